@@ -0,0 +1,115 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// ActionsRunnerTokenParameters are the configurable fields of an
+// ActionsRunnerToken.
+type ActionsRunnerTokenParameters struct {
+	// Org is the Organization the runners register with
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// RefreshBefore is how long before they expire the tokens are minted
+	// anew, 10m by default. GitHub lets tokens expire after an hour.
+	// +optional
+	RefreshBefore *metav1.Duration `json:"refreshBefore,omitempty"`
+}
+
+// ActionsRunnerTokenObservation are the observable fields of an
+// ActionsRunnerToken.
+type ActionsRunnerTokenObservation struct {
+	// ExpiresAt is when the registration token expires
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// RemoveTokenExpiresAt is when the remove token expires
+	RemoveTokenExpiresAt *metav1.Time `json:"removeTokenExpiresAt,omitempty"`
+}
+
+// An ActionsRunnerTokenSpec defines the desired state of an
+// ActionsRunnerToken.
+type ActionsRunnerTokenSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       ActionsRunnerTokenParameters `json:"forProvider"`
+}
+
+// An ActionsRunnerTokenStatus represents the observed state of an
+// ActionsRunnerToken.
+type ActionsRunnerTokenStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          ActionsRunnerTokenObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An ActionsRunnerToken mints the tokens that self-hosted runners register
+// with an organization and remove themselves with, and publishes them as the
+// token and removeToken of its connection secret. The tokens are minted anew
+// before they expire. GitHub can't revoke the tokens, so deleting an
+// ActionsRunnerToken only stops refreshing them.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ORG",type="string",JSONPath=".spec.forProvider.org"
+// +kubebuilder:printcolumn:name="EXPIRES-AT",type="date",JSONPath=".status.atProvider.expiresAt"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type ActionsRunnerToken struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ActionsRunnerTokenSpec   `json:"spec"`
+	Status ActionsRunnerTokenStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ActionsRunnerTokenList contains a list of ActionsRunnerToken
+type ActionsRunnerTokenList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ActionsRunnerToken `json:"items"`
+}
+
+// ActionsRunnerToken type metadata.
+var (
+	ActionsRunnerTokenKind             = reflect.TypeOf(ActionsRunnerToken{}).Name()
+	ActionsRunnerTokenGroupKind        = schema.GroupKind{Group: Group, Kind: ActionsRunnerTokenKind}.String()
+	ActionsRunnerTokenKindAPIVersion   = ActionsRunnerTokenKind + "." + SchemeGroupVersion.String()
+	ActionsRunnerTokenGroupVersionKind = SchemeGroupVersion.WithKind(ActionsRunnerTokenKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&ActionsRunnerToken{}, &ActionsRunnerTokenList{})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BranchParameters are the configurable fields of a Branch.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type BranchParameters struct {
+	// Org is the Organization of the repository
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository of the branch
+	// +immutable
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// SourceBranch is the branch the branch is created from, the default
+	// branch of the repository if neither it nor SourceSHA are set.
+	// +optional
+	SourceBranch *string `json:"sourceBranch,omitempty"`
+
+	// SourceSHA is the commit the branch is created from. It takes
+	// precedence over SourceBranch.
+	// +optional
+	SourceSHA *string `json:"sourceSha,omitempty"`
+
+	// RenameFrom is a branch that is renamed to the branch if the branch
+	// doesn't exist yet, instead of creating it. GitHub retargets the pull
+	// requests and branch protection rules of a renamed branch.
+	// +optional
+	RenameFrom *string `json:"renameFrom,omitempty"`
+}
+
+// BranchObservation are the observable fields of a Branch.
+type BranchObservation struct {
+	// SHA is the commit the branch points to
+	SHA *string `json:"sha,omitempty"`
+}
+
+// A BranchSpec defines the desired state of a Branch.
+type BranchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchParameters `json:"forProvider"`
+}
+
+// A BranchStatus represents the observed state of a Branch.
+type BranchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A Branch is a branch of a repository. Its external name is the name of the
+// branch. The source of a Branch only matters when the branch is created,
+// commits pushed to the branch afterwards are left alone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="SHA",type="string",JSONPath=".status.atProvider.sha",priority=1
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Branch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchSpec   `json:"spec"`
+	Status BranchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchList contains a list of Branch
+type BranchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Branch `json:"items"`
+}
+
+// Branch type metadata.
+var (
+	BranchKind             = reflect.TypeOf(Branch{}).Name()
+	BranchGroupKind        = schema.GroupKind{Group: Group, Kind: BranchKind}.String()
+	BranchKindAPIVersion   = BranchKind + "." + SchemeGroupVersion.String()
+	BranchGroupVersionKind = SchemeGroupVersion.WithKind(BranchKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Branch{}, &BranchList{})
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// BranchProtectionRuleParameters are the configurable fields of a
+// BranchProtectionRule.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type BranchProtectionRuleParameters struct {
+	// Org is the Organization of the repository
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository the rule protects branches of
+	// +immutable
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// The settings of the rule are those of the branchProtectionRules of a
+	// Repository, except for the ones that name users, teams or apps.
+	RepositoryBranchProtectionRule `json:",inline"`
+}
+
+// BranchProtectionRuleObservation are the observable fields of a
+// BranchProtectionRule.
+type BranchProtectionRuleObservation struct {
+	// ID is the GraphQL node ID of the rule
+	ID string `json:"id,omitempty"`
+}
+
+// A BranchProtectionRuleSpec defines the desired state of a
+// BranchProtectionRule.
+type BranchProtectionRuleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       BranchProtectionRuleParameters `json:"forProvider"`
+}
+
+// A BranchProtectionRuleStatus represents the observed state of a
+// BranchProtectionRule.
+type BranchProtectionRuleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          BranchProtectionRuleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A BranchProtectionRule is a branch protection rule of a repository, managed
+// apart from the Repository so that the rules of a repository can be owned by
+// different teams. Its external name is the GraphQL node ID of the rule, which
+// is looked up by branch pattern when the rule is created or imported.
+// Repositories leave the rules of BranchProtectionRules alone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="BRANCH",type="string",JSONPath=".spec.forProvider.branch"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type BranchProtectionRule struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BranchProtectionRuleSpec   `json:"spec"`
+	Status BranchProtectionRuleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BranchProtectionRuleList contains a list of BranchProtectionRule
+type BranchProtectionRuleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BranchProtectionRule `json:"items"`
+}
+
+// BranchProtectionRule type metadata.
+var (
+	BranchProtectionRuleKind             = reflect.TypeOf(BranchProtectionRule{}).Name()
+	BranchProtectionRuleGroupKind        = schema.GroupKind{Group: Group, Kind: BranchProtectionRuleKind}.String()
+	BranchProtectionRuleKindAPIVersion   = BranchProtectionRuleKind + "." + SchemeGroupVersion.String()
+	BranchProtectionRuleGroupVersionKind = SchemeGroupVersion.WithKind(BranchProtectionRuleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&BranchProtectionRule{}, &BranchProtectionRuleList{})
+}
@@ -0,0 +1,408 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// Condition types used by the organizations resources in addition to the
+// Ready and Synced conditions maintained by crossplane-runtime.
+const (
+	// TypeRulesValid indicates whether the branch protection rules and
+	// repository rulesets of a Repository passed pre-flight validation.
+	TypeRulesValid xpv1.ConditionType = "RulesValid"
+
+	// TypeBranchProtectionApplied indicates whether the branch protection rules
+	// of a Repository could be applied to all their branches.
+	TypeBranchProtectionApplied xpv1.ConditionType = "BranchProtectionApplied"
+
+	// TypePermissionsSufficient indicates whether the provider's credentials
+	// allow it to manage a managed resource and all the sub-resources of a
+	// Repository or Organization.
+	TypePermissionsSufficient xpv1.ConditionType = "PermissionsSufficient"
+
+	// TypeRulesDeclared indicates whether all branch protection rules and
+	// rulesets of a Repository are declared in its spec. It is only reported
+	// for the Report management policy.
+	TypeRulesDeclared xpv1.ConditionType = "RulesDeclared"
+
+	// TypeUpToDate indicates whether a Repository and its sub-resources on
+	// GitHub match its spec, and lists the ones that differ otherwise.
+	TypeUpToDate xpv1.ConditionType = "UpToDate"
+
+	// TypeArchived indicates whether a Repository is archived on GitHub, in
+	// which case its settings are not reconciled.
+	TypeArchived xpv1.ConditionType = "Archived"
+
+	// TypeCodeownersValid indicates whether GitHub found errors in the
+	// CODEOWNERS file of a Repository. It is only reported if the
+	// Repository validates its CODEOWNERS file.
+	TypeCodeownersValid xpv1.ConditionType = "CodeownersValid"
+
+	// TypeWebhookEventsValid indicates whether the webhooks of a Repository
+	// only subscribe to events GitHub delivers to repository webhooks. It is
+	// only reported if the Repository declares webhooks.
+	TypeWebhookEventsValid xpv1.ConditionType = "WebhookEventsValid"
+
+	// TypePermissionsSynced, TypeWebhooksSynced, TypeProtectionsSynced and
+	// TypeRulesetsSynced indicate whether the collaborators and teams, the
+	// webhooks, the branch protection rules and the rulesets of a Repository
+	// were last synced with GitHub. Each group is synced apart from the
+	// others, so one failing group doesn't hide the state of the rest.
+	// Webhooks, branch protection rules and rulesets are only reported if
+	// the Repository declares them.
+	TypePermissionsSynced xpv1.ConditionType = "PermissionsSynced"
+	TypeWebhooksSynced    xpv1.ConditionType = "WebhooksSynced"
+	TypeProtectionsSynced xpv1.ConditionType = "ProtectionsSynced"
+	TypeRulesetsSynced    xpv1.ConditionType = "RulesetsSynced"
+
+	// TypeDeletionConfirmed indicates whether a Repository that is being
+	// deleted with the Delete deletion policy is confirmed to be deleted on
+	// GitHub by forceDelete. It is only reported once the Repository is
+	// deleted.
+	TypeDeletionConfirmed xpv1.ConditionType = "DeletionConfirmed"
+)
+
+// Reasons a Repository's rules are or are not valid.
+const (
+	ReasonRulesValid   xpv1.ConditionReason = "PreflightPassed"
+	ReasonRulesInvalid xpv1.ConditionReason = "PreflightFailed"
+)
+
+// Reasons a Repository's branch protection rules are or are not applied.
+const (
+	ReasonBranchesProtected xpv1.ConditionReason = "BranchesProtected"
+	ReasonBranchesPending   xpv1.ConditionReason = "BranchesPending"
+)
+
+// Reasons the provider's credentials are or are not sufficient to manage all
+// sub-resources.
+const (
+	ReasonPermissionsGranted xpv1.ConditionReason = "PermissionsGranted"
+	ReasonPermissionsMissing xpv1.ConditionReason = "PermissionsMissing"
+	ReasonScopesInsufficient xpv1.ConditionReason = "InsufficientScopes"
+)
+
+// Reasons all rules of a Repository are or are not declared in its spec.
+const (
+	ReasonRulesDeclared   xpv1.ConditionReason = "AllRulesDeclared"
+	ReasonRulesUndeclared xpv1.ConditionReason = "UndeclaredRulesFound"
+)
+
+// Reasons a Repository does or does not match its spec.
+const (
+	ReasonUpToDate xpv1.ConditionReason = "MatchesSpec"
+	ReasonDrifted  xpv1.ConditionReason = "DriftDetected"
+)
+
+// Reasons a Repository is or is not archived.
+const (
+	ReasonArchived    xpv1.ConditionReason = "RepositoryArchived"
+	ReasonNotArchived xpv1.ConditionReason = "RepositoryNotArchived"
+)
+
+// Reasons the CODEOWNERS file of a Repository is or is not valid.
+const (
+	ReasonCodeownersValid   xpv1.ConditionReason = "NoCodeownersErrors"
+	ReasonCodeownersInvalid xpv1.ConditionReason = "CodeownersErrors"
+)
+
+// Reasons the webhook events of a Repository are or are not known.
+const (
+	ReasonWebhookEventsKnown   xpv1.ConditionReason = "KnownEvents"
+	ReasonWebhookEventsUnknown xpv1.ConditionReason = "UnknownEvents"
+)
+
+// Reasons a group of sub-resources of a Repository is or is not synced.
+const (
+	ReasonSubResourcesSynced     xpv1.ConditionReason = "InSync"
+	ReasonSubResourcesSyncFailed xpv1.ConditionReason = "SyncFailed"
+	ReasonSubResourcesSkipped    xpv1.ConditionReason = "SyncSkipped"
+)
+
+// Reasons the deletion of a Repository on GitHub is or is not confirmed.
+const (
+	ReasonForceDeleteSet   xpv1.ConditionReason = "ForceDeleteSet"
+	ReasonForceDeleteUnset xpv1.ConditionReason = "ForceDeleteUnset"
+)
+
+// RulesValid returns a condition that indicates all branch protection rules
+// and repository rulesets passed pre-flight validation.
+func RulesValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRulesValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRulesValid,
+	}
+}
+
+// RulesInvalid returns a condition that indicates one or more branch
+// protection rules or repository rulesets failed pre-flight validation and
+// were not pushed to GitHub.
+func RulesInvalid(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRulesValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRulesInvalid,
+		Message:            msg,
+	}
+}
+
+// BranchProtectionApplied returns a condition that indicates the branch
+// protection rules of a Repository are applied to all their branches.
+func BranchProtectionApplied() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBranchProtectionApplied,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBranchesProtected,
+	}
+}
+
+// BranchProtectionPending returns a condition that indicates one or more
+// branch protection rules are for branches that don't exist yet, and are
+// applied once the branches are pushed.
+func BranchProtectionPending(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeBranchProtectionApplied,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonBranchesPending,
+		Message:            msg,
+	}
+}
+
+// PermissionsSufficient returns a condition that indicates the provider's
+// credentials allow it to manage all sub-resources.
+func PermissionsSufficient() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePermissionsSufficient,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionsGranted,
+	}
+}
+
+// PermissionsMissing returns a condition that indicates one or more
+// sub-resources were skipped because the provider's credentials lack the
+// token scopes or App permissions to manage them.
+func PermissionsMissing(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePermissionsSufficient,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonPermissionsMissing,
+		Message:            msg,
+	}
+}
+
+// ScopesInsufficient returns a condition that indicates the managed resource
+// could not be reconciled because the provider's credentials lack a token
+// scope or permission. The message names what they need, if GitHub said so.
+func ScopesInsufficient(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypePermissionsSufficient,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonScopesInsufficient,
+		Message:            msg,
+	}
+}
+
+// RulesDeclared returns a condition that indicates all branch protection rules
+// and rulesets of a Repository are declared in its spec.
+func RulesDeclared() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRulesDeclared,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRulesDeclared,
+	}
+}
+
+// RulesUndeclared returns a condition that indicates a Repository has branch
+// protection rules or rulesets that are not declared in its spec, and that are
+// left alone because of the Report management policy.
+func RulesUndeclared(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeRulesDeclared,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonRulesUndeclared,
+		Message:            msg,
+	}
+}
+
+// UpToDate returns a condition that indicates a Repository and its
+// sub-resources on GitHub match its spec.
+func UpToDate() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonUpToDate,
+	}
+}
+
+// Drifted returns a condition that indicates a Repository or some of its
+// sub-resources on GitHub differ from its spec, and are about to be updated.
+func Drifted(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeUpToDate,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonDrifted,
+		Message:            msg,
+	}
+}
+
+// Archived returns a condition that indicates a Repository is archived on
+// GitHub, and that its settings are left alone until it is unarchived.
+func Archived() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeArchived,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonArchived,
+		Message:            "repository is archived, its settings are not reconciled unless archived is set to false",
+	}
+}
+
+// NotArchived returns a condition that indicates a Repository is not archived
+// on GitHub.
+func NotArchived() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeArchived,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonNotArchived,
+	}
+}
+
+// CodeownersValid returns a condition that indicates GitHub found no errors in
+// the CODEOWNERS file of a Repository, or that it has none.
+func CodeownersValid(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCodeownersValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCodeownersValid,
+		Message:            msg,
+	}
+}
+
+// CodeownersInvalid returns a condition that indicates GitHub found errors in
+// the CODEOWNERS file of a Repository, which keep it from requesting reviews
+// from the owners of the lines with errors.
+func CodeownersInvalid(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeCodeownersValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonCodeownersInvalid,
+		Message:            msg,
+	}
+}
+
+// WebhookEventsValid returns a condition that indicates the webhooks of a
+// Repository only subscribe to known events.
+func WebhookEventsValid() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeWebhookEventsValid,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWebhookEventsKnown,
+	}
+}
+
+// WebhookEventsUnknown returns a condition that indicates webhooks of a
+// Repository subscribe to events GitHub doesn't deliver to repository
+// webhooks, which GitHub accepts without ever delivering them.
+func WebhookEventsUnknown(msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeWebhookEventsValid,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonWebhookEventsUnknown,
+		Message:            msg,
+	}
+}
+
+// SubResourcesSynced returns a condition of type t that indicates a group of
+// sub-resources of a Repository matches its spec.
+func SubResourcesSynced(t xpv1.ConditionType) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               t,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSubResourcesSynced,
+	}
+}
+
+// SubResourcesSyncFailed returns a condition of type t that indicates a group
+// of sub-resources of a Repository could not be synced with GitHub.
+func SubResourcesSyncFailed(t xpv1.ConditionType, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               t,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSubResourcesSyncFailed,
+		Message:            msg,
+	}
+}
+
+// SubResourcesSkipped returns a condition of type t that indicates a group of
+// sub-resources of a Repository was skipped because the provider's
+// credentials lack the token scopes or App permissions to manage it.
+func SubResourcesSkipped(t xpv1.ConditionType, msg string) xpv1.Condition {
+	return xpv1.Condition{
+		Type:               t,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonSubResourcesSkipped,
+		Message:            msg,
+	}
+}
+
+// DeletionConfirmed returns a condition that indicates a Repository that is
+// being deleted is deleted on GitHub, as forceDelete is set.
+func DeletionConfirmed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionConfirmed,
+		Status:             corev1.ConditionTrue,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonForceDeleteSet,
+	}
+}
+
+// DeletionUnconfirmed returns a condition that indicates a Repository that is
+// being deleted is kept on GitHub until its deletion is confirmed.
+func DeletionUnconfirmed() xpv1.Condition {
+	return xpv1.Condition{
+		Type:               TypeDeletionConfirmed,
+		Status:             corev1.ConditionFalse,
+		LastTransitionTime: metav1.Now(),
+		Reason:             ReasonForceDeleteUnset,
+		Message:            "repository is not deleted on GitHub unless forceDelete is set to true; set deletionPolicy to Orphan to only delete the Repository",
+	}
+}
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// CustomRepositoryRoleParameters are the configurable fields of a
+// CustomRepositoryRole. The name of the role is the external name.
+type CustomRepositoryRoleParameters struct {
+	// Org is the Organization the role is defined in
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSlector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Description is the description of the role
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// BaseRole is the built-in role the permissions are added to, can be one of: "read", "triage", "write", "maintain"
+	// +kubebuilder:validation:Enum=read;triage;write;maintain
+	BaseRole string `json:"baseRole"`
+
+	// Permissions are the additional fine-grained permissions of the role, e.g. delete_alerts_code_scanning
+	// +optional
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// CustomRepositoryRoleObservation are the observable fields of a
+// CustomRepositoryRole.
+type CustomRepositoryRoleObservation struct {
+	// ID is the ID of the role
+	ID *int64 `json:"id,omitempty"`
+}
+
+// A CustomRepositoryRoleSpec defines the desired state of a CustomRepositoryRole.
+type CustomRepositoryRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       CustomRepositoryRoleParameters `json:"forProvider"`
+}
+
+// A CustomRepositoryRoleStatus represents the observed state of a CustomRepositoryRole.
+type CustomRepositoryRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          CustomRepositoryRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A CustomRepositoryRole is a repository role of an organization, which can
+// be granted to the users and teams of its repositories by name.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type CustomRepositoryRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CustomRepositoryRoleSpec   `json:"spec"`
+	Status CustomRepositoryRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomRepositoryRoleList contains a list of CustomRepositoryRole
+type CustomRepositoryRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomRepositoryRole `json:"items"`
+}
+
+// CustomRepositoryRole type metadata.
+var (
+	CustomRepositoryRoleKind             = reflect.TypeOf(CustomRepositoryRole{}).Name()
+	CustomRepositoryRoleGroupKind        = schema.GroupKind{Group: Group, Kind: CustomRepositoryRoleKind}.String()
+	CustomRepositoryRoleKindAPIVersion   = CustomRepositoryRoleKind + "." + SchemeGroupVersion.String()
+	CustomRepositoryRoleGroupVersionKind = SchemeGroupVersion.WithKind(CustomRepositoryRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&CustomRepositoryRole{}, &CustomRepositoryRoleList{})
+}
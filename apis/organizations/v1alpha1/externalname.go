@@ -0,0 +1,45 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reference"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// ParseRepositoryExternalName splits the external name of a Repository, which
+// is either the bare name of the repository or of the form owner/name. The
+// owner is empty for a bare name.
+func ParseRepositoryExternalName(externalName string) (owner, name string) {
+	owner, name, found := strings.Cut(externalName, "/")
+	if !found {
+		return "", externalName
+	}
+	return owner, name
+}
+
+// RepositoryName extracts the name of a referenced Repository on GitHub, i.e.
+// its external name without the owner.
+func RepositoryName() reference.ExtractValueFn {
+	return func(mg resource.Managed) string {
+		_, name := ParseRepositoryExternalName(meta.GetExternalName(mg))
+		return name
+	}
+}
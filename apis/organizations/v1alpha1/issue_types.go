@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// IssueParameters are the configurable fields of an Issue.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type IssueParameters struct {
+	// Org is the Organization of the repository
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository of the issue
+	// +immutable
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// Title is the title of the issue
+	Title string `json:"title"`
+
+	// Body is the Markdown body of the issue
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// Labels are the names of the labels of the issue. The labels of an
+	// issue are left as they are if Labels is not set.
+	// +listType=set
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+
+	// Assignees are the logins of the users the issue is assigned to. The
+	// assignees of an issue are left as they are if Assignees is not set.
+	// +listType=set
+	// +optional
+	Assignees []string `json:"assignees,omitempty"`
+
+	// Milestone is the number of the milestone of the issue
+	// +optional
+	Milestone *int `json:"milestone,omitempty"`
+
+	// State is the state of the issue, open by default
+	// +kubebuilder:validation:Enum=open;closed
+	// +optional
+	State *string `json:"state,omitempty"`
+}
+
+// IssueObservation are the observable fields of an Issue.
+type IssueObservation struct {
+	// Number is the number of the issue in its repository
+	Number *int `json:"number,omitempty"`
+
+	// State is the state of the issue
+	State *string `json:"state,omitempty"`
+
+	// HTMLURL is the URL of the issue
+	HTMLURL *string `json:"htmlUrl,omitempty"`
+}
+
+// An IssueSpec defines the desired state of an Issue.
+type IssueSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       IssueParameters `json:"forProvider"`
+}
+
+// An IssueStatus represents the observed state of an Issue.
+type IssueStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          IssueObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An Issue is an issue of a repository, e.g. an onboarding checklist that is
+// opened with a new repository. Its external name is the number of the issue.
+// GitHub doesn't delete issues, so deleting an Issue closes its issue.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="NUMBER",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="STATE",type="string",JSONPath=".status.atProvider.state"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type Issue struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IssueSpec   `json:"spec"`
+	Status IssueStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// IssueList contains a list of Issue
+type IssueList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Issue `json:"items"`
+}
+
+// Issue type metadata.
+var (
+	IssueKind             = reflect.TypeOf(Issue{}).Name()
+	IssueGroupKind        = schema.GroupKind{Group: Group, Kind: IssueKind}.String()
+	IssueKindAPIVersion   = IssueKind + "." + SchemeGroupVersion.String()
+	IssueGroupVersionKind = SchemeGroupVersion.WithKind(IssueKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&Issue{}, &IssueList{})
+}
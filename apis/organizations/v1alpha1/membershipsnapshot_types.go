@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// MembershipSnapshotParameters are the configurable fields of a MembershipSnapshot.
+type MembershipSnapshotParameters struct {
+	// User is the login of the user to report access for
+	// +crossplane:generate:reference:type=Membership
+	User string `json:"user,omitempty"`
+
+	// UserRef is a reference to a Membership
+	// +optional
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects a reference to a Membership
+	// +optional
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
+	// Org is the Organization to report access in
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSlector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+}
+
+// MembershipSnapshotTeam is a team the user is a member of.
+type MembershipSnapshotTeam struct {
+	// Team is the name of the team
+	Team string `json:"team"`
+
+	// Role is the role of the user in the team
+	Role string `json:"role"`
+
+	// ResourceName is the name of the Team managed resource granting the membership
+	ResourceName string `json:"resourceName"`
+}
+
+// MembershipSnapshotRepository is a repository the user has been granted direct access to.
+type MembershipSnapshotRepository struct {
+	// Repo is the name of the repository
+	Repo string `json:"repo"`
+
+	// Role is the role granted to the user on the repository
+	Role string `json:"role"`
+
+	// ResourceName is the name of the Repository managed resource granting the access
+	ResourceName string `json:"resourceName"`
+}
+
+// MembershipSnapshotObservation are the observable fields of a MembershipSnapshot.
+type MembershipSnapshotObservation struct {
+	// Teams the user is a member of across all managed Teams of the organization.
+	Teams []MembershipSnapshotTeam `json:"teams,omitempty"`
+
+	// Repositories the user has direct access to across all managed Repositories of the organization.
+	Repositories []MembershipSnapshotRepository `json:"repositories,omitempty"`
+}
+
+// A MembershipSnapshotSpec defines the desired state of a MembershipSnapshot.
+type MembershipSnapshotSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       MembershipSnapshotParameters `json:"forProvider"`
+}
+
+// A MembershipSnapshotStatus represents the observed state of a MembershipSnapshot.
+type MembershipSnapshotStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          MembershipSnapshotObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A MembershipSnapshot is an observe-only resource that reports all team
+// memberships and direct repository grants a user has across the managed
+// resources of an organization, to support offboarding workflows.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="USER",type="string",JSONPath=".spec.forProvider.user"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type MembershipSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   MembershipSnapshotSpec   `json:"spec"`
+	Status MembershipSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// MembershipSnapshotList contains a list of MembershipSnapshot
+type MembershipSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []MembershipSnapshot `json:"items"`
+}
+
+// MembershipSnapshot type metadata.
+var (
+	MembershipSnapshotKind             = reflect.TypeOf(MembershipSnapshot{}).Name()
+	MembershipSnapshotGroupKind        = schema.GroupKind{Group: Group, Kind: MembershipSnapshotKind}.String()
+	MembershipSnapshotKindAPIVersion   = MembershipSnapshotKind + "." + SchemeGroupVersion.String()
+	MembershipSnapshotGroupVersionKind = SchemeGroupVersion.WithKind(MembershipSnapshotKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&MembershipSnapshot{}, &MembershipSnapshotList{})
+}
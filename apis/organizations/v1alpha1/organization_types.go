@@ -26,13 +26,47 @@ import (
 )
 
 // ActionsConfiguration are the configurable fields of an Organization Actions.
+// +kubebuilder:validation:XValidation:rule="!has(self.enabledRepos) || !has(self.enabledRepositories) || self.enabledRepositories == 'selected'",message="enabledRepos require enabledRepositories to be selected"
 type ActionsConfiguration struct {
+	// EnabledRepositories is the policy of the repositories GitHub Actions
+	// are enabled for: all of them, none or the selected ones of
+	// EnabledRepos. It is left as it is if EnabledRepositories is not set.
+	// +kubebuilder:validation:Enum=all;none;selected
+	// +optional
+	EnabledRepositories *string `json:"enabledRepositories,omitempty"`
+
+	// EnabledRepos are the repositories GitHub Actions are enabled for, if
+	// the policy is selected.
+	// +optional
 	EnabledRepos []ActionEnabledRepo `json:"enabledRepos,omitempty"`
+
+	// OIDCSubjectClaim is the template of the subject claim of the OIDC
+	// tokens of the workflows of the Organization's repositories. It is
+	// left as it is if OIDCSubjectClaim is not set.
+	// +optional
+	OIDCSubjectClaim *OIDCSubjectClaim `json:"oidcSubjectClaim,omitempty"`
+}
+
+// OIDCSubjectClaim is the template of the subject claim of the OIDC tokens of
+// GitHub Actions workflows.
+type OIDCSubjectClaim struct {
+	// UseDefault is whether the default template is used. For an
+	// organization, that is the subject claim of GitHub, made of the repo and
+	// context claims. For a repository, that is the template of its
+	// organization.
+	UseDefault bool `json:"useDefault"`
+
+	// IncludeClaimKeys are the claims the subject claim is made of, in their
+	// order, e.g. repo, context or job_workflow_ref. They are required unless
+	// UseDefault is true.
+	// +optional
+	IncludeClaimKeys []string `json:"includeClaimKeys,omitempty"`
 }
 
 type ActionEnabledRepo struct {
 	// Name of the repository
 	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
 	Repo string `json:"repo,omitempty"`
 
 	// RepoRef is a reference to the Repositories
@@ -47,6 +81,7 @@ type ActionEnabledRepo struct {
 type SecretSelectedRepo struct {
 	// Name of the repository
 	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
 	Repo string `json:"repo,omitempty"`
 
 	// RepoRef is a reference to the Repositories
@@ -74,6 +109,10 @@ type SecretConfiguration struct {
 	// List of Dependabot secrets
 	// +optional
 	DependabotSecrets []OrgSecret `json:"dependabotSecrets,omitempty"`
+
+	// List of Codespaces secrets
+	// +optional
+	CodespacesSecrets []OrgSecret `json:"codespacesSecrets,omitempty"`
 }
 
 // OrganizationParameters are the configurable fields of a Organization.
@@ -81,14 +120,338 @@ type OrganizationParameters struct {
 	Description string               `json:"description"`
 	Actions     ActionsConfiguration `json:"actions,omitempty"`
 
+	// Name is the display name of the Organization. The profile fields
+	// default to the current profile of the Organization.
+	// +optional
+	Name *string `json:"name,omitempty"`
+
+	// Company is the company name of the Organization.
+	// +optional
+	Company *string `json:"company,omitempty"`
+
+	// BillingEmail is the private email address that receives the bills of
+	// the Organization.
+	// +optional
+	BillingEmail *string `json:"billingEmail,omitempty"`
+
+	// Blog is the URL of the website of the Organization.
+	// +optional
+	Blog *string `json:"blog,omitempty"`
+
+	// Location of the Organization.
+	// +optional
+	Location *string `json:"location,omitempty"`
+
+	// Email is the public email address of the Organization.
+	// +optional
+	Email *string `json:"email,omitempty"`
+
+	// TwitterUsername is the Twitter username of the Organization.
+	// +optional
+	TwitterUsername *string `json:"twitterUsername,omitempty"`
+
 	// Configuration for Organization Secrets.
 	// +optional
 	Secrets *SecretConfiguration `json:"secrets,omitempty"`
+
+	// Defaults applied to all managed Repositories of the Organization.
+	// +optional
+	RepositoryDefaults *RepositoryDefaults `json:"repositoryDefaults,omitempty"`
+
+	// Billing enables observing the Actions usage and included quota of the
+	// Organization. Requires the organization administration read permission.
+	// +optional
+	Billing *BillingConfiguration `json:"billing,omitempty"`
+
+	// RepositoryBaseline are settings that all repositories of the
+	// Organization are audited against, whether they are managed or not.
+	// Repositories that don't have them are reported in the status of the
+	// Organization, but never changed.
+	// +optional
+	RepositoryBaseline *RepositoryBaseline `json:"repositoryBaseline,omitempty"`
+
+	// BlockedUsers are the logins of the users that are blocked from the
+	// Organization. They are blocked again if they are unblocked. Users that
+	// are blocked on GitHub but not listed here stay blocked.
+	// +optional
+	// +listType=set
+	BlockedUsers []string `json:"blockedUsers,omitempty"`
+
+	// CustomProperties are the custom property schemas of the Organization.
+	// Properties that are defined on GitHub but not listed here are left
+	// untouched, removing a property would remove its values from all
+	// repositories.
+	// +optional
+	CustomProperties []CustomPropertyDefinition `json:"customProperties,omitempty"`
+
+	// SecurityManagerTeams are the teams with the security manager role of
+	// the Organization, which can read every repository and see and manage
+	// its security alerts. Teams that have the role but aren't listed lose
+	// it. The role is left as it is if SecurityManagerTeams is not set.
+	// +optional
+	SecurityManagerTeams []SecurityManagerTeam `json:"securityManagerTeams,omitempty"`
+
+	// DependabotAlertDismissalRules dismiss the open Dependabot alerts of the
+	// repositories of the Organization that match one of them, like the
+	// auto-triage rules GitHub doesn't offer an API for. Dismissed alerts
+	// stay dismissed if a rule is removed.
+	// +optional
+	DependabotAlertDismissalRules []DependabotAlertDismissalRule `json:"dependabotAlertDismissalRules,omitempty"`
+}
+
+// SecurityManagerTeam is a team with the security manager role of an
+// organization.
+type SecurityManagerTeam struct {
+	// Team is the name of the team
+	// +crossplane:generate:reference:type=Team
+	Team string `json:"team,omitempty"`
+
+	// TeamRef is a reference to a Team
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+
+	// TeamSelector selects a reference to a Team
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+}
+
+// DependabotAlertDismissalRule dismisses the Dependabot alerts that match all
+// of its criteria. Criteria that aren't set match every alert.
+type DependabotAlertDismissalRule struct {
+	// Name of the rule. It is part of the comment of the alerts the rule
+	// dismisses.
+	Name string `json:"name"`
+
+	// Scope is the scope of the vulnerable dependency, development or
+	// runtime.
+	// +kubebuilder:validation:Enum=development;runtime
+	// +optional
+	Scope *string `json:"scope,omitempty"`
+
+	// Severities are the severities of the alerts, of low, medium, high and
+	// critical.
+	// +listType=set
+	// +optional
+	Severities []string `json:"severities,omitempty"`
+
+	// Ecosystems are the package ecosystems of the vulnerable dependency,
+	// e.g. npm or pip.
+	// +listType=set
+	// +optional
+	Ecosystems []string `json:"ecosystems,omitempty"`
+
+	// Packages are the names of the vulnerable dependency.
+	// +listType=set
+	// +optional
+	Packages []string `json:"packages,omitempty"`
+
+	// DismissedReason is the reason the alerts are dismissed for, one of
+	// fix_started, inaccurate, no_bandwidth, not_used and tolerable_risk.
+	// +kubebuilder:validation:Enum=fix_started;inaccurate;no_bandwidth;not_used;tolerable_risk
+	DismissedReason string `json:"dismissedReason"`
+}
+
+// CustomPropertyDefinition is the schema of an organization custom property
+// whose values are set on repositories.
+type CustomPropertyDefinition struct {
+	// Name of the custom property.
+	Name string `json:"name"`
+
+	// ValueType of the custom property.
+	// +kubebuilder:validation:Enum=string;single_select;multi_select;true_false
+	ValueType string `json:"valueType"`
+
+	// Required makes it mandatory to set a value on every repository.
+	// Required properties must have a default value.
+	// +optional
+	Required *bool `json:"required,omitempty"`
+
+	// DefaultValue is the value of repositories that don't set one.
+	// +optional
+	DefaultValue *string `json:"defaultValue,omitempty"`
+
+	// Description of the custom property.
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// AllowedValues of single_select and multi_select properties.
+	// +optional
+	AllowedValues []string `json:"allowedValues,omitempty"`
+}
+
+// BillingConfiguration configures how the billing of an Organization is observed.
+type BillingConfiguration struct {
+	// RefreshInterval is how often the Actions usage is refreshed. Usage is
+	// reported with a delay by GitHub, refreshing it more often than hourly is
+	// rarely useful.
+	// Default: 1h
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
+}
+
+// RepositoryDefaults are applied by the Repository controller to all managed
+// Repositories of an Organization. They are maintained additively, settings
+// made directly on a repository are never removed.
+type RepositoryDefaults struct {
+	// Topics that every managed repository is required to have, e.g. managed-by-crossplane
+	// +optional
+	Topics []string `json:"topics,omitempty"`
+
+	// TopicsFromLabels are label keys of the Repository resources whose values are
+	// added as topics. A label cost-center=platform on a Repository for the key
+	// cost-center results in the topic cost-center-platform.
+	// +optional
+	TopicsFromLabels []string `json:"topicsFromLabels,omitempty"`
+}
+
+// RepositoryBaseline are the settings the repositories of an Organization are
+// audited against. Settings that aren't set aren't audited. Archived
+// repositories are not audited.
+type RepositoryBaseline struct {
+	// Topics that every repository is required to have.
+	// +optional
+	Topics []string `json:"topics,omitempty"`
+
+	// AllowMergeCommit is whether repositories are required to allow, or
+	// not to allow, merging pull requests with a merge commit.
+	// +optional
+	AllowMergeCommit *bool `json:"allowMergeCommit,omitempty"`
+
+	// AllowSquashMerge is whether repositories are required to allow, or
+	// not to allow, squash-merging pull requests.
+	// +optional
+	AllowSquashMerge *bool `json:"allowSquashMerge,omitempty"`
+
+	// AllowRebaseMerge is whether repositories are required to allow, or
+	// not to allow, rebase-merging pull requests.
+	// +optional
+	AllowRebaseMerge *bool `json:"allowRebaseMerge,omitempty"`
+
+	// DeleteBranchOnMerge is whether repositories are required to delete,
+	// or not to delete, head branches when pull requests are merged.
+	// +optional
+	DeleteBranchOnMerge *bool `json:"deleteBranchOnMerge,omitempty"`
+
+	// SecretScanning is whether repositories are required to have secret
+	// scanning enabled or disabled.
+	// +optional
+	SecretScanning *bool `json:"secretScanning,omitempty"`
+
+	// SecretScanningPushProtection is whether repositories are required to
+	// have secret scanning push protection enabled or disabled.
+	// +optional
+	SecretScanningPushProtection *bool `json:"secretScanningPushProtection,omitempty"`
+
+	// DependabotSecurityUpdates is whether repositories are required to have
+	// Dependabot security updates enabled or disabled.
+	// +optional
+	DependabotSecurityUpdates *bool `json:"dependabotSecurityUpdates,omitempty"`
+
+	// RefreshInterval is how often the repositories are audited. Auditing
+	// merge and security settings costs an API request per repository.
+	// Default: 1h
+	// +optional
+	RefreshInterval *metav1.Duration `json:"refreshInterval,omitempty"`
 }
 
 // OrganizationObservation are the observable fields of a Organization.
 type OrganizationObservation struct {
 	Description string `json:"description,omitempty"`
+
+	// NodeID is the GraphQL node ID of the organization.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// Plan is the name of the plan of the organization, e.g. team or enterprise.
+	Plan string `json:"plan,omitempty"`
+
+	// Seats is the number of seats of the plan.
+	Seats *int `json:"seats,omitempty"`
+
+	// FilledSeats is the number of seats of the plan in use.
+	FilledSeats *int `json:"filledSeats,omitempty"`
+
+	// Members is the number of members of the organization.
+	Members *int `json:"members,omitempty"`
+
+	// OutsideCollaborators is the number of outside collaborators of the
+	// repositories of the organization.
+	OutsideCollaborators *int `json:"outsideCollaborators,omitempty"`
+
+	// TwoFactorRequirementEnabled is whether members are required to enable
+	// two-factor authentication.
+	TwoFactorRequirementEnabled *bool `json:"twoFactorRequirementEnabled,omitempty"`
+
+	// DefaultRepositoryPermission is the base permission of members on the
+	// repositories of the organization, one of read, write, admin and none.
+	DefaultRepositoryPermission string `json:"defaultRepositoryPermission,omitempty"`
+
+	// Billing is the Actions usage of the current billing cycle.
+	Billing *BillingObservation `json:"billing,omitempty"`
+
+	// BlockedUsers are the logins of all users blocked from the
+	// organization, sorted. Only observed if the spec lists blocked users.
+	BlockedUsers []string `json:"blockedUsers,omitempty"`
+
+	// RepositoryBaseline is the result of the last audit of the
+	// repositories against the repository baseline.
+	RepositoryBaseline *RepositoryBaselineObservation `json:"repositoryBaseline,omitempty"`
+}
+
+// RepositoryBaselineObservation is the result of an audit of the repositories
+// of an Organization against its repository baseline.
+type RepositoryBaselineObservation struct {
+	// RefreshTime is when the repositories were last audited.
+	RefreshTime *metav1.Time `json:"refreshTime,omitempty"`
+
+	// Repositories is the number of audited repositories.
+	Repositories int `json:"repositories"`
+
+	// NonCompliant is the number of audited repositories that don't comply
+	// with the baseline.
+	NonCompliant int `json:"nonCompliant"`
+
+	// NonCompliantRepositories are the repositories that don't comply with
+	// the baseline, sorted by name. At most 100 repositories are listed.
+	NonCompliantRepositories []NonCompliantRepository `json:"nonCompliantRepositories,omitempty"`
+}
+
+// NonCompliantRepository is a repository that doesn't comply with the
+// repository baseline of its Organization.
+type NonCompliantRepository struct {
+	// Name of the repository.
+	Name string `json:"name"`
+
+	// Violations are the baseline settings the repository doesn't have,
+	// e.g. topics[managed] or allowMergeCommit.
+	Violations []string `json:"violations"`
+}
+
+// BillingObservation is the Actions usage of an Organization in the current
+// billing cycle. Storage is reported in GB, as a decimal string.
+type BillingObservation struct {
+	// RefreshTime is when the usage was last refreshed.
+	RefreshTime *metav1.Time `json:"refreshTime,omitempty"`
+
+	// ActionsMinutesUsed is the number of Actions minutes used.
+	ActionsMinutesUsed int64 `json:"actionsMinutesUsed"`
+
+	// ActionsPaidMinutesUsed is the number of Actions minutes used beyond the included minutes.
+	ActionsPaidMinutesUsed int64 `json:"actionsPaidMinutesUsed"`
+
+	// ActionsIncludedMinutes is the number of Actions minutes included in the plan.
+	ActionsIncludedMinutes int64 `json:"actionsIncludedMinutes"`
+
+	// ActionsMinutesUsedBreakdown is the number of Actions minutes used by runner OS.
+	ActionsMinutesUsedBreakdown map[string]int `json:"actionsMinutesUsedBreakdown,omitempty"`
+
+	// EstimatedStorageForMonth is the estimated Actions and Packages storage for the month.
+	EstimatedStorageForMonth string `json:"estimatedStorageForMonth,omitempty"`
+
+	// EstimatedPaidStorageForMonth is the estimated storage for the month beyond the included storage.
+	EstimatedPaidStorageForMonth string `json:"estimatedPaidStorageForMonth,omitempty"`
+
+	// DaysLeftInBillingCycle is the number of days until the usage resets.
+	DaysLeftInBillingCycle int `json:"daysLeftInBillingCycle"`
 }
 
 // A OrganizationSpec defines the desired state of a Organization.
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationRoleParameters are the configurable fields of a custom
+// OrganizationRole. The name of the role is the external name.
+type OrganizationRoleParameters struct {
+	// Org is the Organization the role is defined in
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSlector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Description is the description of the role
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// BaseRole is the repository role the role grants on all repositories of the organization,
+	// can be one of: "read", "triage", "write", "maintain", "admin"
+	// +kubebuilder:validation:Enum=read;triage;write;maintain;admin
+	// +optional
+	BaseRole *string `json:"baseRole,omitempty"`
+
+	// Permissions are the organization permissions of the role, e.g. read_organization_custom_repo_role
+	// +optional
+	Permissions []string `json:"permissions,omitempty"`
+}
+
+// OrganizationRoleObservation are the observable fields of an OrganizationRole.
+type OrganizationRoleObservation struct {
+	// ID is the ID of the role
+	ID *int64 `json:"id,omitempty"`
+}
+
+// An OrganizationRoleSpec defines the desired state of an OrganizationRole.
+type OrganizationRoleSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationRoleParameters `json:"forProvider"`
+}
+
+// An OrganizationRoleStatus represents the observed state of an OrganizationRole.
+type OrganizationRoleStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationRoleObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationRole is a custom role of an organization, which delegates
+// administration of the organization to the users and teams it is assigned to.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EXTERNAL-NAME",type="string",JSONPath=".metadata.annotations.crossplane\\.io/external-name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationRole struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationRoleSpec   `json:"spec"`
+	Status OrganizationRoleStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationRoleList contains a list of OrganizationRole
+type OrganizationRoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationRole `json:"items"`
+}
+
+// OrganizationRole type metadata.
+var (
+	OrganizationRoleKind             = reflect.TypeOf(OrganizationRole{}).Name()
+	OrganizationRoleGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationRoleKind}.String()
+	OrganizationRoleKindAPIVersion   = OrganizationRoleKind + "." + SchemeGroupVersion.String()
+	OrganizationRoleGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationRoleKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationRole{}, &OrganizationRoleList{})
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationRoleAssignmentParameters are the configurable fields of an
+// OrganizationRoleAssignment. Exactly one of User and Team must be set.
+type OrganizationRoleAssignmentParameters struct {
+	// Org is the Organization of the role
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSlector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Role is the name of the predefined or custom organization role to assign
+	// +immutable
+	// +crossplane:generate:reference:type=OrganizationRole
+	Role string `json:"role,omitempty"`
+
+	// RoleRef is a reference to an OrganizationRole
+	// +optional
+	RoleRef *xpv1.Reference `json:"roleRef,omitempty"`
+
+	// RoleSelector selects a reference to an OrganizationRole
+	// +optional
+	RoleSelector *xpv1.Selector `json:"roleSelector,omitempty"`
+
+	// User is the login of the member the role is assigned to
+	// +immutable
+	// +crossplane:generate:reference:type=Membership
+	// +optional
+	User string `json:"user,omitempty"`
+
+	// UserRef is a reference to a Membership
+	// +optional
+	UserRef *xpv1.Reference `json:"userRef,omitempty"`
+
+	// UserSelector selects a reference to a Membership
+	// +optional
+	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
+
+	// Team is the name of the team the role is assigned to
+	// +immutable
+	// +crossplane:generate:reference:type=Team
+	// +optional
+	Team string `json:"team,omitempty"`
+
+	// TeamRef is a reference to a Team
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+
+	// TeamSelector selects a reference to a Team
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+}
+
+// OrganizationRoleAssignmentObservation are the observable fields of an
+// OrganizationRoleAssignment.
+type OrganizationRoleAssignmentObservation struct {
+	// RoleID is the ID of the assigned role
+	RoleID *int64 `json:"roleId,omitempty"`
+}
+
+// An OrganizationRoleAssignmentSpec defines the desired state of an OrganizationRoleAssignment.
+type OrganizationRoleAssignmentSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationRoleAssignmentParameters `json:"forProvider"`
+}
+
+// An OrganizationRoleAssignmentStatus represents the observed state of an OrganizationRoleAssignment.
+type OrganizationRoleAssignmentStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationRoleAssignmentObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationRoleAssignment assigns an organization role to a member or a
+// team of the organization.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ROLE",type="string",JSONPath=".spec.forProvider.role"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationRoleAssignment struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationRoleAssignmentSpec   `json:"spec"`
+	Status OrganizationRoleAssignmentStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationRoleAssignmentList contains a list of OrganizationRoleAssignment
+type OrganizationRoleAssignmentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationRoleAssignment `json:"items"`
+}
+
+// OrganizationRoleAssignment type metadata.
+var (
+	OrganizationRoleAssignmentKind             = reflect.TypeOf(OrganizationRoleAssignment{}).Name()
+	OrganizationRoleAssignmentGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationRoleAssignmentKind}.String()
+	OrganizationRoleAssignmentKindAPIVersion   = OrganizationRoleAssignmentKind + "." + SchemeGroupVersion.String()
+	OrganizationRoleAssignmentGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationRoleAssignmentKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationRoleAssignment{}, &OrganizationRoleAssignmentList{})
+}
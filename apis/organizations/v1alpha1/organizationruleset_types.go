@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// OrganizationRulesetParameters are the configurable fields of an
+// OrganizationRuleset.
+type OrganizationRulesetParameters struct {
+	// Org is the Organization the ruleset belongs to
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repositories selects the repositories of the organization the ruleset
+	// applies to.
+	Repositories OrganizationRulesetRepositories `json:"repositories"`
+
+	// The settings of the ruleset are those of the repositoryRules of a
+	// Repository. Its required workflows must set their repo, repoRef or
+	// repositoryId, as there is no repository they default to.
+	Ruleset `json:",inline"`
+}
+
+// OrganizationRulesetRepositories select the repositories an organization
+// ruleset applies to, either by name or by the values of their custom
+// properties.
+// +kubebuilder:validation:XValidation:rule="has(self.names) != has(self.properties)",message="exactly one of names and properties must be set"
+type OrganizationRulesetRepositories struct {
+	// Names selects the repositories by name.
+	// +optional
+	Names *RulesetRepositoryNames `json:"names,omitempty"`
+
+	// Properties selects the repositories by the values of their custom
+	// properties.
+	// +optional
+	Properties *RulesetRepositoryProperties `json:"properties,omitempty"`
+}
+
+// RulesetRepositoryNames select repositories by name.
+type RulesetRepositoryNames struct {
+	// Include is the list of the names or patterns of the repositories the
+	// ruleset applies to, e.g. service-*, or ~ALL for all of them.
+	Include []string `json:"include"`
+
+	// Exclude is the list of the names or patterns of the repositories the
+	// ruleset doesn't apply to.
+	// +optional
+	Exclude []string `json:"exclude,omitempty"`
+
+	// Protected prevents the repositories the ruleset applies to from being
+	// renamed, as that could exclude them.
+	// +optional
+	Protected *bool `json:"protected,omitempty"`
+}
+
+// RulesetRepositoryProperties select repositories by the values of their
+// custom properties. A repository is selected if it matches all of the
+// included properties and none of the excluded ones.
+type RulesetRepositoryProperties struct {
+	// Include is the list of the properties the repositories the ruleset
+	// applies to must match.
+	// +optional
+	Include []RulesetRepositoryProperty `json:"include,omitempty"`
+
+	// Exclude is the list of the properties the repositories the ruleset
+	// applies to must not match.
+	// +optional
+	Exclude []RulesetRepositoryProperty `json:"exclude,omitempty"`
+}
+
+// RulesetRepositoryProperty matches the repositories whose custom property
+// Name has one of Values.
+type RulesetRepositoryProperty struct {
+	// Name is the name of the custom property
+	Name string `json:"name"`
+
+	// Values is the list of the values of the property to match
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values"`
+}
+
+// OrganizationRulesetObservation are the observable fields of an
+// OrganizationRuleset.
+type OrganizationRulesetObservation struct {
+	// ID is the ID of the ruleset
+	ID int64 `json:"id,omitempty"`
+}
+
+// An OrganizationRulesetSpec defines the desired state of an
+// OrganizationRuleset.
+type OrganizationRulesetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       OrganizationRulesetParameters `json:"forProvider"`
+}
+
+// An OrganizationRulesetStatus represents the observed state of an
+// OrganizationRuleset.
+type OrganizationRulesetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          OrganizationRulesetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// An OrganizationRuleset is a ruleset of an organization, which applies to the
+// repositories it selects by name or by custom property. Its external name is
+// the ID of the ruleset, which is looked up by name when the ruleset is
+// imported.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="ORG",type="string",JSONPath=".spec.forProvider.org"
+// +kubebuilder:printcolumn:name="RULESET",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type OrganizationRuleset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OrganizationRulesetSpec   `json:"spec"`
+	Status OrganizationRulesetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// OrganizationRulesetList contains a list of OrganizationRuleset
+type OrganizationRulesetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []OrganizationRuleset `json:"items"`
+}
+
+// OrganizationRuleset type metadata.
+var (
+	OrganizationRulesetKind             = reflect.TypeOf(OrganizationRuleset{}).Name()
+	OrganizationRulesetGroupKind        = schema.GroupKind{Group: Group, Kind: OrganizationRulesetKind}.String()
+	OrganizationRulesetKindAPIVersion   = OrganizationRulesetKind + "." + SchemeGroupVersion.String()
+	OrganizationRulesetGroupVersionKind = SchemeGroupVersion.WithKind(OrganizationRulesetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&OrganizationRuleset{}, &OrganizationRulesetList{})
+}
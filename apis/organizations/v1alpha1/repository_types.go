@@ -30,12 +30,60 @@ type RepositoryParameters struct {
 	Description string                `json:"description,omitempty"`
 	Permissions RepositoryPermissions `json:"permissions,omitempty"`
 
+	// PermissionManagementPolicy determines how collaborators and teams that
+	// are not listed in permissions are handled. Full removes them, Patch only
+	// ensures the listed grants and leaves others alone, e.g. bot accounts
+	// added by GitHub Apps. Listed users whose access expired are removed
+	// with both policies.
+	// Default: Full
+	// +kubebuilder:validation:Enum=Full;Patch
+	// +optional
+	PermissionManagementPolicy *string `json:"permissionManagementPolicy,omitempty"`
+
+	// IgnoreOrganizationOwners leaves the owners of the organization, who are
+	// admins of every repository, out of the collaborators compared to the
+	// users in permissions unless they are listed there, so that they are
+	// never removed. Access granted through teams is never compared to the
+	// users in permissions.
+	// Default: true
+	// +optional
+	IgnoreOrganizationOwners *bool `json:"ignoreOrganizationOwners,omitempty"`
+
+	// Webhooks are the webhooks of the repository, keyed by their URL. A URL
+	// may only be listed once.
+	// +listType=map
+	// +listMapKey=url
+	// +optional
 	Webhooks []RepositoryWebhook `json:"webhooks,omitempty"`
 
-	BranchProtectionRules []BranchProtectionRule `json:"branchProtectionRules,omitempty"`
+	// WebhookManagementPolicy determines how webhooks that are not listed in
+	// webhooks are handled. Full deletes them, Patch leaves webhooks added by
+	// humans or apps alone and only reconciles the listed ones.
+	// Default: Full
+	// +kubebuilder:validation:Enum=Full;Patch
+	// +optional
+	WebhookManagementPolicy *string `json:"webhookManagementPolicy,omitempty"`
+
+	BranchProtectionRules []RepositoryBranchProtectionRule `json:"branchProtectionRules,omitempty"`
+
+	// BranchProtectionManagementPolicy determines how branch protection rules
+	// that are not listed in branchProtectionRules are handled. Full deletes
+	// them, Patch leaves them alone and Report leaves them alone, but lists
+	// them in the RulesDeclared condition.
+	// Default: Full
+	// +kubebuilder:validation:Enum=Full;Patch;Report
+	// +optional
+	BranchProtectionManagementPolicy *string `json:"branchProtectionManagementPolicy,omitempty"`
 
 	// RepositoryRules are the rules for the repository
-	RepositoryRules []RepositoryRuleset `json:"repositoryRules,omitempty"`
+	RepositoryRules []Ruleset `json:"repositoryRules,omitempty"`
+
+	// RulesetManagementPolicy determines how rulesets that are not listed in
+	// repositoryRules are handled, like BranchProtectionManagementPolicy.
+	// Default: Full
+	// +kubebuilder:validation:Enum=Full;Patch;Report
+	// +optional
+	RulesetManagementPolicy *string `json:"rulesetManagementPolicy,omitempty"`
 
 	// Creates a new repository using a repository template
 	CreateFromTemplate *TemplateRepo `json:"createFromTemplate,omitempty"`
@@ -56,11 +104,20 @@ type RepositoryParameters struct {
 	// +optional
 	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
 
-	// Archived sets if a repository should be archived on delete
+	// Archived sets if a repository should be archived. A repository is
+	// archived after all other changes are applied, and left as it is while
+	// archived. It is unarchived before other changes are applied, but only if
+	// Archived is explicitly false; a repository archived on GitHub while
+	// Archived is unset stays archived.
 	// +optional
 	Archived *bool `json:"archived,omitempty"`
 
-	// Safeguard for accidental deletion
+	// ForceDelete confirms that the repository is deleted on GitHub when the
+	// Repository is deleted with the Delete deletion policy. Without it the
+	// repository is never deleted, and the Repository is kept with a
+	// DeletionConfirmed condition of False until forceDelete is set or the
+	// deletion policy is changed to Orphan.
+	// +optional
 	ForceDelete *bool `json:"forceDelete,omitempty"`
 
 	// Private sets the repository to private, if false it will be public
@@ -70,6 +127,333 @@ type RepositoryParameters struct {
 	// Default: false
 	// +optional
 	IsTemplate *bool `json:"isTemplate,omitempty"`
+
+	// HasIssues enables issues for the repository. Defaults to the current
+	// setting of the repository.
+	// +optional
+	HasIssues *bool `json:"hasIssues,omitempty"`
+
+	// HasWiki enables the wiki of the repository. Defaults to the current
+	// setting of the repository.
+	// +optional
+	HasWiki *bool `json:"hasWiki,omitempty"`
+
+	// HasProjects enables projects for the repository. Defaults to the
+	// current setting of the repository.
+	// +optional
+	HasProjects *bool `json:"hasProjects,omitempty"`
+
+	// HasDiscussions enables discussions for the repository. Defaults to the
+	// current setting of the repository.
+	// +optional
+	HasDiscussions *bool `json:"hasDiscussions,omitempty"`
+
+	// HasDownloads enables downloads for the repository. Defaults to the
+	// current setting of the repository.
+	// +optional
+	HasDownloads *bool `json:"hasDownloads,omitempty"`
+
+	// Bootstrap configures actions that are run exactly once after the repository has been created.
+	// Completed actions are recorded in status.atProvider.completedBootstrapActions and never re-run.
+	// +optional
+	Bootstrap *RepositoryBootstrap `json:"bootstrap,omitempty"`
+
+	// CustomProperties are the values of organization custom properties, keyed
+	// by property name. Only the listed properties are managed, values of
+	// multi_select properties are not supported.
+	// +optional
+	CustomProperties map[string]string `json:"customProperties,omitempty"`
+
+	// DependabotSecrets are the repository Dependabot secrets. Their values are
+	// read from Kubernetes Secrets and encrypted with the public key of the
+	// repository. Secrets that are not listed are left untouched.
+	// +optional
+	DependabotSecrets []RepositorySecret `json:"dependabotSecrets,omitempty"`
+
+	// CodespacesSecrets are the repository Codespaces secrets. They are set in
+	// the same way as the Dependabot secrets.
+	// +optional
+	CodespacesSecrets []RepositorySecret `json:"codespacesSecrets,omitempty"`
+
+	// Environments are the deployment environments of the repository, with
+	// the branches and tags that may deploy to them. Environments that are not
+	// listed are left untouched, as are the reviewers and wait timers of the
+	// listed ones.
+	// +optional
+	Environments []RepositoryEnvironment `json:"environments,omitempty"`
+
+	// CodeScanning is the CodeQL default setup of code scanning of the
+	// repository. It is left as it is if CodeScanning is not set.
+	// +optional
+	CodeScanning *RepositoryCodeScanning `json:"codeScanning,omitempty"`
+
+	// OIDCSubjectClaim is the template of the subject claim of the OIDC
+	// tokens of the workflows of the repository. It is left as it is if
+	// OIDCSubjectClaim is not set.
+	// +optional
+	OIDCSubjectClaim *OIDCSubjectClaim `json:"oidcSubjectClaim,omitempty"`
+
+	// SecretScanningDelegatedBypass is the delegated bypass of secret
+	// scanning push protection of the repository. It is left as it is if
+	// SecretScanningDelegatedBypass is not set.
+	// +optional
+	SecretScanningDelegatedBypass *SecretScanningDelegatedBypass `json:"secretScanningDelegatedBypass,omitempty"`
+
+	// ValidateCodeowners reports the errors GitHub finds in the CODEOWNERS
+	// file of the default branch, such as unknown users or teams, in the
+	// CodeownersValid condition. The file itself is not managed.
+	// +optional
+	ValidateCodeowners *bool `json:"validateCodeowners,omitempty"`
+}
+
+// RepositoryCodeScanning is the CodeQL default setup of code scanning of a
+// repository.
+type RepositoryCodeScanning struct {
+	// State is whether the default setup is configured.
+	// +kubebuilder:validation:Enum=configured;not-configured
+	State string `json:"state"`
+
+	// QuerySuite is the CodeQL query suite that is run, default or extended.
+	// GitHub uses the default suite if it is not set.
+	// +kubebuilder:validation:Enum=default;extended
+	// +optional
+	QuerySuite *string `json:"querySuite,omitempty"`
+
+	// Languages are the languages that are analyzed, e.g. go or
+	// javascript-typescript. GitHub analyzes the languages it detects if
+	// they are not set.
+	// +listType=set
+	// +optional
+	Languages []string `json:"languages,omitempty"`
+}
+
+// SecretScanningDelegatedBypass is the delegated bypass of secret scanning
+// push protection of a repository. While it is enabled, only the reviewers
+// can bypass push protection, and everyone else has to request their
+// approval.
+type SecretScanningDelegatedBypass struct {
+	// Enabled is whether bypassing push protection needs the approval of a
+	// reviewer.
+	Enabled bool `json:"enabled"`
+
+	// ReviewerTeams are the teams that review requests to bypass push
+	// protection.
+	// +optional
+	ReviewerTeams []SecretScanningBypassReviewerTeam `json:"reviewerTeams,omitempty"`
+}
+
+// SecretScanningBypassReviewerTeam is a team that reviews requests to bypass
+// push protection.
+type SecretScanningBypassReviewerTeam struct {
+	// Team is the name of the team
+	// +crossplane:generate:reference:type=Team
+	Team string `json:"team,omitempty"`
+
+	// TeamRef is a reference to a Team
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+
+	// TeamSelector selects a reference to a Team
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+}
+
+// RepositoryEnvironment is a deployment environment of a repository.
+type RepositoryEnvironment struct {
+	// Name of the environment.
+	Name string `json:"name"`
+
+	// ProtectedBranches only lets branches with a branch protection rule
+	// deploy to the environment. It can't be combined with
+	// DeploymentBranchPolicies.
+	// +optional
+	ProtectedBranches *bool `json:"protectedBranches,omitempty"`
+
+	// DeploymentBranchPolicies are the name patterns of the branches and tags
+	// that may deploy to the environment. Any branch or tag may deploy if
+	// neither these nor ProtectedBranches are set.
+	// +optional
+	DeploymentBranchPolicies []DeploymentBranchPolicy `json:"deploymentBranchPolicies,omitempty"`
+}
+
+// RepositoryEnvironmentObservation is what gates the deployments to an
+// environment of a repository.
+type RepositoryEnvironmentObservation struct {
+	// Name of the environment.
+	Name string `json:"name"`
+
+	// WaitTimer is the number of minutes deployments to the environment wait
+	// before they proceed.
+	WaitTimer *int `json:"waitTimer,omitempty"`
+
+	// Reviewers are the users and teams of which one must approve deployments
+	// to the environment.
+	Reviewers []EnvironmentReviewer `json:"reviewers,omitempty"`
+
+	// PreventSelfReview is whether whoever triggered a deployment can't
+	// approve it.
+	PreventSelfReview *bool `json:"preventSelfReview,omitempty"`
+}
+
+// EnvironmentReviewer is a user or team that may approve deployments to an
+// environment.
+type EnvironmentReviewer struct {
+	// Type is whether the reviewer is a User or a Team.
+	Type string `json:"type"`
+
+	// ID is the ID of the user or team.
+	ID int64 `json:"id"`
+
+	// Name is the login of the user or the slug of the team.
+	Name string `json:"name"`
+}
+
+// DeploymentBranchPolicy is a name pattern of the branches or tags that may
+// deploy to an environment.
+type DeploymentBranchPolicy struct {
+	// Name is the fnmatch pattern the names of the branches or tags match,
+	// e.g. release/*.
+	Name string `json:"name"`
+
+	// Type is whether the pattern matches branches or tags.
+	// Default: branch
+	// +kubebuilder:validation:Enum=branch;tag
+	// +optional
+	Type *string `json:"type,omitempty"`
+}
+
+// RepositorySecret is a secret of a repository whose value is read from a
+// Kubernetes Secret.
+type RepositorySecret struct {
+	// Name of the GitHub secret
+	Name string `json:"name"`
+
+	// ValueSecretRef references the key of a Kubernetes Secret that holds the
+	// value of the secret.
+	ValueSecretRef xpv1.SecretKeySelector `json:"valueSecretRef"`
+}
+
+// RepositoryBootstrap represents the actions that are run once after a repository has been created.
+type RepositoryBootstrap struct {
+	// Files are seed files that are committed to the default branch.
+	// +optional
+	Files []BootstrapFile `json:"files,omitempty"`
+
+	// Templates are issue and pull request templates that are committed to
+	// the default branch from ConfigMaps, after the seed files.
+	// +optional
+	Templates *BootstrapTemplates `json:"templates,omitempty"`
+
+	// Environments are the names of deployment environments to create.
+	// +optional
+	Environments []string `json:"environments,omitempty"`
+
+	// Issue is a tracking issue that is opened in the repository.
+	// +optional
+	Issue *BootstrapIssue `json:"issue,omitempty"`
+
+	// CommitStatuses are commit statuses that are set on the head of the default branch, so that
+	// required status checks are known before CI first reports them.
+	// +optional
+	CommitStatuses []BootstrapCommitStatus `json:"commitStatuses,omitempty"`
+
+	// WorkflowDispatches are workflow_dispatch events that are triggered after the other actions ran.
+	// +optional
+	WorkflowDispatches []BootstrapWorkflowDispatch `json:"workflowDispatches,omitempty"`
+}
+
+// BootstrapFile represents a file that is committed to a newly created repository.
+// A file that already exists at its path is left as it is.
+type BootstrapFile struct {
+	// Path is the path of the file in the repository.
+	Path string `json:"path"`
+
+	// Content is the unencoded content of the file.
+	Content string `json:"content"`
+
+	// Message is the commit message.
+	// Default: "Add <path>"
+	// +optional
+	Message *string `json:"message,omitempty"`
+}
+
+// BootstrapTemplates represents the issue and pull request templates that are
+// committed to a newly created repository.
+type BootstrapTemplates struct {
+	// IssueTemplatesRef refers to a ConfigMap whose entries are committed as
+	// issue templates to .github/ISSUE_TEMPLATE, named by their keys, e.g.
+	// bug_report.md or config.yml.
+	// +optional
+	IssueTemplatesRef *ConfigMapReference `json:"issueTemplatesRef,omitempty"`
+
+	// PullRequestTemplateRef selects the key of a ConfigMap whose value is
+	// committed as .github/PULL_REQUEST_TEMPLATE.md.
+	// +optional
+	PullRequestTemplateRef *ConfigMapKeySelector `json:"pullRequestTemplateRef,omitempty"`
+}
+
+// A ConfigMapReference is a reference to a ConfigMap in a namespace.
+type ConfigMapReference struct {
+	// Name of the ConfigMap.
+	Name string `json:"name"`
+
+	// Namespace of the ConfigMap.
+	Namespace string `json:"namespace"`
+}
+
+// A ConfigMapKeySelector selects a key of a ConfigMap in a namespace.
+type ConfigMapKeySelector struct {
+	ConfigMapReference `json:",inline"`
+
+	// Key of the ConfigMap whose value is selected.
+	Key string `json:"key"`
+}
+
+// BootstrapIssue represents an issue that is opened in a newly created repository.
+// An open issue with the same title is taken as the issue, so that it is never
+// opened twice.
+type BootstrapIssue struct {
+	// Title is the title of the issue.
+	Title string `json:"title"`
+
+	// Body is the body of the issue.
+	// +optional
+	Body *string `json:"body,omitempty"`
+
+	// Labels are the labels to add to the issue.
+	// +optional
+	Labels []string `json:"labels,omitempty"`
+}
+
+// BootstrapCommitStatus represents a commit status that is set on the default branch of a newly
+// created repository.
+type BootstrapCommitStatus struct {
+	// Context is the name of the status check, e.g. ci/build.
+	Context string `json:"context"`
+
+	// State is the state of the commit status. Commit statuses have no neutral state, a
+	// successful status satisfies a required status check.
+	// Default: success
+	// +kubebuilder:validation:Enum=error;failure;pending;success
+	// +optional
+	State *string `json:"state,omitempty"`
+
+	// Description is a short description of the commit status.
+	// +optional
+	Description *string `json:"description,omitempty"`
+}
+
+// BootstrapWorkflowDispatch represents a workflow_dispatch event triggered in a newly created repository.
+type BootstrapWorkflowDispatch struct {
+	// Workflow is the file name of the workflow, e.g. bootstrap.yaml.
+	Workflow string `json:"workflow"`
+
+	// Ref is the branch or tag to run the workflow on.
+	Ref string `json:"ref"`
+
+	// Inputs are the input keys and values configured in the workflow file.
+	// +optional
+	Inputs map[string]string `json:"inputs,omitempty"`
 }
 
 // RepositoryParameters are the configurable fields of a Repository.
@@ -78,6 +462,7 @@ type RepositoryPermissions struct {
 	Teams []RepositoryTeam `json:"teams,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.user) || has(self.userRef) || has(self.userSelector)",message="one of user, userRef or userSelector is required"
 type RepositoryUser struct {
 	// Name is the name of the user
 	// +crossplane:generate:reference:type=Membership
@@ -91,10 +476,21 @@ type RepositoryUser struct {
 	// +optional
 	UserSelector *xpv1.Selector `json:"userSelector,omitempty"`
 
-	// Role is the role of the user
+	// Role is the role of the user, one of pull, triage, push, maintain, admin,
+	// read and write, which are the names of the pull and push roles, or the
+	// name of a custom repository role of the organization. Custom
+	// roles are validated against the roles of the organization when the
+	// Repository is reconciled.
+	// +kubebuilder:validation:MinLength=1
 	Role string `json:"role"`
+
+	// ExpiresAt is the time the access of the user expires. Once it has passed,
+	// the user is removed as a collaborator of the repository.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
 }
 
+// +kubebuilder:validation:XValidation:rule="has(self.team) || has(self.teamRef) || has(self.teamSelector)",message="one of team, teamRef or teamSelector is required"
 type RepositoryTeam struct {
 	// Team is the name of the team
 	// +crossplane:generate:reference:type=Team
@@ -108,7 +504,12 @@ type RepositoryTeam struct {
 	// +optional
 	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
 
-	// Role is the role of the team
+	// Role is the role of the team, one of pull, triage, push, maintain, admin,
+	// read and write, which are the names of the pull and push roles, or the
+	// name of a custom repository role of the organization. Custom
+	// roles are validated against the roles of the organization when the
+	// Repository is reconciled.
+	// +kubebuilder:validation:MinLength=1
 	Role string `json:"role"`
 }
 
@@ -131,16 +532,58 @@ type RepositoryWebhook struct {
 	// Determines what events the hook is triggered for. See https://docs.github.com/en/webhooks/webhook-events-and-payloads
 	Events []string `json:"events"`
 
+	// AllowUnknownEvents accepts events that the provider doesn't know GitHub
+	// delivers to repository webhooks, e.g. ones GitHub added recently,
+	// without reporting them in the WebhookEventsValid condition.
+	// Default: false
+	// +optional
+	AllowUnknownEvents *bool `json:"allowUnknownEvents,omitempty"`
+
 	// Determines if notifications are sent when the webhook is triggered.
 	// Default: true
 	// +optional
 	Active *bool `json:"active,omitempty"`
+
+	// PingOnCreate pings the webhook right after it is created, so that the
+	// status reports whether its URL accepts deliveries before any event
+	// triggered it. Inactive webhooks are not pinged.
+	// Default: false
+	// +optional
+	PingOnCreate *bool `json:"pingOnCreate,omitempty"`
+}
+
+// RepositoryWebhookObservation is the last delivery of a webhook of the
+// repository.
+type RepositoryWebhookObservation struct {
+	// URL of the webhook.
+	URL string `json:"url"`
+
+	// ID of the webhook.
+	ID int64 `json:"id"`
+
+	// LastDeliveryStatusCode is the HTTP status code the URL of the webhook
+	// answered its last delivery with, 0 if it couldn't be delivered.
+	// +optional
+	LastDeliveryStatusCode *int `json:"lastDeliveryStatusCode,omitempty"`
+
+	// LastDeliveryStatus describes the outcome of the last delivery, e.g. OK
+	// or the error that prevented it.
+	// +optional
+	LastDeliveryStatus string `json:"lastDeliveryStatus,omitempty"`
+
+	// LastDeliveryTime is when the webhook was last delivered. It is unset if
+	// the webhook was never delivered.
+	// +optional
+	LastDeliveryTime *metav1.Time `json:"lastDeliveryTime,omitempty"`
 }
 
-// BranchProtectionRule represents a rule for protecting a branch in a repository.
+// RepositoryBranchProtectionRule represents a rule for protecting a branch in a repository.
 // It includes various parameters for enforcing code quality and access control.
-type BranchProtectionRule struct {
-	// The branch name to apply the protection rule to.
+type RepositoryBranchProtectionRule struct {
+	// The branch name to apply the protection rule to. Patterns like "release/*"
+	// protect all matching branches, including branches that don't exist yet.
+	// Rules for patterns don't support status check app IDs, dismissal restrictions,
+	// bypass allowances or push restrictions for specific users, teams and apps.
 	Branch string `json:"branch"`
 
 	// Require status checks to pass before merging.
@@ -230,6 +673,8 @@ type RequiredPullRequestReviews struct {
 	RequireCodeOwnerReviews bool `json:"requireCodeOwnerReviews"`
 
 	// Specify the number of reviewers required to approve pull requests. Use a number between 1 and 6 or 0 to not require reviewers.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=6
 	RequiredApprovingReviewCount int `json:"requiredApprovingReviewCount"`
 
 	// Whether the most recent push must be approved by someone other than the person who pushed it.
@@ -295,14 +740,17 @@ type BranchProtectionRestrictions struct {
 	Apps []string `json:"apps,omitempty"`
 }
 
-// RepositoryRuleset represents the rules for a repository
-type RepositoryRuleset struct {
+// Ruleset represents the rules for a repository
+type Ruleset struct {
 	// Name is the name of the ruleset
 	Name string `json:"name"`
 	// Enforcement is the enforcement level of the ruleset, can be one of: "disabled", "active"
 	// +optional
 	Enforcement *string `json:"enforcement,omitempty"`
-	// Target is the target of the ruleset, can be one of: "branch", "tag"
+	// Target is the target of the ruleset, can be one of: "branch", "tag", "push". Defaults to "branch".
+	// Rulesets targeting "tag" protect the tags matched by Conditions and don't support the
+	// requiredLinearHistory, requiredDeployments, pullRequest, requiredStatusChecks,
+	// branchNamePattern and workflows rules.
 	// +optional
 	Target *string `json:"target,omitempty"`
 	// BypassActors is the list of actors that can bypass the ruleset
@@ -317,9 +765,24 @@ type RepositoryRuleset struct {
 }
 
 type RulesetByPassActors struct {
-	// ActorId is the ID of the actor
+	// ActorId is the ID of the actor. Either ActorId, Team or AppSlug must be set.
 	// +optional
 	ActorId *int64 `json:"actorId,omitempty"`
+	// Team is the name of a team of the organization to resolve the ActorId from.
+	// The ActorType defaults to Team.
+	// +crossplane:generate:reference:type=Team
+	// +optional
+	Team string `json:"team,omitempty"`
+	// TeamRef is a reference to a Team
+	// +optional
+	TeamRef *xpv1.Reference `json:"teamRef,omitempty"`
+	// TeamSelector selects a reference to a Team
+	// +optional
+	TeamSelector *xpv1.Selector `json:"teamSelector,omitempty"`
+	// AppSlug is the slug of a GitHub App to resolve the ActorId from.
+	// The ActorType defaults to Integration.
+	// +optional
+	AppSlug *string `json:"appSlug,omitempty"`
 	// ActorType is the type of the actor, can be one of: Integration, OrganizationAdmin, RepositoryRole, Team
 	// +optional
 	ActorType *string `json:"actorType,omitempty"`
@@ -333,9 +796,10 @@ type RulesetConditions struct {
 }
 
 type RulesetRefName struct {
-	// Include is the list of branches or tags to include
+	// Include is the list of branches or tags to include, e.g. refs/heads/main, refs/tags/v* or ~ALL.
+	// ~DEFAULT_BRANCH only matches branches.
 	Include []string `json:"include"`
-	// Exclude is the list of branches or tags to exclude
+	// Exclude is the list of branches or tags to exclude, in the same format as Include
 	Exclude []string `json:"exclude"`
 }
 
@@ -367,6 +831,100 @@ type Rules struct {
 	// NonFastForward restricts force pushes to matching branches or tags that are set in Conditions
 	// +optional
 	NonFastForward *bool `json:"nonFastForward,omitempty"`
+	// CommitMessagePattern restricts the commit messages that can be pushed to matching branches.
+	// +optional
+	CommitMessagePattern *RulesPattern `json:"commitMessagePattern,omitempty"`
+	// CommitAuthorEmailPattern restricts the commit author emails that can be pushed to matching branches.
+	// +optional
+	CommitAuthorEmailPattern *RulesPattern `json:"commitAuthorEmailPattern,omitempty"`
+	// CommitterEmailPattern restricts the committer emails that can be pushed to matching branches.
+	// +optional
+	CommitterEmailPattern *RulesPattern `json:"committerEmailPattern,omitempty"`
+	// BranchNamePattern restricts the names of the branches that can be pushed.
+	// +optional
+	BranchNamePattern *RulesPattern `json:"branchNamePattern,omitempty"`
+	// TagNamePattern restricts the names of the tags that can be pushed.
+	// +optional
+	TagNamePattern *RulesPattern `json:"tagNamePattern,omitempty"`
+	// Workflows requires workflows to pass before merging.
+	// +optional
+	Workflows *RulesWorkflows `json:"workflows,omitempty"`
+	// FilePathRestriction prevents commits that include changes to the specified file paths from being pushed.
+	// Only applies to rulesets with target "push".
+	// +optional
+	FilePathRestriction *RulesFilePathRestriction `json:"filePathRestriction,omitempty"`
+	// MaxFilePathLength prevents commits that include file paths exceeding the specified length from being pushed.
+	// Only applies to rulesets with target "push".
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=256
+	// +optional
+	MaxFilePathLength *int `json:"maxFilePathLength,omitempty"`
+	// FileExtensionRestriction prevents commits that include files with the specified extensions from being pushed.
+	// Only applies to rulesets with target "push".
+	// +optional
+	FileExtensionRestriction *RulesFileExtensionRestriction `json:"fileExtensionRestriction,omitempty"`
+	// MaxFileSize prevents commits that include files larger than the specified size in MB from being pushed.
+	// Only applies to rulesets with target "push".
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxFileSize *int `json:"maxFileSize,omitempty"`
+}
+
+type RulesFilePathRestriction struct {
+	// RestrictedFilePaths is the list of file paths that are restricted from being pushed, e.g. secrets/**
+	RestrictedFilePaths []string `json:"restrictedFilePaths"`
+}
+
+type RulesFileExtensionRestriction struct {
+	// RestrictedFileExtensions is the list of file extensions that are restricted from being pushed, e.g. *.zip
+	RestrictedFileExtensions []string `json:"restrictedFileExtensions"`
+}
+
+type RulesWorkflows struct {
+	// Workflows is the list of workflows that must pass before merging.
+	Workflows []*RulesWorkflow `json:"workflows"`
+}
+
+type RulesWorkflow struct {
+	// Path is the path to the workflow file, e.g. .github/workflows/ci.yaml
+	Path string `json:"path"`
+	// Repo is the name of the repository in the organization containing the workflow.
+	// Defaults to the repository the ruleset applies to.
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	// +optional
+	Repo string `json:"repo,omitempty"`
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+	// RepositoryId is the ID of the repository containing the workflow. It takes
+	// precedence over Repo.
+	// +optional
+	RepositoryId *int64 `json:"repositoryId,omitempty"`
+	// Ref is the branch or tag of the workflow file to use.
+	// +optional
+	Ref *string `json:"ref,omitempty"`
+	// Sha is the commit SHA of the workflow file to use.
+	// +optional
+	Sha *string `json:"sha,omitempty"`
+}
+
+type RulesPattern struct {
+	// Name is how this rule will appear to users.
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// Operator is the operator to use for matching.
+	// +kubebuilder:validation:Enum=starts_with;ends_with;contains;regex
+	Operator string `json:"operator"`
+	// Pattern is the pattern to match with.
+	Pattern string `json:"pattern"`
+	// Negate makes the rule fail if the pattern matches.
+	// +optional
+	Negate *bool `json:"negate,omitempty"`
 }
 
 type RulesRequiredDeployments struct {
@@ -386,6 +944,8 @@ type RulesPullRequest struct {
 	// +optional
 	RequireLastPushApproval *bool `json:"requireLastPushApproval,omitempty"`
 	// RequiredApprovingReviewCount specifies the number of reviewers required to approve pull requests.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10
 	// +optional
 	RequiredApprovingReviewCount *int `json:"requiredApprovingReviewCount,omitempty"`
 	// RequiredReviewThreadResolution requires all conversations on code to be resolved before a pull request can be merged.
@@ -436,6 +996,120 @@ type RepoFork struct {
 // RepositoryObservation are the observable fields of a Repository.
 type RepositoryObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+
+	// ID of the repository.
+	ID *int64 `json:"id,omitempty"`
+
+	// NodeID is the GraphQL node ID of the repository.
+	NodeID string `json:"nodeId,omitempty"`
+
+	// FullName of the repository, e.g. octo-org/octo-repo.
+	FullName string `json:"fullName,omitempty"`
+
+	// PreviousNames are the names the repository was renamed from by changing
+	// the external name of the Repository, oldest first.
+	PreviousNames []string `json:"previousNames,omitempty"`
+
+	// HTMLURL is the URL of the repository on GitHub.
+	HTMLURL string `json:"htmlUrl,omitempty"`
+
+	// SSHURL is the URL to clone the repository with SSH.
+	SSHURL string `json:"sshUrl,omitempty"`
+
+	// CloneURL is the URL to clone the repository with HTTPS.
+	CloneURL string `json:"cloneUrl,omitempty"`
+
+	// DefaultBranch of the repository.
+	DefaultBranch string `json:"defaultBranch,omitempty"`
+
+	// Visibility of the repository, one of public, private and internal.
+	Visibility string `json:"visibility,omitempty"`
+
+	// Archived is whether the repository is archived.
+	Archived *bool `json:"archived,omitempty"`
+
+	// CreatedAt is when the repository was created.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// UpdatedAt is when the settings of the repository were last changed.
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// PushedAt is when a commit was last pushed to the repository.
+	PushedAt *metav1.Time `json:"pushedAt,omitempty"`
+
+	// Webhooks are the last deliveries of the webhooks of the spec.
+	Webhooks []RepositoryWebhookObservation `json:"webhooks,omitempty"`
+
+	// Environments are what gates the deployments to the environments of the
+	// spec that exist, so that they can be audited from the cluster.
+	Environments []RepositoryEnvironmentObservation `json:"environments,omitempty"`
+
+	// CompletedBootstrapActions are the bootstrap actions that already ran for this repository.
+	CompletedBootstrapActions []string `json:"completedBootstrapActions,omitempty"`
+
+	// RulesetIDs are the IDs of the repositoryRules of the spec on GitHub,
+	// keyed by ruleset name. A ruleset that is renamed in the spec is updated
+	// by its ID rather than created anew.
+	RulesetIDs map[string]int64 `json:"rulesetIds,omitempty"`
+
+	// PendingBranchProtectionRules are the branches with a protection rule that
+	// don't exist yet. Their protection is applied once they are pushed.
+	PendingBranchProtectionRules []string `json:"pendingBranchProtectionRules,omitempty"`
+
+	// ExportedParameters are the parameters, rendered as YAML, that reproduce the
+	// live settings of the repository. They are only exported while the
+	// github.crossplane.io/export-parameters annotation is set to "true".
+	ExportedParameters string `json:"exportedParameters,omitempty"`
+
+	// UnmodeledSettings are the settings of the repository that differ from the
+	// GitHub defaults, but can't be expressed in the parameters yet. They are
+	// only exported along with the parameters.
+	UnmodeledSettings []string `json:"unmodeledSettings,omitempty"`
+
+	// DependabotSecretVersions are the resource versions of the Kubernetes
+	// Secrets the Dependabot secrets were last set from, keyed by secret name.
+	DependabotSecretVersions map[string]string `json:"dependabotSecretVersions,omitempty"`
+
+	// CodespacesSecretVersions are the resource versions of the Kubernetes
+	// Secrets the Codespaces secrets were last set from, keyed by secret name.
+	CodespacesSecretVersions map[string]string `json:"codespacesSecretVersions,omitempty"`
+
+	// Drift reports what differs between the spec and the repository, i.e.
+	// what the next update is about to change. It is empty while the
+	// repository is up to date.
+	Drift *DriftReport `json:"drift,omitempty"`
+}
+
+// A DriftReport lists the changes an update of a Repository is about to make,
+// in the manner of a JSON patch of its spec.forProvider.
+type DriftReport struct {
+	// Changes are the changes, at most 50.
+	Changes []DriftChange `json:"changes,omitempty"`
+
+	// Truncated is whether there are more changes than listed.
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// A DriftChange is a change an update of a Repository is about to make.
+type DriftChange struct {
+	// Op is how the field is changed, one of add, remove and replace.
+	Op string `json:"op"`
+
+	// Path is the JSON pointer of the field in spec.forProvider, with the key
+	// of an entry for entries of lists and maps, e.g.
+	// /webhooks/https:~1~1example.org~1hook.
+	Path string `json:"path"`
+
+	// Value is the desired value of the field as JSON, cut to 256 characters.
+	// Fields whose values aren't compared as a whole, e.g. rulesets, have
+	// none.
+	// +optional
+	Value string `json:"value,omitempty"`
+
+	// Observed is the value of the field on GitHub as JSON, cut to 256
+	// characters.
+	// +optional
+	Observed string `json:"observed,omitempty"`
 }
 
 // A RepositorySpec defines the desired state of a Repository.
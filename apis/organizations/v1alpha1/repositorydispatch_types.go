@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RepositoryDispatchParameters are the configurable fields of a
+// RepositoryDispatch.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type RepositoryDispatchParameters struct {
+	// Org is the Organization of the repository
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository the event is dispatched to
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// EventType is the type of the repository_dispatch event that workflows
+	// select with on.repository_dispatch.types, e.g. bootstrap
+	// +kubebuilder:validation:MaxLength=100
+	EventType string `json:"eventType"`
+
+	// ClientPayload are the keys and values of the client_payload of the
+	// event, at most 10
+	// +kubebuilder:validation:MaxProperties=10
+	// +optional
+	ClientPayload map[string]string `json:"clientPayload,omitempty"`
+}
+
+// RepositoryDispatchObservation are the observable fields of a
+// RepositoryDispatch.
+type RepositoryDispatchObservation struct {
+	// DispatchedGeneration is the generation of the spec the event was last
+	// dispatched for
+	DispatchedGeneration *int64 `json:"dispatchedGeneration,omitempty"`
+
+	// DispatchedAt is when the event was last dispatched
+	DispatchedAt *metav1.Time `json:"dispatchedAt,omitempty"`
+}
+
+// A RepositoryDispatchSpec defines the desired state of a RepositoryDispatch.
+type RepositoryDispatchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryDispatchParameters `json:"forProvider"`
+}
+
+// A RepositoryDispatchStatus represents the observed state of a
+// RepositoryDispatch.
+type RepositoryDispatchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryDispatchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryDispatch sends a repository_dispatch event to a repository once
+// for every generation of its spec, e.g. to start the workflows that
+// bootstrap a repository after it is created. It becomes Ready once the event
+// was dispatched.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="EVENT-TYPE",type="string",JSONPath=".spec.forProvider.eventType"
+// +kubebuilder:printcolumn:name="DISPATCHED-AT",type="date",JSONPath=".status.atProvider.dispatchedAt"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryDispatch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryDispatchSpec   `json:"spec"`
+	Status RepositoryDispatchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryDispatchList contains a list of RepositoryDispatch
+type RepositoryDispatchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryDispatch `json:"items"`
+}
+
+// RepositoryDispatch type metadata.
+var (
+	RepositoryDispatchKind             = reflect.TypeOf(RepositoryDispatch{}).Name()
+	RepositoryDispatchGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryDispatchKind}.String()
+	RepositoryDispatchKindAPIVersion   = RepositoryDispatchKind + "." + SchemeGroupVersion.String()
+	RepositoryDispatchGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryDispatchKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RepositoryDispatch{}, &RepositoryDispatchList{})
+}
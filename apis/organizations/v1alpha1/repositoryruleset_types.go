@@ -0,0 +1,127 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// RepositoryRulesetParameters are the configurable fields of a
+// RepositoryRuleset.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type RepositoryRulesetParameters struct {
+	// Org is the Organization of the repository
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSelector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository the ruleset applies to
+	// +immutable
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// The settings of the ruleset are those of the repositoryRules of a
+	// Repository.
+	Ruleset `json:",inline"`
+}
+
+// RepositoryRulesetObservation are the observable fields of a
+// RepositoryRuleset.
+type RepositoryRulesetObservation struct {
+	// ID is the ID of the ruleset
+	ID int64 `json:"id,omitempty"`
+}
+
+// A RepositoryRulesetSpec defines the desired state of a
+// RepositoryRuleset.
+type RepositoryRulesetSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       RepositoryRulesetParameters `json:"forProvider"`
+}
+
+// A RepositoryRulesetStatus represents the observed state of a
+// RepositoryRuleset.
+type RepositoryRulesetStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          RepositoryRulesetObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A RepositoryRuleset is a ruleset of a repository, managed apart from the
+// Repository so that rulesets can be composed and shared across repositories.
+// Its external name is the ID of the ruleset, which is looked up by name when
+// the ruleset is imported. Repositories leave the rulesets of
+// RepositoryRulesets alone.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="REPO",type="string",JSONPath=".spec.forProvider.repo"
+// +kubebuilder:printcolumn:name="RULESET",type="string",JSONPath=".spec.forProvider.name"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type RepositoryRuleset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   RepositoryRulesetSpec   `json:"spec"`
+	Status RepositoryRulesetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// RepositoryRulesetList contains a list of RepositoryRuleset
+type RepositoryRulesetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []RepositoryRuleset `json:"items"`
+}
+
+// RepositoryRuleset type metadata.
+var (
+	RepositoryRulesetKind             = reflect.TypeOf(RepositoryRuleset{}).Name()
+	RepositoryRulesetGroupKind        = schema.GroupKind{Group: Group, Kind: RepositoryRulesetKind}.String()
+	RepositoryRulesetKindAPIVersion   = RepositoryRulesetKind + "." + SchemeGroupVersion.String()
+	RepositoryRulesetGroupVersionKind = SchemeGroupVersion.WithKind(RepositoryRulesetKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&RepositoryRuleset{}, &RepositoryRulesetList{})
+}
@@ -43,7 +43,9 @@ type TeamParameters struct {
 	// +optional
 	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
 
-	// Parent is the parent team of a Team
+	// Parent is the name of the parent team of a Team. The parent team has
+	// to exist before the Team is created, Teams of a tree applied at once are
+	// created from the root.
 	// +crossplane:generate:reference:type=Team
 	Parent *string `json:"parent,omitempty"`
 
@@ -57,6 +59,26 @@ type TeamParameters struct {
 
 	// Privacy represents the visibility of the team (secret, closed)
 	Privacy *string `json:"privacy,omitempty"`
+
+	// IdPGroups are the identity provider groups, e.g. of Azure AD or Okta,
+	// whose members team synchronization makes the members of the team.
+	// The groups of the team are only managed if at least one is listed, a
+	// team with groups can't have its Members managed.
+	// +optional
+	IdPGroups []TeamIdPGroup `json:"idpGroups,omitempty"`
+}
+
+// TeamIdPGroup is an identity provider group connected to a team.
+type TeamIdPGroup struct {
+	// GroupID is the ID of the group in the identity provider.
+	GroupID string `json:"groupId"`
+
+	// GroupName is the name of the group.
+	GroupName string `json:"groupName"`
+
+	// GroupDescription is the description of the group.
+	// +optional
+	GroupDescription *string `json:"groupDescription,omitempty"`
 }
 
 type TeamMemberUser struct {
@@ -96,6 +118,19 @@ type TeamMemberTeam struct {
 // TeamObservation are the observable fields of a Team.
 type TeamObservation struct {
 	ObservableField string `json:"observableField,omitempty"`
+
+	// ID of the team.
+	ID *int64 `json:"id,omitempty"`
+
+	// Slug of the team, which other teams refer to it by.
+	Slug string `json:"slug,omitempty"`
+
+	// ParentSlug is the slug of the parent team, if the team has one.
+	ParentSlug string `json:"parentSlug,omitempty"`
+
+	// IdPGroups are the identity provider groups connected to the team.
+	// Only observed if the spec lists groups.
+	IdPGroups []TeamIdPGroup `json:"idpGroups,omitempty"`
 }
 
 // A TeamSpec defines the desired state of a Team.
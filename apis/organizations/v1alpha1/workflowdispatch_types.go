@@ -0,0 +1,139 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+)
+
+// WorkflowDispatchParameters are the configurable fields of a WorkflowDispatch.
+// +kubebuilder:validation:XValidation:rule="has(self.org) || has(self.orgRef) || has(self.orgSelector)",message="one of org, orgRef or orgSelector is required"
+// +kubebuilder:validation:XValidation:rule="has(self.repo) || has(self.repoRef) || has(self.repoSelector)",message="one of repo, repoRef or repoSelector is required"
+type WorkflowDispatchParameters struct {
+	// Org is the Organization of the repository
+	// +immutable
+	// +crossplane:generate:reference:type=Organization
+	Org string `json:"org,omitempty"`
+
+	// OrgRef is a reference to an Organization
+	// +optional
+	OrgRef *xpv1.Reference `json:"orgRef,omitempty"`
+
+	// OrgSlector selects a reference to an Organization
+	// +optional
+	OrgSelector *xpv1.Selector `json:"orgSelector,omitempty"`
+
+	// Repo is the name of the repository containing the workflow
+	// +immutable
+	// +crossplane:generate:reference:type=Repository
+	// +crossplane:generate:reference:extractor=RepositoryName()
+	Repo string `json:"repo,omitempty"`
+
+	// RepoRef is a reference to a Repository
+	// +optional
+	RepoRef *xpv1.Reference `json:"repoRef,omitempty"`
+
+	// RepoSelector selects a reference to a Repository
+	// +optional
+	RepoSelector *xpv1.Selector `json:"repoSelector,omitempty"`
+
+	// Workflow is the file name of the workflow to dispatch, e.g. bootstrap.yaml
+	// +immutable
+	Workflow string `json:"workflow"`
+
+	// Ref is the git reference the workflow is run on
+	// +immutable
+	Ref string `json:"ref"`
+
+	// Inputs are the input keys and values configured in the workflow file
+	// +immutable
+	// +optional
+	Inputs map[string]string `json:"inputs,omitempty"`
+}
+
+// WorkflowDispatchObservation are the observable fields of a WorkflowDispatch.
+type WorkflowDispatchObservation struct {
+	// RunID is the ID of the workflow run started by the dispatch
+	RunID *int64 `json:"runId,omitempty"`
+
+	// Status is the status of the workflow run
+	Status *string `json:"status,omitempty"`
+
+	// Conclusion is the conclusion of the workflow run once it is completed
+	Conclusion *string `json:"conclusion,omitempty"`
+
+	// HTMLURL is the URL of the workflow run
+	HTMLURL *string `json:"htmlUrl,omitempty"`
+}
+
+// A WorkflowDispatchSpec defines the desired state of a WorkflowDispatch.
+type WorkflowDispatchSpec struct {
+	xpv1.ResourceSpec `json:",inline"`
+	ForProvider       WorkflowDispatchParameters `json:"forProvider"`
+}
+
+// A WorkflowDispatchStatus represents the observed state of a WorkflowDispatch.
+type WorkflowDispatchStatus struct {
+	xpv1.ResourceStatus `json:",inline"`
+	AtProvider          WorkflowDispatchObservation `json:"atProvider,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// A WorkflowDispatch triggers a workflow_dispatch event for a workflow exactly
+// once and tracks the resulting workflow run. It becomes Ready once the run
+// completed successfully.
+// +kubebuilder:printcolumn:name="READY",type="string",JSONPath=".status.conditions[?(@.type=='Ready')].status"
+// +kubebuilder:printcolumn:name="SYNCED",type="string",JSONPath=".status.conditions[?(@.type=='Synced')].status"
+// +kubebuilder:printcolumn:name="RUN-ID",type="string",JSONPath=".status.atProvider.runId"
+// +kubebuilder:printcolumn:name="CONCLUSION",type="string",JSONPath=".status.atProvider.conclusion"
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={crossplane,managed,github}
+type WorkflowDispatch struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   WorkflowDispatchSpec   `json:"spec"`
+	Status WorkflowDispatchStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// WorkflowDispatchList contains a list of WorkflowDispatch
+type WorkflowDispatchList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []WorkflowDispatch `json:"items"`
+}
+
+// WorkflowDispatch type metadata.
+var (
+	WorkflowDispatchKind             = reflect.TypeOf(WorkflowDispatch{}).Name()
+	WorkflowDispatchGroupKind        = schema.GroupKind{Group: Group, Kind: WorkflowDispatchKind}.String()
+	WorkflowDispatchKindAPIVersion   = WorkflowDispatchKind + "." + SchemeGroupVersion.String()
+	WorkflowDispatchGroupVersionKind = SchemeGroupVersion.WithKind(WorkflowDispatchKind)
+)
+
+func init() {
+	SchemeBuilder.Register(&WorkflowDispatch{}, &WorkflowDispatchList{})
+}
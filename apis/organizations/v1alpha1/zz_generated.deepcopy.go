@@ -23,6 +23,7 @@ package v1alpha1
 
 import (
 	"github.com/crossplane/crossplane-runtime/apis/common/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
@@ -54,6 +55,11 @@ func (in *ActionEnabledRepo) DeepCopy() *ActionEnabledRepo {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActionsConfiguration) DeepCopyInto(out *ActionsConfiguration) {
 	*out = *in
+	if in.EnabledRepositories != nil {
+		in, out := &in.EnabledRepositories, &out.EnabledRepositories
+		*out = new(string)
+		**out = **in
+	}
 	if in.EnabledRepos != nil {
 		in, out := &in.EnabledRepos, &out.EnabledRepos
 		*out = make([]ActionEnabledRepo, len(*in))
@@ -61,6 +67,11 @@ func (in *ActionsConfiguration) DeepCopyInto(out *ActionsConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OIDCSubjectClaim != nil {
+		in, out := &in.OIDCSubjectClaim, &out.OIDCSubjectClaim
+		*out = new(OIDCSubjectClaim)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsConfiguration.
@@ -74,179 +85,316 @@ func (in *ActionsConfiguration) DeepCopy() *ActionsConfiguration {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchProtectionRestrictions) DeepCopyInto(out *BranchProtectionRestrictions) {
+func (in *ActionsRunnerToken) DeepCopyInto(out *ActionsRunnerToken) {
 	*out = *in
-	if in.BlockCreations != nil {
-		in, out := &in.BlockCreations, &out.BlockCreations
-		*out = new(bool)
-		**out = **in
-	}
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerToken.
+func (in *ActionsRunnerToken) DeepCopy() *ActionsRunnerToken {
+	if in == nil {
+		return nil
 	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	out := new(ActionsRunnerToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ActionsRunnerToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.Apps != nil {
-		in, out := &in.Apps, &out.Apps
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsRunnerTokenList) DeepCopyInto(out *ActionsRunnerTokenList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ActionsRunnerToken, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRestrictions.
-func (in *BranchProtectionRestrictions) DeepCopy() *BranchProtectionRestrictions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerTokenList.
+func (in *ActionsRunnerTokenList) DeepCopy() *ActionsRunnerTokenList {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchProtectionRestrictions)
+	out := new(ActionsRunnerTokenList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ActionsRunnerTokenList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BranchProtectionRule) DeepCopyInto(out *BranchProtectionRule) {
+func (in *ActionsRunnerTokenObservation) DeepCopyInto(out *ActionsRunnerTokenObservation) {
 	*out = *in
-	if in.RequiredStatusChecks != nil {
-		in, out := &in.RequiredStatusChecks, &out.RequiredStatusChecks
-		*out = new(RequiredStatusChecks)
-		(*in).DeepCopyInto(*out)
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
 	}
-	if in.RequiredPullRequestReviews != nil {
-		in, out := &in.RequiredPullRequestReviews, &out.RequiredPullRequestReviews
-		*out = new(RequiredPullRequestReviews)
+	if in.RemoveTokenExpiresAt != nil {
+		in, out := &in.RemoveTokenExpiresAt, &out.RemoveTokenExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerTokenObservation.
+func (in *ActionsRunnerTokenObservation) DeepCopy() *ActionsRunnerTokenObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(ActionsRunnerTokenObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsRunnerTokenParameters) DeepCopyInto(out *ActionsRunnerTokenParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.BranchProtectionRestrictions != nil {
-		in, out := &in.BranchProtectionRestrictions, &out.BranchProtectionRestrictions
-		*out = new(BranchProtectionRestrictions)
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.RequireLinearHistory != nil {
-		in, out := &in.RequireLinearHistory, &out.RequireLinearHistory
-		*out = new(bool)
+	if in.RefreshBefore != nil {
+		in, out := &in.RefreshBefore, &out.RefreshBefore
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.AllowForcePushes != nil {
-		in, out := &in.AllowForcePushes, &out.AllowForcePushes
-		*out = new(bool)
-		**out = **in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerTokenParameters.
+func (in *ActionsRunnerTokenParameters) DeepCopy() *ActionsRunnerTokenParameters {
+	if in == nil {
+		return nil
 	}
-	if in.AllowDeletions != nil {
-		in, out := &in.AllowDeletions, &out.AllowDeletions
-		*out = new(bool)
-		**out = **in
+	out := new(ActionsRunnerTokenParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsRunnerTokenSpec) DeepCopyInto(out *ActionsRunnerTokenSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerTokenSpec.
+func (in *ActionsRunnerTokenSpec) DeepCopy() *ActionsRunnerTokenSpec {
+	if in == nil {
+		return nil
 	}
-	if in.RequiredConversationResolution != nil {
-		in, out := &in.RequiredConversationResolution, &out.RequiredConversationResolution
-		*out = new(bool)
-		**out = **in
+	out := new(ActionsRunnerTokenSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ActionsRunnerTokenStatus) DeepCopyInto(out *ActionsRunnerTokenStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ActionsRunnerTokenStatus.
+func (in *ActionsRunnerTokenStatus) DeepCopy() *ActionsRunnerTokenStatus {
+	if in == nil {
+		return nil
 	}
-	if in.LockBranch != nil {
-		in, out := &in.LockBranch, &out.LockBranch
-		*out = new(bool)
+	out := new(ActionsRunnerTokenStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BillingConfiguration) DeepCopyInto(out *BillingConfiguration) {
+	*out = *in
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
 		**out = **in
 	}
-	if in.AllowForkSyncing != nil {
-		in, out := &in.AllowForkSyncing, &out.AllowForkSyncing
-		*out = new(bool)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingConfiguration.
+func (in *BillingConfiguration) DeepCopy() *BillingConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BillingObservation) DeepCopyInto(out *BillingObservation) {
+	*out = *in
+	if in.RefreshTime != nil {
+		in, out := &in.RefreshTime, &out.RefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ActionsMinutesUsedBreakdown != nil {
+		in, out := &in.ActionsMinutesUsedBreakdown, &out.ActionsMinutesUsedBreakdown
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BillingObservation.
+func (in *BillingObservation) DeepCopy() *BillingObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BillingObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapCommitStatus) DeepCopyInto(out *BootstrapCommitStatus) {
+	*out = *in
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
 		**out = **in
 	}
-	if in.RequireSignedCommits != nil {
-		in, out := &in.RequireSignedCommits, &out.RequireSignedCommits
-		*out = new(bool)
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRule.
-func (in *BranchProtectionRule) DeepCopy() *BranchProtectionRule {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapCommitStatus.
+func (in *BootstrapCommitStatus) DeepCopy() *BootstrapCommitStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(BranchProtectionRule)
+	out := new(BootstrapCommitStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *BypassPullRequestAllowancesRequest) DeepCopyInto(out *BypassPullRequestAllowancesRequest) {
+func (in *BootstrapFile) DeepCopyInto(out *BootstrapFile) {
 	*out = *in
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+	if in.Message != nil {
+		in, out := &in.Message, &out.Message
+		*out = new(string)
+		**out = **in
 	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = make([]string, len(*in))
-		copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapFile.
+func (in *BootstrapFile) DeepCopy() *BootstrapFile {
+	if in == nil {
+		return nil
 	}
-	if in.Apps != nil {
-		in, out := &in.Apps, &out.Apps
+	out := new(BootstrapFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapIssue) DeepCopyInto(out *BootstrapIssue) {
+	*out = *in
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BypassPullRequestAllowancesRequest.
-func (in *BypassPullRequestAllowancesRequest) DeepCopy() *BypassPullRequestAllowancesRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapIssue.
+func (in *BootstrapIssue) DeepCopy() *BootstrapIssue {
 	if in == nil {
 		return nil
 	}
-	out := new(BypassPullRequestAllowancesRequest)
+	out := new(BootstrapIssue)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DismissalRestrictionsRequest) DeepCopyInto(out *DismissalRestrictionsRequest) {
+func (in *BootstrapTemplates) DeepCopyInto(out *BootstrapTemplates) {
 	*out = *in
-	if in.Users != nil {
-		in, out := &in.Users, &out.Users
-		*out = new([]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]string, len(*in))
-			copy(*out, *in)
-		}
+	if in.IssueTemplatesRef != nil {
+		in, out := &in.IssueTemplatesRef, &out.IssueTemplatesRef
+		*out = new(ConfigMapReference)
+		**out = **in
 	}
-	if in.Teams != nil {
-		in, out := &in.Teams, &out.Teams
-		*out = new([]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]string, len(*in))
-			copy(*out, *in)
-		}
+	if in.PullRequestTemplateRef != nil {
+		in, out := &in.PullRequestTemplateRef, &out.PullRequestTemplateRef
+		*out = new(ConfigMapKeySelector)
+		**out = **in
 	}
-	if in.Apps != nil {
-		in, out := &in.Apps, &out.Apps
-		*out = new([]string)
-		if **in != nil {
-			in, out := *in, *out
-			*out = make([]string, len(*in))
-			copy(*out, *in)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapTemplates.
+func (in *BootstrapTemplates) DeepCopy() *BootstrapTemplates {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapTemplates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapWorkflowDispatch) DeepCopyInto(out *BootstrapWorkflowDispatch) {
+	*out = *in
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DismissalRestrictionsRequest.
-func (in *DismissalRestrictionsRequest) DeepCopy() *DismissalRestrictionsRequest {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapWorkflowDispatch.
+func (in *BootstrapWorkflowDispatch) DeepCopy() *BootstrapWorkflowDispatch {
 	if in == nil {
 		return nil
 	}
-	out := new(DismissalRestrictionsRequest)
+	out := new(BootstrapWorkflowDispatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Membership) DeepCopyInto(out *Membership) {
+func (in *Branch) DeepCopyInto(out *Branch) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -254,18 +402,18 @@ func (in *Membership) DeepCopyInto(out *Membership) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Membership.
-func (in *Membership) DeepCopy() *Membership {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Branch.
+func (in *Branch) DeepCopy() *Branch {
 	if in == nil {
 		return nil
 	}
-	out := new(Membership)
+	out := new(Branch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Membership) DeepCopyObject() runtime.Object {
+func (in *Branch) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -273,31 +421,31 @@ func (in *Membership) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MembershipList) DeepCopyInto(out *MembershipList) {
+func (in *BranchList) DeepCopyInto(out *BranchList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Membership, len(*in))
+		*out = make([]Branch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipList.
-func (in *MembershipList) DeepCopy() *MembershipList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchList.
+func (in *BranchList) DeepCopy() *BranchList {
 	if in == nil {
 		return nil
 	}
-	out := new(MembershipList)
+	out := new(BranchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MembershipList) DeepCopyObject() runtime.Object {
+func (in *BranchList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -305,103 +453,2262 @@ func (in *MembershipList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MembershipObservation) DeepCopyInto(out *MembershipObservation) {
+func (in *BranchObservation) DeepCopyInto(out *BranchObservation) {
 	*out = *in
+	if in.SHA != nil {
+		in, out := &in.SHA, &out.SHA
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchObservation.
+func (in *BranchObservation) DeepCopy() *BranchObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchParameters) DeepCopyInto(out *BranchParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SourceBranch != nil {
+		in, out := &in.SourceBranch, &out.SourceBranch
+		*out = new(string)
+		**out = **in
+	}
+	if in.SourceSHA != nil {
+		in, out := &in.SourceSHA, &out.SourceSHA
+		*out = new(string)
+		**out = **in
+	}
+	if in.RenameFrom != nil {
+		in, out := &in.RenameFrom, &out.RenameFrom
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchParameters.
+func (in *BranchParameters) DeepCopy() *BranchParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRestrictions) DeepCopyInto(out *BranchProtectionRestrictions) {
+	*out = *in
+	if in.BlockCreations != nil {
+		in, out := &in.BlockCreations, &out.BlockCreations
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRestrictions.
+func (in *BranchProtectionRestrictions) DeepCopy() *BranchProtectionRestrictions {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRestrictions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRule) DeepCopyInto(out *BranchProtectionRule) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRule.
+func (in *BranchProtectionRule) DeepCopy() *BranchProtectionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchProtectionRule) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRuleList) DeepCopyInto(out *BranchProtectionRuleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BranchProtectionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRuleList.
+func (in *BranchProtectionRuleList) DeepCopy() *BranchProtectionRuleList {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRuleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BranchProtectionRuleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRuleObservation) DeepCopyInto(out *BranchProtectionRuleObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRuleObservation.
+func (in *BranchProtectionRuleObservation) DeepCopy() *BranchProtectionRuleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRuleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRuleParameters) DeepCopyInto(out *BranchProtectionRuleParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.RepositoryBranchProtectionRule.DeepCopyInto(&out.RepositoryBranchProtectionRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRuleParameters.
+func (in *BranchProtectionRuleParameters) DeepCopy() *BranchProtectionRuleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRuleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRuleSpec) DeepCopyInto(out *BranchProtectionRuleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRuleSpec.
+func (in *BranchProtectionRuleSpec) DeepCopy() *BranchProtectionRuleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRuleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchProtectionRuleStatus) DeepCopyInto(out *BranchProtectionRuleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchProtectionRuleStatus.
+func (in *BranchProtectionRuleStatus) DeepCopy() *BranchProtectionRuleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchProtectionRuleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchSpec) DeepCopyInto(out *BranchSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchSpec.
+func (in *BranchSpec) DeepCopy() *BranchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BranchStatus) DeepCopyInto(out *BranchStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BranchStatus.
+func (in *BranchStatus) DeepCopy() *BranchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BranchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BypassPullRequestAllowancesRequest) DeepCopyInto(out *BypassPullRequestAllowancesRequest) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BypassPullRequestAllowancesRequest.
+func (in *BypassPullRequestAllowancesRequest) DeepCopy() *BypassPullRequestAllowancesRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(BypassPullRequestAllowancesRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapKeySelector) DeepCopyInto(out *ConfigMapKeySelector) {
+	*out = *in
+	out.ConfigMapReference = in.ConfigMapReference
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapKeySelector.
+func (in *ConfigMapKeySelector) DeepCopy() *ConfigMapKeySelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapKeySelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigMapReference) DeepCopyInto(out *ConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapReference.
+func (in *ConfigMapReference) DeepCopy() *ConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomPropertyDefinition) DeepCopyInto(out *CustomPropertyDefinition) {
+	*out = *in
+	if in.Required != nil {
+		in, out := &in.Required, &out.Required
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DefaultValue != nil {
+		in, out := &in.DefaultValue, &out.DefaultValue
+		*out = new(string)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.AllowedValues != nil {
+		in, out := &in.AllowedValues, &out.AllowedValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomPropertyDefinition.
+func (in *CustomPropertyDefinition) DeepCopy() *CustomPropertyDefinition {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomPropertyDefinition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRole) DeepCopyInto(out *CustomRepositoryRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRole.
+func (in *CustomRepositoryRole) DeepCopy() *CustomRepositoryRole {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomRepositoryRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRoleList) DeepCopyInto(out *CustomRepositoryRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomRepositoryRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRoleList.
+func (in *CustomRepositoryRoleList) DeepCopy() *CustomRepositoryRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomRepositoryRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRoleObservation) DeepCopyInto(out *CustomRepositoryRoleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRoleObservation.
+func (in *CustomRepositoryRoleObservation) DeepCopy() *CustomRepositoryRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRoleParameters) DeepCopyInto(out *CustomRepositoryRoleParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRoleParameters.
+func (in *CustomRepositoryRoleParameters) DeepCopy() *CustomRepositoryRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRoleSpec) DeepCopyInto(out *CustomRepositoryRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRoleSpec.
+func (in *CustomRepositoryRoleSpec) DeepCopy() *CustomRepositoryRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomRepositoryRoleStatus) DeepCopyInto(out *CustomRepositoryRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomRepositoryRoleStatus.
+func (in *CustomRepositoryRoleStatus) DeepCopy() *CustomRepositoryRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomRepositoryRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DependabotAlertDismissalRule) DeepCopyInto(out *DependabotAlertDismissalRule) {
+	*out = *in
+	if in.Scope != nil {
+		in, out := &in.Scope, &out.Scope
+		*out = new(string)
+		**out = **in
+	}
+	if in.Severities != nil {
+		in, out := &in.Severities, &out.Severities
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Ecosystems != nil {
+		in, out := &in.Ecosystems, &out.Ecosystems
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Packages != nil {
+		in, out := &in.Packages, &out.Packages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DependabotAlertDismissalRule.
+func (in *DependabotAlertDismissalRule) DeepCopy() *DependabotAlertDismissalRule {
+	if in == nil {
+		return nil
+	}
+	out := new(DependabotAlertDismissalRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeploymentBranchPolicy) DeepCopyInto(out *DeploymentBranchPolicy) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeploymentBranchPolicy.
+func (in *DeploymentBranchPolicy) DeepCopy() *DeploymentBranchPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DeploymentBranchPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DismissalRestrictionsRequest) DeepCopyInto(out *DismissalRestrictionsRequest) {
+	*out = *in
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Apps != nil {
+		in, out := &in.Apps, &out.Apps
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DismissalRestrictionsRequest.
+func (in *DismissalRestrictionsRequest) DeepCopy() *DismissalRestrictionsRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(DismissalRestrictionsRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftChange) DeepCopyInto(out *DriftChange) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftChange.
+func (in *DriftChange) DeepCopy() *DriftChange {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftReport) DeepCopyInto(out *DriftReport) {
+	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]DriftChange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftReport.
+func (in *DriftReport) DeepCopy() *DriftReport {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftReport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvironmentReviewer) DeepCopyInto(out *EnvironmentReviewer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvironmentReviewer.
+func (in *EnvironmentReviewer) DeepCopy() *EnvironmentReviewer {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvironmentReviewer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Issue) DeepCopyInto(out *Issue) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Issue.
+func (in *Issue) DeepCopy() *Issue {
+	if in == nil {
+		return nil
+	}
+	out := new(Issue)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Issue) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueList) DeepCopyInto(out *IssueList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Issue, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueList.
+func (in *IssueList) DeepCopy() *IssueList {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IssueList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueObservation) DeepCopyInto(out *IssueObservation) {
+	*out = *in
+	if in.Number != nil {
+		in, out := &in.Number, &out.Number
+		*out = new(int)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTMLURL != nil {
+		in, out := &in.HTMLURL, &out.HTMLURL
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueObservation.
+func (in *IssueObservation) DeepCopy() *IssueObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueParameters) DeepCopyInto(out *IssueParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Assignees != nil {
+		in, out := &in.Assignees, &out.Assignees
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Milestone != nil {
+		in, out := &in.Milestone, &out.Milestone
+		*out = new(int)
+		**out = **in
+	}
+	if in.State != nil {
+		in, out := &in.State, &out.State
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueParameters.
+func (in *IssueParameters) DeepCopy() *IssueParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueSpec) DeepCopyInto(out *IssueSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueSpec.
+func (in *IssueSpec) DeepCopy() *IssueSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IssueStatus) DeepCopyInto(out *IssueStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IssueStatus.
+func (in *IssueStatus) DeepCopy() *IssueStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IssueStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Membership) DeepCopyInto(out *Membership) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Membership.
+func (in *Membership) DeepCopy() *Membership {
+	if in == nil {
+		return nil
+	}
+	out := new(Membership)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Membership) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipList) DeepCopyInto(out *MembershipList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Membership, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipList.
+func (in *MembershipList) DeepCopy() *MembershipList {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MembershipList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipObservation) DeepCopyInto(out *MembershipObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipObservation.
+func (in *MembershipObservation) DeepCopy() *MembershipObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipParameters) DeepCopyInto(out *MembershipParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipParameters.
+func (in *MembershipParameters) DeepCopy() *MembershipParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshot) DeepCopyInto(out *MembershipSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshot.
+func (in *MembershipSnapshot) DeepCopy() *MembershipSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MembershipSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotList) DeepCopyInto(out *MembershipSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MembershipSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotList.
+func (in *MembershipSnapshotList) DeepCopy() *MembershipSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MembershipSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotObservation) DeepCopyInto(out *MembershipSnapshotObservation) {
+	*out = *in
+	if in.Teams != nil {
+		in, out := &in.Teams, &out.Teams
+		*out = make([]MembershipSnapshotTeam, len(*in))
+		copy(*out, *in)
+	}
+	if in.Repositories != nil {
+		in, out := &in.Repositories, &out.Repositories
+		*out = make([]MembershipSnapshotRepository, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotObservation.
+func (in *MembershipSnapshotObservation) DeepCopy() *MembershipSnapshotObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotParameters) DeepCopyInto(out *MembershipSnapshotParameters) {
+	*out = *in
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotParameters.
+func (in *MembershipSnapshotParameters) DeepCopy() *MembershipSnapshotParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotRepository) DeepCopyInto(out *MembershipSnapshotRepository) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotRepository.
+func (in *MembershipSnapshotRepository) DeepCopy() *MembershipSnapshotRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotSpec) DeepCopyInto(out *MembershipSnapshotSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotSpec.
+func (in *MembershipSnapshotSpec) DeepCopy() *MembershipSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotStatus) DeepCopyInto(out *MembershipSnapshotStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotStatus.
+func (in *MembershipSnapshotStatus) DeepCopy() *MembershipSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSnapshotTeam) DeepCopyInto(out *MembershipSnapshotTeam) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSnapshotTeam.
+func (in *MembershipSnapshotTeam) DeepCopy() *MembershipSnapshotTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSnapshotTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipSpec) DeepCopyInto(out *MembershipSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSpec.
+func (in *MembershipSpec) DeepCopy() *MembershipSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MembershipStatus) DeepCopyInto(out *MembershipStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipStatus.
+func (in *MembershipStatus) DeepCopy() *MembershipStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MembershipStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NonCompliantRepository) DeepCopyInto(out *NonCompliantRepository) {
+	*out = *in
+	if in.Violations != nil {
+		in, out := &in.Violations, &out.Violations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NonCompliantRepository.
+func (in *NonCompliantRepository) DeepCopy() *NonCompliantRepository {
+	if in == nil {
+		return nil
+	}
+	out := new(NonCompliantRepository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCSubjectClaim) DeepCopyInto(out *OIDCSubjectClaim) {
+	*out = *in
+	if in.IncludeClaimKeys != nil {
+		in, out := &in.IncludeClaimKeys, &out.IncludeClaimKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCSubjectClaim.
+func (in *OIDCSubjectClaim) DeepCopy() *OIDCSubjectClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCSubjectClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrgSecret) DeepCopyInto(out *OrgSecret) {
+	*out = *in
+	if in.RepositoryAccessList != nil {
+		in, out := &in.RepositoryAccessList, &out.RepositoryAccessList
+		*out = make([]SecretSelectedRepo, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgSecret.
+func (in *OrgSecret) DeepCopy() *OrgSecret {
+	if in == nil {
+		return nil
+	}
+	out := new(OrgSecret)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Organization) DeepCopyInto(out *Organization) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
+func (in *Organization) DeepCopy() *Organization {
+	if in == nil {
+		return nil
+	}
+	out := new(Organization)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Organization) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Organization, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
+func (in *OrganizationList) DeepCopy() *OrganizationList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
+	*out = *in
+	if in.Seats != nil {
+		in, out := &in.Seats, &out.Seats
+		*out = new(int)
+		**out = **in
+	}
+	if in.FilledSeats != nil {
+		in, out := &in.FilledSeats, &out.FilledSeats
+		*out = new(int)
+		**out = **in
+	}
+	if in.Members != nil {
+		in, out := &in.Members, &out.Members
+		*out = new(int)
+		**out = **in
+	}
+	if in.OutsideCollaborators != nil {
+		in, out := &in.OutsideCollaborators, &out.OutsideCollaborators
+		*out = new(int)
+		**out = **in
+	}
+	if in.TwoFactorRequirementEnabled != nil {
+		in, out := &in.TwoFactorRequirementEnabled, &out.TwoFactorRequirementEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Billing != nil {
+		in, out := &in.Billing, &out.Billing
+		*out = new(BillingObservation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedUsers != nil {
+		in, out := &in.BlockedUsers, &out.BlockedUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RepositoryBaseline != nil {
+		in, out := &in.RepositoryBaseline, &out.RepositoryBaseline
+		*out = new(RepositoryBaselineObservation)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
+func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
+	*out = *in
+	in.Actions.DeepCopyInto(&out.Actions)
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Company != nil {
+		in, out := &in.Company, &out.Company
+		*out = new(string)
+		**out = **in
+	}
+	if in.BillingEmail != nil {
+		in, out := &in.BillingEmail, &out.BillingEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.Blog != nil {
+		in, out := &in.Blog, &out.Blog
+		*out = new(string)
+		**out = **in
+	}
+	if in.Location != nil {
+		in, out := &in.Location, &out.Location
+		*out = new(string)
+		**out = **in
+	}
+	if in.Email != nil {
+		in, out := &in.Email, &out.Email
+		*out = new(string)
+		**out = **in
+	}
+	if in.TwitterUsername != nil {
+		in, out := &in.TwitterUsername, &out.TwitterUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.Secrets != nil {
+		in, out := &in.Secrets, &out.Secrets
+		*out = new(SecretConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryDefaults != nil {
+		in, out := &in.RepositoryDefaults, &out.RepositoryDefaults
+		*out = new(RepositoryDefaults)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Billing != nil {
+		in, out := &in.Billing, &out.Billing
+		*out = new(BillingConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryBaseline != nil {
+		in, out := &in.RepositoryBaseline, &out.RepositoryBaseline
+		*out = new(RepositoryBaseline)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BlockedUsers != nil {
+		in, out := &in.BlockedUsers, &out.BlockedUsers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make([]CustomPropertyDefinition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SecurityManagerTeams != nil {
+		in, out := &in.SecurityManagerTeams, &out.SecurityManagerTeams
+		*out = make([]SecurityManagerTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DependabotAlertDismissalRules != nil {
+		in, out := &in.DependabotAlertDismissalRules, &out.DependabotAlertDismissalRules
+		*out = make([]DependabotAlertDismissalRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
+func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRole) DeepCopyInto(out *OrganizationRole) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRole.
+func (in *OrganizationRole) DeepCopy() *OrganizationRole {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRole)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRole) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignment) DeepCopyInto(out *OrganizationRoleAssignment) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignment.
+func (in *OrganizationRoleAssignment) DeepCopy() *OrganizationRoleAssignment {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignment)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRoleAssignment) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignmentList) DeepCopyInto(out *OrganizationRoleAssignmentList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationRoleAssignment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignmentList.
+func (in *OrganizationRoleAssignmentList) DeepCopy() *OrganizationRoleAssignmentList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignmentList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRoleAssignmentList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignmentObservation) DeepCopyInto(out *OrganizationRoleAssignmentObservation) {
+	*out = *in
+	if in.RoleID != nil {
+		in, out := &in.RoleID, &out.RoleID
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignmentObservation.
+func (in *OrganizationRoleAssignmentObservation) DeepCopy() *OrganizationRoleAssignmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignmentParameters) DeepCopyInto(out *OrganizationRoleAssignmentParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleRef != nil {
+		in, out := &in.RoleRef, &out.RoleRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RoleSelector != nil {
+		in, out := &in.RoleSelector, &out.RoleSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserRef != nil {
+		in, out := &in.UserRef, &out.UserRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.UserSelector != nil {
+		in, out := &in.UserSelector, &out.UserSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamSelector != nil {
+		in, out := &in.TeamSelector, &out.TeamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignmentParameters.
+func (in *OrganizationRoleAssignmentParameters) DeepCopy() *OrganizationRoleAssignmentParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignmentParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignmentSpec) DeepCopyInto(out *OrganizationRoleAssignmentSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignmentSpec.
+func (in *OrganizationRoleAssignmentSpec) DeepCopy() *OrganizationRoleAssignmentSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignmentSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleAssignmentStatus) DeepCopyInto(out *OrganizationRoleAssignmentStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleAssignmentStatus.
+func (in *OrganizationRoleAssignmentStatus) DeepCopy() *OrganizationRoleAssignmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleAssignmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleList) DeepCopyInto(out *OrganizationRoleList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationRole, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleList.
+func (in *OrganizationRoleList) DeepCopy() *OrganizationRoleList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRoleList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleObservation) DeepCopyInto(out *OrganizationRoleObservation) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleObservation.
+func (in *OrganizationRoleObservation) DeepCopy() *OrganizationRoleObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleParameters) DeepCopyInto(out *OrganizationRoleParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.BaseRole != nil {
+		in, out := &in.BaseRole, &out.BaseRole
+		*out = new(string)
+		**out = **in
+	}
+	if in.Permissions != nil {
+		in, out := &in.Permissions, &out.Permissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleParameters.
+func (in *OrganizationRoleParameters) DeepCopy() *OrganizationRoleParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleSpec) DeepCopyInto(out *OrganizationRoleSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleSpec.
+func (in *OrganizationRoleSpec) DeepCopy() *OrganizationRoleSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRoleStatus) DeepCopyInto(out *OrganizationRoleStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRoleStatus.
+func (in *OrganizationRoleStatus) DeepCopy() *OrganizationRoleStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRoleStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRuleset) DeepCopyInto(out *OrganizationRuleset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRuleset.
+func (in *OrganizationRuleset) DeepCopy() *OrganizationRuleset {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRuleset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRuleset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetList) DeepCopyInto(out *OrganizationRulesetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OrganizationRuleset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetList.
+func (in *OrganizationRulesetList) DeepCopy() *OrganizationRulesetList {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OrganizationRulesetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetObservation) DeepCopyInto(out *OrganizationRulesetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetObservation.
+func (in *OrganizationRulesetObservation) DeepCopy() *OrganizationRulesetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetParameters) DeepCopyInto(out *OrganizationRulesetParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Repositories.DeepCopyInto(&out.Repositories)
+	in.Ruleset.DeepCopyInto(&out.Ruleset)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetParameters.
+func (in *OrganizationRulesetParameters) DeepCopy() *OrganizationRulesetParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetRepositories) DeepCopyInto(out *OrganizationRulesetRepositories) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = new(RulesetRepositoryNames)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = new(RulesetRepositoryProperties)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetRepositories.
+func (in *OrganizationRulesetRepositories) DeepCopy() *OrganizationRulesetRepositories {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetRepositories)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetSpec) DeepCopyInto(out *OrganizationRulesetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetSpec.
+func (in *OrganizationRulesetSpec) DeepCopy() *OrganizationRulesetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationRulesetStatus) DeepCopyInto(out *OrganizationRulesetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationRulesetStatus.
+func (in *OrganizationRulesetStatus) DeepCopy() *OrganizationRulesetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationRulesetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
+func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
+func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OrganizationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepoFork) DeepCopyInto(out *RepoFork) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoFork.
+func (in *RepoFork) DeepCopy() *RepoFork {
+	if in == nil {
+		return nil
+	}
+	out := new(RepoFork)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Repository) DeepCopyInto(out *Repository) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
+func (in *Repository) DeepCopy() *Repository {
+	if in == nil {
+		return nil
+	}
+	out := new(Repository)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Repository) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryBaseline) DeepCopyInto(out *RepositoryBaseline) {
+	*out = *in
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowMergeCommit != nil {
+		in, out := &in.AllowMergeCommit, &out.AllowMergeCommit
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowSquashMerge != nil {
+		in, out := &in.AllowSquashMerge, &out.AllowSquashMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowRebaseMerge != nil {
+		in, out := &in.AllowRebaseMerge, &out.AllowRebaseMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeleteBranchOnMerge != nil {
+		in, out := &in.DeleteBranchOnMerge, &out.DeleteBranchOnMerge
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretScanning != nil {
+		in, out := &in.SecretScanning, &out.SecretScanning
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecretScanningPushProtection != nil {
+		in, out := &in.SecretScanningPushProtection, &out.SecretScanningPushProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DependabotSecurityUpdates != nil {
+		in, out := &in.DependabotSecurityUpdates, &out.DependabotSecurityUpdates
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RefreshInterval != nil {
+		in, out := &in.RefreshInterval, &out.RefreshInterval
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryBaseline.
+func (in *RepositoryBaseline) DeepCopy() *RepositoryBaseline {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryBaseline)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryBaselineObservation) DeepCopyInto(out *RepositoryBaselineObservation) {
+	*out = *in
+	if in.RefreshTime != nil {
+		in, out := &in.RefreshTime, &out.RefreshTime
+		*out = (*in).DeepCopy()
+	}
+	if in.NonCompliantRepositories != nil {
+		in, out := &in.NonCompliantRepositories, &out.NonCompliantRepositories
+		*out = make([]NonCompliantRepository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipObservation.
-func (in *MembershipObservation) DeepCopy() *MembershipObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryBaselineObservation.
+func (in *RepositoryBaselineObservation) DeepCopy() *RepositoryBaselineObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(MembershipObservation)
+	out := new(RepositoryBaselineObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MembershipParameters) DeepCopyInto(out *MembershipParameters) {
+func (in *RepositoryBootstrap) DeepCopyInto(out *RepositoryBootstrap) {
 	*out = *in
-	if in.OrgRef != nil {
-		in, out := &in.OrgRef, &out.OrgRef
-		*out = new(v1.Reference)
+	if in.Files != nil {
+		in, out := &in.Files, &out.Files
+		*out = make([]BootstrapFile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = new(BootstrapTemplates)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.OrgSelector != nil {
-		in, out := &in.OrgSelector, &out.OrgSelector
-		*out = new(v1.Selector)
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Issue != nil {
+		in, out := &in.Issue, &out.Issue
+		*out = new(BootstrapIssue)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CommitStatuses != nil {
+		in, out := &in.CommitStatuses, &out.CommitStatuses
+		*out = make([]BootstrapCommitStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WorkflowDispatches != nil {
+		in, out := &in.WorkflowDispatches, &out.WorkflowDispatches
+		*out = make([]BootstrapWorkflowDispatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipParameters.
-func (in *MembershipParameters) DeepCopy() *MembershipParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryBootstrap.
+func (in *RepositoryBootstrap) DeepCopy() *RepositoryBootstrap {
 	if in == nil {
 		return nil
 	}
-	out := new(MembershipParameters)
+	out := new(RepositoryBootstrap)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MembershipSpec) DeepCopyInto(out *MembershipSpec) {
+func (in *RepositoryBranchProtectionRule) DeepCopyInto(out *RepositoryBranchProtectionRule) {
 	*out = *in
-	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
-	in.ForProvider.DeepCopyInto(&out.ForProvider)
+	if in.RequiredStatusChecks != nil {
+		in, out := &in.RequiredStatusChecks, &out.RequiredStatusChecks
+		*out = new(RequiredStatusChecks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequiredPullRequestReviews != nil {
+		in, out := &in.RequiredPullRequestReviews, &out.RequiredPullRequestReviews
+		*out = new(RequiredPullRequestReviews)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BranchProtectionRestrictions != nil {
+		in, out := &in.BranchProtectionRestrictions, &out.BranchProtectionRestrictions
+		*out = new(BranchProtectionRestrictions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequireLinearHistory != nil {
+		in, out := &in.RequireLinearHistory, &out.RequireLinearHistory
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowForcePushes != nil {
+		in, out := &in.AllowForcePushes, &out.AllowForcePushes
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowDeletions != nil {
+		in, out := &in.AllowDeletions, &out.AllowDeletions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequiredConversationResolution != nil {
+		in, out := &in.RequiredConversationResolution, &out.RequiredConversationResolution
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LockBranch != nil {
+		in, out := &in.LockBranch, &out.LockBranch
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AllowForkSyncing != nil {
+		in, out := &in.AllowForkSyncing, &out.AllowForkSyncing
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RequireSignedCommits != nil {
+		in, out := &in.RequireSignedCommits, &out.RequireSignedCommits
+		*out = new(bool)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipSpec.
-func (in *MembershipSpec) DeepCopy() *MembershipSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryBranchProtectionRule.
+func (in *RepositoryBranchProtectionRule) DeepCopy() *RepositoryBranchProtectionRule {
 	if in == nil {
 		return nil
 	}
-	out := new(MembershipSpec)
+	out := new(RepositoryBranchProtectionRule)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MembershipStatus) DeepCopyInto(out *MembershipStatus) {
+func (in *RepositoryCodeScanning) DeepCopyInto(out *RepositoryCodeScanning) {
 	*out = *in
-	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	if in.QuerySuite != nil {
+		in, out := &in.QuerySuite, &out.QuerySuite
+		*out = new(string)
+		**out = **in
+	}
+	if in.Languages != nil {
+		in, out := &in.Languages, &out.Languages
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MembershipStatus.
-func (in *MembershipStatus) DeepCopy() *MembershipStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryCodeScanning.
+func (in *RepositoryCodeScanning) DeepCopy() *RepositoryCodeScanning {
 	if in == nil {
 		return nil
 	}
-	out := new(MembershipStatus)
+	out := new(RepositoryCodeScanning)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrgSecret) DeepCopyInto(out *OrgSecret) {
+func (in *RepositoryDefaults) DeepCopyInto(out *RepositoryDefaults) {
 	*out = *in
-	if in.RepositoryAccessList != nil {
-		in, out := &in.RepositoryAccessList, &out.RepositoryAccessList
-		*out = make([]SecretSelectedRepo, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Topics != nil {
+		in, out := &in.Topics, &out.Topics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopicsFromLabels != nil {
+		in, out := &in.TopicsFromLabels, &out.TopicsFromLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrgSecret.
-func (in *OrgSecret) DeepCopy() *OrgSecret {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDefaults.
+func (in *RepositoryDefaults) DeepCopy() *RepositoryDefaults {
 	if in == nil {
 		return nil
 	}
-	out := new(OrgSecret)
+	out := new(RepositoryDefaults)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Organization) DeepCopyInto(out *Organization) {
+func (in *RepositoryDispatch) DeepCopyInto(out *RepositoryDispatch) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -409,18 +2716,18 @@ func (in *Organization) DeepCopyInto(out *Organization) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Organization.
-func (in *Organization) DeepCopy() *Organization {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatch.
+func (in *RepositoryDispatch) DeepCopy() *RepositoryDispatch {
 	if in == nil {
 		return nil
 	}
-	out := new(Organization)
+	out := new(RepositoryDispatch)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Organization) DeepCopyObject() runtime.Object {
+func (in *RepositoryDispatch) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -428,31 +2735,31 @@ func (in *Organization) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationList) DeepCopyInto(out *OrganizationList) {
+func (in *RepositoryDispatchList) DeepCopyInto(out *RepositoryDispatchList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Organization, len(*in))
+		*out = make([]RepositoryDispatch, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationList.
-func (in *OrganizationList) DeepCopy() *OrganizationList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatchList.
+func (in *RepositoryDispatchList) DeepCopy() *RepositoryDispatchList {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationList)
+	out := new(RepositoryDispatchList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *OrganizationList) DeepCopyObject() runtime.Object {
+func (in *RepositoryDispatchList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -460,111 +2767,188 @@ func (in *OrganizationList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationObservation) DeepCopyInto(out *OrganizationObservation) {
+func (in *RepositoryDispatchObservation) DeepCopyInto(out *RepositoryDispatchObservation) {
 	*out = *in
+	if in.DispatchedGeneration != nil {
+		in, out := &in.DispatchedGeneration, &out.DispatchedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	if in.DispatchedAt != nil {
+		in, out := &in.DispatchedAt, &out.DispatchedAt
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationObservation.
-func (in *OrganizationObservation) DeepCopy() *OrganizationObservation {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatchObservation.
+func (in *RepositoryDispatchObservation) DeepCopy() *RepositoryDispatchObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationObservation)
+	out := new(RepositoryDispatchObservation)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationParameters) DeepCopyInto(out *OrganizationParameters) {
+func (in *RepositoryDispatchParameters) DeepCopyInto(out *RepositoryDispatchParameters) {
 	*out = *in
-	in.Actions.DeepCopyInto(&out.Actions)
-	if in.Secrets != nil {
-		in, out := &in.Secrets, &out.Secrets
-		*out = new(SecretConfiguration)
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientPayload != nil {
+		in, out := &in.ClientPayload, &out.ClientPayload
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationParameters.
-func (in *OrganizationParameters) DeepCopy() *OrganizationParameters {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatchParameters.
+func (in *RepositoryDispatchParameters) DeepCopy() *RepositoryDispatchParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationParameters)
+	out := new(RepositoryDispatchParameters)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationSpec) DeepCopyInto(out *OrganizationSpec) {
+func (in *RepositoryDispatchSpec) DeepCopyInto(out *RepositoryDispatchSpec) {
 	*out = *in
 	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
 	in.ForProvider.DeepCopyInto(&out.ForProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationSpec.
-func (in *OrganizationSpec) DeepCopy() *OrganizationSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatchSpec.
+func (in *RepositoryDispatchSpec) DeepCopy() *RepositoryDispatchSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationSpec)
+	out := new(RepositoryDispatchSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *OrganizationStatus) DeepCopyInto(out *OrganizationStatus) {
+func (in *RepositoryDispatchStatus) DeepCopyInto(out *RepositoryDispatchStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OrganizationStatus.
-func (in *OrganizationStatus) DeepCopy() *OrganizationStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryDispatchStatus.
+func (in *RepositoryDispatchStatus) DeepCopy() *RepositoryDispatchStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(OrganizationStatus)
+	out := new(RepositoryDispatchStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepoFork) DeepCopyInto(out *RepoFork) {
+func (in *RepositoryEnvironment) DeepCopyInto(out *RepositoryEnvironment) {
 	*out = *in
+	if in.ProtectedBranches != nil {
+		in, out := &in.ProtectedBranches, &out.ProtectedBranches
+		*out = new(bool)
+		**out = **in
+	}
+	if in.DeploymentBranchPolicies != nil {
+		in, out := &in.DeploymentBranchPolicies, &out.DeploymentBranchPolicies
+		*out = make([]DeploymentBranchPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoFork.
-func (in *RepoFork) DeepCopy() *RepoFork {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryEnvironment.
+func (in *RepositoryEnvironment) DeepCopy() *RepositoryEnvironment {
 	if in == nil {
 		return nil
 	}
-	out := new(RepoFork)
+	out := new(RepositoryEnvironment)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Repository) DeepCopyInto(out *Repository) {
+func (in *RepositoryEnvironmentObservation) DeepCopyInto(out *RepositoryEnvironmentObservation) {
+	*out = *in
+	if in.WaitTimer != nil {
+		in, out := &in.WaitTimer, &out.WaitTimer
+		*out = new(int)
+		**out = **in
+	}
+	if in.Reviewers != nil {
+		in, out := &in.Reviewers, &out.Reviewers
+		*out = make([]EnvironmentReviewer, len(*in))
+		copy(*out, *in)
+	}
+	if in.PreventSelfReview != nil {
+		in, out := &in.PreventSelfReview, &out.PreventSelfReview
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryEnvironmentObservation.
+func (in *RepositoryEnvironmentObservation) DeepCopy() *RepositoryEnvironmentObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryEnvironmentObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Repository, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Repository.
-func (in *Repository) DeepCopy() *Repository {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
+func (in *RepositoryList) DeepCopy() *RepositoryList {
 	if in == nil {
 		return nil
 	}
-	out := new(Repository)
+	out := new(RepositoryList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Repository) DeepCopyObject() runtime.Object {
+func (in *RepositoryList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -572,40 +2956,90 @@ func (in *Repository) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryList) DeepCopyInto(out *RepositoryList) {
+func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Repository, len(*in))
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.PreviousNames != nil {
+		in, out := &in.PreviousNames, &out.PreviousNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Archived != nil {
+		in, out := &in.Archived, &out.Archived
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.PushedAt != nil {
+		in, out := &in.PushedAt, &out.PushedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.Webhooks != nil {
+		in, out := &in.Webhooks, &out.Webhooks
+		*out = make([]RepositoryWebhookObservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]RepositoryEnvironmentObservation, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryList.
-func (in *RepositoryList) DeepCopy() *RepositoryList {
-	if in == nil {
-		return nil
+	if in.CompletedBootstrapActions != nil {
+		in, out := &in.CompletedBootstrapActions, &out.CompletedBootstrapActions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RulesetIDs != nil {
+		in, out := &in.RulesetIDs, &out.RulesetIDs
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.PendingBranchProtectionRules != nil {
+		in, out := &in.PendingBranchProtectionRules, &out.PendingBranchProtectionRules
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnmodeledSettings != nil {
+		in, out := &in.UnmodeledSettings, &out.UnmodeledSettings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DependabotSecretVersions != nil {
+		in, out := &in.DependabotSecretVersions, &out.DependabotSecretVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CodespacesSecretVersions != nil {
+		in, out := &in.CodespacesSecretVersions, &out.CodespacesSecretVersions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
 	}
-	out := new(RepositoryList)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *RepositoryList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = new(DriftReport)
+		(*in).DeepCopyInto(*out)
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RepositoryObservation) DeepCopyInto(out *RepositoryObservation) {
-	*out = *in
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryObservation.
@@ -622,6 +3056,16 @@ func (in *RepositoryObservation) DeepCopy() *RepositoryObservation {
 func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
 	*out = *in
 	in.Permissions.DeepCopyInto(&out.Permissions)
+	if in.PermissionManagementPolicy != nil {
+		in, out := &in.PermissionManagementPolicy, &out.PermissionManagementPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.IgnoreOrganizationOwners != nil {
+		in, out := &in.IgnoreOrganizationOwners, &out.IgnoreOrganizationOwners
+		*out = new(bool)
+		**out = **in
+	}
 	if in.Webhooks != nil {
 		in, out := &in.Webhooks, &out.Webhooks
 		*out = make([]RepositoryWebhook, len(*in))
@@ -629,20 +3073,35 @@ func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WebhookManagementPolicy != nil {
+		in, out := &in.WebhookManagementPolicy, &out.WebhookManagementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.BranchProtectionRules != nil {
 		in, out := &in.BranchProtectionRules, &out.BranchProtectionRules
-		*out = make([]BranchProtectionRule, len(*in))
+		*out = make([]RepositoryBranchProtectionRule, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BranchProtectionManagementPolicy != nil {
+		in, out := &in.BranchProtectionManagementPolicy, &out.BranchProtectionManagementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.RepositoryRules != nil {
 		in, out := &in.RepositoryRules, &out.RepositoryRules
-		*out = make([]RepositoryRuleset, len(*in))
+		*out = make([]Ruleset, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.RulesetManagementPolicy != nil {
+		in, out := &in.RulesetManagementPolicy, &out.RulesetManagementPolicy
+		*out = new(string)
+		**out = **in
+	}
 	if in.CreateFromTemplate != nil {
 		in, out := &in.CreateFromTemplate, &out.CreateFromTemplate
 		*out = new(TemplateRepo)
@@ -683,6 +3142,80 @@ func (in *RepositoryParameters) DeepCopyInto(out *RepositoryParameters) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HasIssues != nil {
+		in, out := &in.HasIssues, &out.HasIssues
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasWiki != nil {
+		in, out := &in.HasWiki, &out.HasWiki
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasProjects != nil {
+		in, out := &in.HasProjects, &out.HasProjects
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasDiscussions != nil {
+		in, out := &in.HasDiscussions, &out.HasDiscussions
+		*out = new(bool)
+		**out = **in
+	}
+	if in.HasDownloads != nil {
+		in, out := &in.HasDownloads, &out.HasDownloads
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Bootstrap != nil {
+		in, out := &in.Bootstrap, &out.Bootstrap
+		*out = new(RepositoryBootstrap)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CustomProperties != nil {
+		in, out := &in.CustomProperties, &out.CustomProperties
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.DependabotSecrets != nil {
+		in, out := &in.DependabotSecrets, &out.DependabotSecrets
+		*out = make([]RepositorySecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.CodespacesSecrets != nil {
+		in, out := &in.CodespacesSecrets, &out.CodespacesSecrets
+		*out = make([]RepositorySecret, len(*in))
+		copy(*out, *in)
+	}
+	if in.Environments != nil {
+		in, out := &in.Environments, &out.Environments
+		*out = make([]RepositoryEnvironment, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.CodeScanning != nil {
+		in, out := &in.CodeScanning, &out.CodeScanning
+		*out = new(RepositoryCodeScanning)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OIDCSubjectClaim != nil {
+		in, out := &in.OIDCSubjectClaim, &out.OIDCSubjectClaim
+		*out = new(OIDCSubjectClaim)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SecretScanningDelegatedBypass != nil {
+		in, out := &in.SecretScanningDelegatedBypass, &out.SecretScanningDelegatedBypass
+		*out = new(SecretScanningDelegatedBypass)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ValidateCodeowners != nil {
+		in, out := &in.ValidateCodeowners, &out.ValidateCodeowners
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryParameters.
@@ -727,45 +3260,159 @@ func (in *RepositoryPermissions) DeepCopy() *RepositoryPermissions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RepositoryRuleset) DeepCopyInto(out *RepositoryRuleset) {
 	*out = *in
-	if in.Enforcement != nil {
-		in, out := &in.Enforcement, &out.Enforcement
-		*out = new(string)
-		**out = **in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRuleset.
+func (in *RepositoryRuleset) DeepCopy() *RepositoryRuleset {
+	if in == nil {
+		return nil
 	}
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = new(string)
-		**out = **in
+	out := new(RepositoryRuleset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRuleset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
-	if in.BypassActors != nil {
-		in, out := &in.BypassActors, &out.BypassActors
-		*out = make([]*RulesetByPassActors, len(*in))
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRulesetList) DeepCopyInto(out *RepositoryRulesetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]RepositoryRuleset, len(*in))
 		for i := range *in {
-			if (*in)[i] != nil {
-				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RulesetByPassActors)
-				(*in).DeepCopyInto(*out)
-			}
+			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Conditions != nil {
-		in, out := &in.Conditions, &out.Conditions
-		*out = new(RulesetConditions)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRulesetList.
+func (in *RepositoryRulesetList) DeepCopy() *RepositoryRulesetList {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRulesetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *RepositoryRulesetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRulesetObservation) DeepCopyInto(out *RepositoryRulesetObservation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRulesetObservation.
+func (in *RepositoryRulesetObservation) DeepCopy() *RepositoryRulesetObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRulesetObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRulesetParameters) DeepCopyInto(out *RepositoryRulesetParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.Rules != nil {
-		in, out := &in.Rules, &out.Rules
-		*out = new(Rules)
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	in.Ruleset.DeepCopyInto(&out.Ruleset)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRuleset.
-func (in *RepositoryRuleset) DeepCopy() *RepositoryRuleset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRulesetParameters.
+func (in *RepositoryRulesetParameters) DeepCopy() *RepositoryRulesetParameters {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryRuleset)
+	out := new(RepositoryRulesetParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRulesetSpec) DeepCopyInto(out *RepositoryRulesetSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRulesetSpec.
+func (in *RepositoryRulesetSpec) DeepCopy() *RepositoryRulesetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRulesetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryRulesetStatus) DeepCopyInto(out *RepositoryRulesetStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	out.AtProvider = in.AtProvider
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryRulesetStatus.
+func (in *RepositoryRulesetStatus) DeepCopy() *RepositoryRulesetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryRulesetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositorySecret) DeepCopyInto(out *RepositorySecret) {
+	*out = *in
+	out.ValueSecretRef = in.ValueSecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositorySecret.
+func (in *RepositorySecret) DeepCopy() *RepositorySecret {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositorySecret)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -791,7 +3438,7 @@ func (in *RepositorySpec) DeepCopy() *RepositorySpec {
 func (in *RepositoryStatus) DeepCopyInto(out *RepositoryStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryStatus.
@@ -842,6 +3489,10 @@ func (in *RepositoryUser) DeepCopyInto(out *RepositoryUser) {
 		*out = new(v1.Selector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryUser.
@@ -862,24 +3513,58 @@ func (in *RepositoryWebhook) DeepCopyInto(out *RepositoryWebhook) {
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Events != nil {
-		in, out := &in.Events, &out.Events
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Active != nil {
-		in, out := &in.Active, &out.Active
-		*out = new(bool)
-		**out = **in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AllowUnknownEvents != nil {
+		in, out := &in.AllowUnknownEvents, &out.AllowUnknownEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Active != nil {
+		in, out := &in.Active, &out.Active
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PingOnCreate != nil {
+		in, out := &in.PingOnCreate, &out.PingOnCreate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryWebhook.
+func (in *RepositoryWebhook) DeepCopy() *RepositoryWebhook {
+	if in == nil {
+		return nil
+	}
+	out := new(RepositoryWebhook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RepositoryWebhookObservation) DeepCopyInto(out *RepositoryWebhookObservation) {
+	*out = *in
+	if in.LastDeliveryStatusCode != nil {
+		in, out := &in.LastDeliveryStatusCode, &out.LastDeliveryStatusCode
+		*out = new(int)
+		**out = **in
+	}
+	if in.LastDeliveryTime != nil {
+		in, out := &in.LastDeliveryTime, &out.LastDeliveryTime
+		*out = (*in).DeepCopy()
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryWebhook.
-func (in *RepositoryWebhook) DeepCopy() *RepositoryWebhook {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepositoryWebhookObservation.
+func (in *RepositoryWebhookObservation) DeepCopy() *RepositoryWebhookObservation {
 	if in == nil {
 		return nil
 	}
-	out := new(RepositoryWebhook)
+	out := new(RepositoryWebhookObservation)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1008,6 +3693,56 @@ func (in *Rules) DeepCopyInto(out *Rules) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.CommitMessagePattern != nil {
+		in, out := &in.CommitMessagePattern, &out.CommitMessagePattern
+		*out = new(RulesPattern)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CommitAuthorEmailPattern != nil {
+		in, out := &in.CommitAuthorEmailPattern, &out.CommitAuthorEmailPattern
+		*out = new(RulesPattern)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CommitterEmailPattern != nil {
+		in, out := &in.CommitterEmailPattern, &out.CommitterEmailPattern
+		*out = new(RulesPattern)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BranchNamePattern != nil {
+		in, out := &in.BranchNamePattern, &out.BranchNamePattern
+		*out = new(RulesPattern)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TagNamePattern != nil {
+		in, out := &in.TagNamePattern, &out.TagNamePattern
+		*out = new(RulesPattern)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Workflows != nil {
+		in, out := &in.Workflows, &out.Workflows
+		*out = new(RulesWorkflows)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FilePathRestriction != nil {
+		in, out := &in.FilePathRestriction, &out.FilePathRestriction
+		*out = new(RulesFilePathRestriction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxFilePathLength != nil {
+		in, out := &in.MaxFilePathLength, &out.MaxFilePathLength
+		*out = new(int)
+		**out = **in
+	}
+	if in.FileExtensionRestriction != nil {
+		in, out := &in.FileExtensionRestriction, &out.FileExtensionRestriction
+		*out = new(RulesFileExtensionRestriction)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxFileSize != nil {
+		in, out := &in.MaxFileSize, &out.MaxFileSize
+		*out = new(int)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rules.
@@ -1020,6 +3755,71 @@ func (in *Rules) DeepCopy() *Rules {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesFileExtensionRestriction) DeepCopyInto(out *RulesFileExtensionRestriction) {
+	*out = *in
+	if in.RestrictedFileExtensions != nil {
+		in, out := &in.RestrictedFileExtensions, &out.RestrictedFileExtensions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesFileExtensionRestriction.
+func (in *RulesFileExtensionRestriction) DeepCopy() *RulesFileExtensionRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesFileExtensionRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesFilePathRestriction) DeepCopyInto(out *RulesFilePathRestriction) {
+	*out = *in
+	if in.RestrictedFilePaths != nil {
+		in, out := &in.RestrictedFilePaths, &out.RestrictedFilePaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesFilePathRestriction.
+func (in *RulesFilePathRestriction) DeepCopy() *RulesFilePathRestriction {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesFilePathRestriction)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesPattern) DeepCopyInto(out *RulesPattern) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.Negate != nil {
+		in, out := &in.Negate, &out.Negate
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesPattern.
+func (in *RulesPattern) DeepCopy() *RulesPattern {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesPattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesPullRequest) DeepCopyInto(out *RulesPullRequest) {
 	*out = *in
@@ -1131,6 +3931,118 @@ func (in *RulesRequiredStatusChecksParameters) DeepCopy() *RulesRequiredStatusCh
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesWorkflow) DeepCopyInto(out *RulesWorkflow) {
+	*out = *in
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepositoryId != nil {
+		in, out := &in.RepositoryId, &out.RepositoryId
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Ref != nil {
+		in, out := &in.Ref, &out.Ref
+		*out = new(string)
+		**out = **in
+	}
+	if in.Sha != nil {
+		in, out := &in.Sha, &out.Sha
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesWorkflow.
+func (in *RulesWorkflow) DeepCopy() *RulesWorkflow {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesWorkflow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesWorkflows) DeepCopyInto(out *RulesWorkflows) {
+	*out = *in
+	if in.Workflows != nil {
+		in, out := &in.Workflows, &out.Workflows
+		*out = make([]*RulesWorkflow, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RulesWorkflow)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesWorkflows.
+func (in *RulesWorkflows) DeepCopy() *RulesWorkflows {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesWorkflows)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ruleset) DeepCopyInto(out *Ruleset) {
+	*out = *in
+	if in.Enforcement != nil {
+		in, out := &in.Enforcement, &out.Enforcement
+		*out = new(string)
+		**out = **in
+	}
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = new(string)
+		**out = **in
+	}
+	if in.BypassActors != nil {
+		in, out := &in.BypassActors, &out.BypassActors
+		*out = make([]*RulesetByPassActors, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(RulesetByPassActors)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = new(RulesetConditions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = new(Rules)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ruleset.
+func (in *Ruleset) DeepCopy() *Ruleset {
+	if in == nil {
+		return nil
+	}
+	out := new(Ruleset)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RulesetByPassActors) DeepCopyInto(out *RulesetByPassActors) {
 	*out = *in
@@ -1139,69 +4051,163 @@ func (in *RulesetByPassActors) DeepCopyInto(out *RulesetByPassActors) {
 		*out = new(int64)
 		**out = **in
 	}
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamSelector != nil {
+		in, out := &in.TeamSelector, &out.TeamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppSlug != nil {
+		in, out := &in.AppSlug, &out.AppSlug
+		*out = new(string)
+		**out = **in
+	}
 	if in.ActorType != nil {
 		in, out := &in.ActorType, &out.ActorType
 		*out = new(string)
 		**out = **in
 	}
-	if in.BypassMode != nil {
-		in, out := &in.BypassMode, &out.BypassMode
-		*out = new(string)
+	if in.BypassMode != nil {
+		in, out := &in.BypassMode, &out.BypassMode
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetByPassActors.
+func (in *RulesetByPassActors) DeepCopy() *RulesetByPassActors {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetByPassActors)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetConditions) DeepCopyInto(out *RulesetConditions) {
+	*out = *in
+	if in.RefName != nil {
+		in, out := &in.RefName, &out.RefName
+		*out = new(RulesetRefName)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetConditions.
+func (in *RulesetConditions) DeepCopy() *RulesetConditions {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetConditions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRefName) DeepCopyInto(out *RulesetRefName) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRefName.
+func (in *RulesetRefName) DeepCopy() *RulesetRefName {
+	if in == nil {
+		return nil
+	}
+	out := new(RulesetRefName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RulesetRepositoryNames) DeepCopyInto(out *RulesetRepositoryNames) {
+	*out = *in
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Protected != nil {
+		in, out := &in.Protected, &out.Protected
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetByPassActors.
-func (in *RulesetByPassActors) DeepCopy() *RulesetByPassActors {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRepositoryNames.
+func (in *RulesetRepositoryNames) DeepCopy() *RulesetRepositoryNames {
 	if in == nil {
 		return nil
 	}
-	out := new(RulesetByPassActors)
+	out := new(RulesetRepositoryNames)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RulesetConditions) DeepCopyInto(out *RulesetConditions) {
+func (in *RulesetRepositoryProperties) DeepCopyInto(out *RulesetRepositoryProperties) {
 	*out = *in
-	if in.RefName != nil {
-		in, out := &in.RefName, &out.RefName
-		*out = new(RulesetRefName)
-		(*in).DeepCopyInto(*out)
+	if in.Include != nil {
+		in, out := &in.Include, &out.Include
+		*out = make([]RulesetRepositoryProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Exclude != nil {
+		in, out := &in.Exclude, &out.Exclude
+		*out = make([]RulesetRepositoryProperty, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetConditions.
-func (in *RulesetConditions) DeepCopy() *RulesetConditions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRepositoryProperties.
+func (in *RulesetRepositoryProperties) DeepCopy() *RulesetRepositoryProperties {
 	if in == nil {
 		return nil
 	}
-	out := new(RulesetConditions)
+	out := new(RulesetRepositoryProperties)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *RulesetRefName) DeepCopyInto(out *RulesetRefName) {
+func (in *RulesetRepositoryProperty) DeepCopyInto(out *RulesetRepositoryProperty) {
 	*out = *in
-	if in.Include != nil {
-		in, out := &in.Include, &out.Include
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Exclude != nil {
-		in, out := &in.Exclude, &out.Exclude
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRefName.
-func (in *RulesetRefName) DeepCopy() *RulesetRefName {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RulesetRepositoryProperty.
+func (in *RulesetRepositoryProperty) DeepCopy() *RulesetRepositoryProperty {
 	if in == nil {
 		return nil
 	}
-	out := new(RulesetRefName)
+	out := new(RulesetRepositoryProperty)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1223,6 +4229,13 @@ func (in *SecretConfiguration) DeepCopyInto(out *SecretConfiguration) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.CodespacesSecrets != nil {
+		in, out := &in.CodespacesSecrets, &out.CodespacesSecrets
+		*out = make([]OrgSecret, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretConfiguration.
@@ -1235,6 +4248,53 @@ func (in *SecretConfiguration) DeepCopy() *SecretConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretScanningBypassReviewerTeam) DeepCopyInto(out *SecretScanningBypassReviewerTeam) {
+	*out = *in
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamSelector != nil {
+		in, out := &in.TeamSelector, &out.TeamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretScanningBypassReviewerTeam.
+func (in *SecretScanningBypassReviewerTeam) DeepCopy() *SecretScanningBypassReviewerTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretScanningBypassReviewerTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecretScanningDelegatedBypass) DeepCopyInto(out *SecretScanningDelegatedBypass) {
+	*out = *in
+	if in.ReviewerTeams != nil {
+		in, out := &in.ReviewerTeams, &out.ReviewerTeams
+		*out = make([]SecretScanningBypassReviewerTeam, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecretScanningDelegatedBypass.
+func (in *SecretScanningDelegatedBypass) DeepCopy() *SecretScanningDelegatedBypass {
+	if in == nil {
+		return nil
+	}
+	out := new(SecretScanningDelegatedBypass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretSelectedRepo) DeepCopyInto(out *SecretSelectedRepo) {
 	*out = *in
@@ -1260,6 +4320,31 @@ func (in *SecretSelectedRepo) DeepCopy() *SecretSelectedRepo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SecurityManagerTeam) DeepCopyInto(out *SecurityManagerTeam) {
+	*out = *in
+	if in.TeamRef != nil {
+		in, out := &in.TeamRef, &out.TeamRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TeamSelector != nil {
+		in, out := &in.TeamSelector, &out.TeamSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityManagerTeam.
+func (in *SecurityManagerTeam) DeepCopy() *SecurityManagerTeam {
+	if in == nil {
+		return nil
+	}
+	out := new(SecurityManagerTeam)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Team) DeepCopyInto(out *Team) {
 	*out = *in
@@ -1287,6 +4372,26 @@ func (in *Team) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TeamIdPGroup) DeepCopyInto(out *TeamIdPGroup) {
+	*out = *in
+	if in.GroupDescription != nil {
+		in, out := &in.GroupDescription, &out.GroupDescription
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamIdPGroup.
+func (in *TeamIdPGroup) DeepCopy() *TeamIdPGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(TeamIdPGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamList) DeepCopyInto(out *TeamList) {
 	*out = *in
@@ -1372,6 +4477,18 @@ func (in *TeamMemberUser) DeepCopy() *TeamMemberUser {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TeamObservation) DeepCopyInto(out *TeamObservation) {
 	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.IdPGroups != nil {
+		in, out := &in.IdPGroups, &out.IdPGroups
+		*out = make([]TeamIdPGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamObservation.
@@ -1424,6 +4541,13 @@ func (in *TeamParameters) DeepCopyInto(out *TeamParameters) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.IdPGroups != nil {
+		in, out := &in.IdPGroups, &out.IdPGroups
+		*out = make([]TeamIdPGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamParameters.
@@ -1457,7 +4581,7 @@ func (in *TeamSpec) DeepCopy() *TeamSpec {
 func (in *TeamStatus) DeepCopyInto(out *TeamStatus) {
 	*out = *in
 	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
-	out.AtProvider = in.AtProvider
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TeamStatus.
@@ -1484,3 +4608,173 @@ func (in *TemplateRepo) DeepCopy() *TemplateRepo {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatch) DeepCopyInto(out *WorkflowDispatch) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatch.
+func (in *WorkflowDispatch) DeepCopy() *WorkflowDispatch {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowDispatch) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatchList) DeepCopyInto(out *WorkflowDispatchList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WorkflowDispatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatchList.
+func (in *WorkflowDispatchList) DeepCopy() *WorkflowDispatchList {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatchList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WorkflowDispatchList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatchObservation) DeepCopyInto(out *WorkflowDispatchObservation) {
+	*out = *in
+	if in.RunID != nil {
+		in, out := &in.RunID, &out.RunID
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(string)
+		**out = **in
+	}
+	if in.Conclusion != nil {
+		in, out := &in.Conclusion, &out.Conclusion
+		*out = new(string)
+		**out = **in
+	}
+	if in.HTMLURL != nil {
+		in, out := &in.HTMLURL, &out.HTMLURL
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatchObservation.
+func (in *WorkflowDispatchObservation) DeepCopy() *WorkflowDispatchObservation {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatchObservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatchParameters) DeepCopyInto(out *WorkflowDispatchParameters) {
+	*out = *in
+	if in.OrgRef != nil {
+		in, out := &in.OrgRef, &out.OrgRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrgSelector != nil {
+		in, out := &in.OrgSelector, &out.OrgSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoRef != nil {
+		in, out := &in.RepoRef, &out.RepoRef
+		*out = new(v1.Reference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RepoSelector != nil {
+		in, out := &in.RepoSelector, &out.RepoSelector
+		*out = new(v1.Selector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Inputs != nil {
+		in, out := &in.Inputs, &out.Inputs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatchParameters.
+func (in *WorkflowDispatchParameters) DeepCopy() *WorkflowDispatchParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatchParameters)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatchSpec) DeepCopyInto(out *WorkflowDispatchSpec) {
+	*out = *in
+	in.ResourceSpec.DeepCopyInto(&out.ResourceSpec)
+	in.ForProvider.DeepCopyInto(&out.ForProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatchSpec.
+func (in *WorkflowDispatchSpec) DeepCopy() *WorkflowDispatchSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatchSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WorkflowDispatchStatus) DeepCopyInto(out *WorkflowDispatchStatus) {
+	*out = *in
+	in.ResourceStatus.DeepCopyInto(&out.ResourceStatus)
+	in.AtProvider.DeepCopyInto(&out.AtProvider)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WorkflowDispatchStatus.
+func (in *WorkflowDispatchStatus) DeepCopy() *WorkflowDispatchStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(WorkflowDispatchStatus)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -19,6 +19,51 @@ package v1alpha1
 
 import resource "github.com/crossplane/crossplane-runtime/pkg/resource"
 
+// GetItems of this ActionsRunnerTokenList.
+func (l *ActionsRunnerTokenList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BranchList.
+func (l *BranchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this BranchProtectionRuleList.
+func (l *BranchProtectionRuleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this CustomRepositoryRoleList.
+func (l *CustomRepositoryRoleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this IssueList.
+func (l *IssueList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this MembershipList.
 func (l *MembershipList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -28,6 +73,15 @@ func (l *MembershipList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this MembershipSnapshotList.
+func (l *MembershipSnapshotList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this OrganizationList.
 func (l *OrganizationList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -37,6 +91,42 @@ func (l *OrganizationList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this OrganizationRoleAssignmentList.
+func (l *OrganizationRoleAssignmentList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this OrganizationRoleList.
+func (l *OrganizationRoleList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this OrganizationRulesetList.
+func (l *OrganizationRulesetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
+// GetItems of this RepositoryDispatchList.
+func (l *RepositoryDispatchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this RepositoryList.
 func (l *RepositoryList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -46,6 +136,15 @@ func (l *RepositoryList) GetItems() []resource.Managed {
 	return items
 }
 
+// GetItems of this RepositoryRulesetList.
+func (l *RepositoryRulesetList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
+
 // GetItems of this TeamList.
 func (l *TeamList) GetItems() []resource.Managed {
 	items := make([]resource.Managed, len(l.Items))
@@ -54,3 +153,12 @@ func (l *TeamList) GetItems() []resource.Managed {
 	}
 	return items
 }
+
+// GetItems of this WorkflowDispatchList.
+func (l *WorkflowDispatchList) GetItems() []resource.Managed {
+	items := make([]resource.Managed, len(l.Items))
+	for i := range l.Items {
+		items[i] = &l.Items[i]
+	}
+	return items
+}
@@ -24,6 +24,184 @@ import (
 	client "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ResolveReferences of this ActionsRunnerToken.
+func (mg *ActionsRunnerToken) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Branch.
+func (mg *Branch) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this BranchProtectionRule.
+func (mg *BranchProtectionRule) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this CustomRepositoryRole.
+func (mg *CustomRepositoryRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this Issue.
+func (mg *Issue) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this Membership.
 func (mg *Membership) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -50,6 +228,48 @@ func (mg *Membership) ResolveReferences(ctx context.Context, c client.Reader) er
 	return nil
 }
 
+// ResolveReferences of this MembershipSnapshot.
+func (mg *MembershipSnapshot) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.User,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UserRef,
+		Selector:     mg.Spec.ForProvider.UserSelector,
+		To: reference.To{
+			List:    &MembershipList{},
+			Managed: &Membership{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.User")
+	}
+	mg.Spec.ForProvider.User = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	return nil
+}
+
 // ResolveReferences of this Organization.
 func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader) error {
 	r := reference.NewAPIResolver(c, mg)
@@ -60,7 +280,7 @@ func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader)
 	for i4 := 0; i4 < len(mg.Spec.ForProvider.Actions.EnabledRepos); i4++ {
 		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 			CurrentValue: mg.Spec.ForProvider.Actions.EnabledRepos[i4].Repo,
-			Extract:      reference.ExternalName(),
+			Extract:      RepositoryName(),
 			Reference:    mg.Spec.ForProvider.Actions.EnabledRepos[i4].RepoRef,
 			Selector:     mg.Spec.ForProvider.Actions.EnabledRepos[i4].RepoSelector,
 			To: reference.To{
@@ -80,7 +300,7 @@ func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader)
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Secrets.ActionsSecrets[i4].RepositoryAccessList); i5++ {
 				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 					CurrentValue: mg.Spec.ForProvider.Secrets.ActionsSecrets[i4].RepositoryAccessList[i5].Repo,
-					Extract:      reference.ExternalName(),
+					Extract:      RepositoryName(),
 					Reference:    mg.Spec.ForProvider.Secrets.ActionsSecrets[i4].RepositoryAccessList[i5].RepoRef,
 					Selector:     mg.Spec.ForProvider.Secrets.ActionsSecrets[i4].RepositoryAccessList[i5].RepoSelector,
 					To: reference.To{
@@ -102,7 +322,7 @@ func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader)
 			for i5 := 0; i5 < len(mg.Spec.ForProvider.Secrets.DependabotSecrets[i4].RepositoryAccessList); i5++ {
 				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 					CurrentValue: mg.Spec.ForProvider.Secrets.DependabotSecrets[i4].RepositoryAccessList[i5].Repo,
-					Extract:      reference.ExternalName(),
+					Extract:      RepositoryName(),
 					Reference:    mg.Spec.ForProvider.Secrets.DependabotSecrets[i4].RepositoryAccessList[i5].RepoRef,
 					Selector:     mg.Spec.ForProvider.Secrets.DependabotSecrets[i4].RepositoryAccessList[i5].RepoSelector,
 					To: reference.To{
@@ -119,6 +339,213 @@ func (mg *Organization) ResolveReferences(ctx context.Context, c client.Reader)
 			}
 		}
 	}
+	if mg.Spec.ForProvider.Secrets != nil {
+		for i4 := 0; i4 < len(mg.Spec.ForProvider.Secrets.CodespacesSecrets); i4++ {
+			for i5 := 0; i5 < len(mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList); i5++ {
+				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].Repo,
+					Extract:      RepositoryName(),
+					Reference:    mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].RepoRef,
+					Selector:     mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].RepoSelector,
+					To: reference.To{
+						List:    &RepositoryList{},
+						Managed: &Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].Repo")
+				}
+				mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].Repo = rsp.ResolvedValue
+				mg.Spec.ForProvider.Secrets.CodespacesSecrets[i4].RepositoryAccessList[i5].RepoRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.SecurityManagerTeams); i3++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.SecurityManagerTeams[i3].Team,
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.SecurityManagerTeams[i3].TeamRef,
+			Selector:     mg.Spec.ForProvider.SecurityManagerTeams[i3].TeamSelector,
+			To: reference.To{
+				List:    &TeamList{},
+				Managed: &Team{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.SecurityManagerTeams[i3].Team")
+		}
+		mg.Spec.ForProvider.SecurityManagerTeams[i3].Team = rsp.ResolvedValue
+		mg.Spec.ForProvider.SecurityManagerTeams[i3].TeamRef = rsp.ResolvedReference
+
+	}
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationRole.
+func (mg *OrganizationRole) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationRoleAssignment.
+func (mg *OrganizationRoleAssignment) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Role,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.RoleRef,
+		Selector:     mg.Spec.ForProvider.RoleSelector,
+		To: reference.To{
+			List:    &OrganizationRoleList{},
+			Managed: &OrganizationRole{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Role")
+	}
+	mg.Spec.ForProvider.Role = rsp.ResolvedValue
+	mg.Spec.ForProvider.RoleRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.User,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.UserRef,
+		Selector:     mg.Spec.ForProvider.UserSelector,
+		To: reference.To{
+			List:    &MembershipList{},
+			Managed: &Membership{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.User")
+	}
+	mg.Spec.ForProvider.User = rsp.ResolvedValue
+	mg.Spec.ForProvider.UserRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Team,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.TeamRef,
+		Selector:     mg.Spec.ForProvider.TeamSelector,
+		To: reference.To{
+			List:    &TeamList{},
+			Managed: &Team{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Team")
+	}
+	mg.Spec.ForProvider.Team = rsp.ResolvedValue
+	mg.Spec.ForProvider.TeamRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this OrganizationRuleset.
+func (mg *OrganizationRuleset) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	for i4 := 0; i4 < len(mg.Spec.ForProvider.Ruleset.BypassActors); i4++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team,
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamRef,
+			Selector:     mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamSelector,
+			To: reference.To{
+				List:    &TeamList{},
+				Managed: &Team{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team")
+		}
+		mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team = rsp.ResolvedValue
+		mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.Ruleset.Rules != nil {
+		if mg.Spec.ForProvider.Ruleset.Rules.Workflows != nil {
+			for i6 := 0; i6 < len(mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows); i6++ {
+				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo,
+					Extract:      RepositoryName(),
+					Reference:    mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoRef,
+					Selector:     mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoSelector,
+					To: reference.To{
+						List:    &RepositoryList{},
+						Managed: &Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo")
+				}
+				mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo = rsp.ResolvedValue
+				mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoRef = rsp.ResolvedReference
+
+			}
+		}
+	}
 
 	return nil
 }
@@ -166,6 +593,50 @@ func (mg *Repository) ResolveReferences(ctx context.Context, c client.Reader) er
 		mg.Spec.ForProvider.Permissions.Teams[i4].TeamRef = rsp.ResolvedReference
 
 	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.RepositoryRules); i3++ {
+		for i4 := 0; i4 < len(mg.Spec.ForProvider.RepositoryRules[i3].BypassActors); i4++ {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].Team,
+				Extract:      reference.ExternalName(),
+				Reference:    mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].TeamRef,
+				Selector:     mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].TeamSelector,
+				To: reference.To{
+					List:    &TeamList{},
+					Managed: &Team{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].Team")
+			}
+			mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].Team = rsp.ResolvedValue
+			mg.Spec.ForProvider.RepositoryRules[i3].BypassActors[i4].TeamRef = rsp.ResolvedReference
+
+		}
+	}
+	for i3 := 0; i3 < len(mg.Spec.ForProvider.RepositoryRules); i3++ {
+		if mg.Spec.ForProvider.RepositoryRules[i3].Rules != nil {
+			if mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows != nil {
+				for i6 := 0; i6 < len(mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows); i6++ {
+					rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+						CurrentValue: mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].Repo,
+						Extract:      RepositoryName(),
+						Reference:    mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].RepoRef,
+						Selector:     mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].RepoSelector,
+						To: reference.To{
+							List:    &RepositoryList{},
+							Managed: &Repository{},
+						},
+					})
+					if err != nil {
+						return errors.Wrap(err, "mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].Repo")
+					}
+					mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].Repo = rsp.ResolvedValue
+					mg.Spec.ForProvider.RepositoryRules[i3].Rules.Workflows.Workflows[i6].RepoRef = rsp.ResolvedReference
+
+				}
+			}
+		}
+	}
 	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
 		CurrentValue: mg.Spec.ForProvider.Org,
 		Extract:      reference.ExternalName(),
@@ -182,6 +653,152 @@ func (mg *Repository) ResolveReferences(ctx context.Context, c client.Reader) er
 	mg.Spec.ForProvider.Org = rsp.ResolvedValue
 	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
 
+	if mg.Spec.ForProvider.SecretScanningDelegatedBypass != nil {
+		for i4 := 0; i4 < len(mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams); i4++ {
+			rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+				CurrentValue: mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].Team,
+				Extract:      reference.ExternalName(),
+				Reference:    mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].TeamRef,
+				Selector:     mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].TeamSelector,
+				To: reference.To{
+					List:    &TeamList{},
+					Managed: &Team{},
+				},
+			})
+			if err != nil {
+				return errors.Wrap(err, "mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].Team")
+			}
+			mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].Team = rsp.ResolvedValue
+			mg.Spec.ForProvider.SecretScanningDelegatedBypass.ReviewerTeams[i4].TeamRef = rsp.ResolvedReference
+
+		}
+	}
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryDispatch.
+func (mg *RepositoryDispatch) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
+
+// ResolveReferences of this RepositoryRuleset.
+func (mg *RepositoryRuleset) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	for i4 := 0; i4 < len(mg.Spec.ForProvider.Ruleset.BypassActors); i4++ {
+		rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+			CurrentValue: mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team,
+			Extract:      reference.ExternalName(),
+			Reference:    mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamRef,
+			Selector:     mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamSelector,
+			To: reference.To{
+				List:    &TeamList{},
+				Managed: &Team{},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team")
+		}
+		mg.Spec.ForProvider.Ruleset.BypassActors[i4].Team = rsp.ResolvedValue
+		mg.Spec.ForProvider.Ruleset.BypassActors[i4].TeamRef = rsp.ResolvedReference
+
+	}
+	if mg.Spec.ForProvider.Ruleset.Rules != nil {
+		if mg.Spec.ForProvider.Ruleset.Rules.Workflows != nil {
+			for i6 := 0; i6 < len(mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows); i6++ {
+				rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+					CurrentValue: mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo,
+					Extract:      RepositoryName(),
+					Reference:    mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoRef,
+					Selector:     mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoSelector,
+					To: reference.To{
+						List:    &RepositoryList{},
+						Managed: &Repository{},
+					},
+				})
+				if err != nil {
+					return errors.Wrap(err, "mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo")
+				}
+				mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].Repo = rsp.ResolvedValue
+				mg.Spec.ForProvider.Ruleset.Rules.Workflows.Workflows[i6].RepoRef = rsp.ResolvedReference
+
+			}
+		}
+	}
+
 	return nil
 }
 
@@ -244,3 +861,45 @@ func (mg *Team) ResolveReferences(ctx context.Context, c client.Reader) error {
 
 	return nil
 }
+
+// ResolveReferences of this WorkflowDispatch.
+func (mg *WorkflowDispatch) ResolveReferences(ctx context.Context, c client.Reader) error {
+	r := reference.NewAPIResolver(c, mg)
+
+	var rsp reference.ResolutionResponse
+	var err error
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Org,
+		Extract:      reference.ExternalName(),
+		Reference:    mg.Spec.ForProvider.OrgRef,
+		Selector:     mg.Spec.ForProvider.OrgSelector,
+		To: reference.To{
+			List:    &OrganizationList{},
+			Managed: &Organization{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Org")
+	}
+	mg.Spec.ForProvider.Org = rsp.ResolvedValue
+	mg.Spec.ForProvider.OrgRef = rsp.ResolvedReference
+
+	rsp, err = r.Resolve(ctx, reference.ResolutionRequest{
+		CurrentValue: mg.Spec.ForProvider.Repo,
+		Extract:      RepositoryName(),
+		Reference:    mg.Spec.ForProvider.RepoRef,
+		Selector:     mg.Spec.ForProvider.RepoSelector,
+		To: reference.To{
+			List:    &RepositoryList{},
+			Managed: &Repository{},
+		},
+	})
+	if err != nil {
+		return errors.Wrap(err, "mg.Spec.ForProvider.Repo")
+	}
+	mg.Spec.ForProvider.Repo = rsp.ResolvedValue
+	mg.Spec.ForProvider.RepoRef = rsp.ResolvedReference
+
+	return nil
+}
@@ -29,6 +29,38 @@ import (
 type ProviderConfigSpec struct {
 	// Credentials required to authenticate to this provider.
 	Credentials ProviderCredentials `json:"credentials"`
+
+	// CredentialsPool are keys of Secrets with further credentials of the
+	// same format as the credentials, e.g. of other Apps installed on the
+	// same organizations. Every reconcile uses the credentials with the
+	// largest share of their rate limit left, so that the requests of large
+	// installations are spread over the rate limits of all of them.
+	// +optional
+	CredentialsPool []xpv1.SecretKeySelector `json:"credentialsPool,omitempty"`
+
+	// BaseURL is the URL of the GitHub Enterprise Server to manage, e.g.
+	// https://github.example.org. Its REST API is reached under /api/v3 and
+	// its GraphQL API under /api/graphql. By default github.com is managed.
+	// +optional
+	BaseURL *string `json:"baseURL,omitempty"`
+
+	// ProxyURL is the URL of the HTTP proxy that GitHub is reached through,
+	// e.g. http://proxy.example.org:3128. By default the proxy of the
+	// HTTPS_PROXY and NO_PROXY environment variables of the provider is used.
+	// +optional
+	ProxyURL *string `json:"proxyURL,omitempty"`
+
+	// CABundle is a PEM encoded bundle of certificate authorities that are
+	// trusted in addition to the ones of the system, e.g. the CA of a proxy
+	// that intercepts TLS.
+	// +optional
+	CABundle *string `json:"caBundle,omitempty"`
+
+	// InsecureSkipVerify disables the verification of the certificates that
+	// GitHub or the proxy present. It is meant for testing only, the
+	// credentials can be intercepted over such connections.
+	// +optional
+	InsecureSkipVerify *bool `json:"insecureSkipVerify,omitempty"`
 }
 
 // ProviderCredentials required to authenticate.
@@ -51,6 +83,7 @@ type ProviderConfigStatus struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp"
 // +kubebuilder:printcolumn:name="SECRET-NAME",type="string",JSONPath=".spec.credentials.secretRef.name",priority=1
+// +kubebuilder:printcolumn:name="USERS",type="integer",JSONPath=".status.users"
 // +kubebuilder:resource:scope=Cluster
 type ProviderConfig struct {
 	metav1.TypeMeta   `json:",inline"`
@@ -0,0 +1,106 @@
+/*
+Copyright 2020 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/controller/repository"
+)
+
+// repositoryManifest is a Repository without the fields an apply would
+// ignore or reject, such as its status and creation timestamp.
+type repositoryManifest struct {
+	metav1.TypeMeta `json:",inline"`
+	Metadata        repositoryManifestMetadata `json:"metadata"`
+	Spec            v1alpha1.RepositorySpec    `json:"spec"`
+}
+
+type repositoryManifestMetadata struct {
+	Name        string            `json:"name"`
+	Annotations map[string]string `json:"annotations"`
+}
+
+// importOptions configure the Repository manifests of importRepositories.
+type importOptions struct {
+	org            string
+	credentials    string
+	providerConfig string
+	observeOnly    bool
+}
+
+// importRepositories writes a Repository manifest for each repository of an
+// organization to w, with the external name set to adopt the repository.
+func importRepositories(ctx context.Context, w io.Writer, o importOptions) error {
+	creds, err := os.ReadFile(o.credentials)
+	if err != nil {
+		return errors.Wrap(err, "cannot read credentials")
+	}
+	gh, err := ghclient.NewClient(string(creds))
+	if err != nil {
+		return errors.Wrap(err, "cannot create GitHub client")
+	}
+
+	discovered, err := repository.DiscoverRepositories(ctx, gh, o.org)
+	if err != nil {
+		return err
+	}
+
+	for _, d := range discovered {
+		m := repositoryManifest{
+			TypeMeta: d.Repository.TypeMeta,
+			Metadata: repositoryManifestMetadata{
+				Name:        d.Repository.GetName(),
+				Annotations: d.Repository.GetAnnotations(),
+			},
+			Spec: d.Repository.Spec,
+		}
+		m.Spec.ProviderConfigReference = &xpv1.Reference{Name: o.providerConfig}
+		if o.observeOnly {
+			m.Spec.ManagementPolicies = xpv1.ManagementPolicies{xpv1.ManagementActionObserve}
+		}
+
+		out, err := yaml.Marshal(m)
+		if err != nil {
+			return errors.Wrapf(err, "cannot render repository %s", m.Metadata.Name)
+		}
+		if _, err := fmt.Fprintln(w, "---"); err != nil {
+			return err
+		}
+		if len(d.UnmodeledSettings) > 0 {
+			if _, err := fmt.Fprintf(w, "# Settings not modeled by the Repository: %s\n", strings.Join(d.UnmodeledSettings, ", ")); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(out); err != nil {
+			return err
+		}
+	}
+	return nil
+}
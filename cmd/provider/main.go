@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 
 	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
@@ -38,8 +39,11 @@ import (
 
 	"github.com/crossplane/provider-github/apis"
 	"github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
 	github "github.com/crossplane/provider-github/internal/controller"
+	"github.com/crossplane/provider-github/internal/dryrun"
 	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/webhook"
 )
 
 func main() {
@@ -52,11 +56,36 @@ func main() {
 		pollInterval     = app.Flag("poll", "How often individual resources will be checked for drift from the desired state").Default("1m").Duration()
 		maxReconcileRate = app.Flag("max-reconcile-rate", "The global maximum rate per second at which resources may checked for drift from the desired state.").Default("10").Int()
 
+		retryInitialBackoff = app.Flag("retry-initial-backoff", "How long the first retry of a failed reconcile is delayed. The delay doubles with every failure in a row.").Default("1s").Duration()
+		retryMaxBackoff     = app.Flag("retry-max-backoff", "The longest delay of the retry of a failed reconcile.").Default("1m").Duration()
+		retryJitter         = app.Flag("retry-jitter", "The share of its delay by which the retry of a failed reconcile is delayed further at random, between 0 and 1.").Default("0").Float64()
+
 		namespace                  = app.Flag("namespace", "Namespace used to set as default scope in default secret store config.").Default("crossplane-system").Envar("POD_NAMESPACE").String()
 		enableExternalSecretStores = app.Flag("enable-external-secret-stores", "Enable support for ExternalSecretStores.").Default("false").Envar("ENABLE_EXTERNAL_SECRET_STORES").Bool()
 		enableManagementPolicies   = app.Flag("enable-management-policies", "Enable support for Management Policies.").Default("false").Envar("ENABLE_MANAGEMENT_POLICIES").Bool()
+
+		dryRun = app.Flag("dry-run", "Record the GitHub mutations of managed resources as events instead of executing them, unless they are annotated otherwise.").Default("false").Envar("DRY_RUN").Bool()
+
+		webhookAddress = app.Flag("webhook-address", "Address at which GitHub organization webhooks are received, e.g. :8090. Webhooks are not received if empty.").Default("").Envar("WEBHOOK_ADDRESS").String()
+		webhookSecret  = app.Flag("webhook-secret", "Secret the GitHub organization webhooks are signed with.").Envar("WEBHOOK_SECRET").String()
+
+		_ = app.Command("start", "Start the GitHub controllers.").Default()
+
+		importCmd            = app.Command("import-repositories", "Print Repository manifests that adopt the existing repositories of an organization.")
+		importOrg            = importCmd.Flag("org", "Organization whose repositories are imported.").Required().String()
+		importCredentials    = importCmd.Flag("credentials", "File with the credentials of a ProviderConfig, i.e. appId,installationId,privateKey.").Required().ExistingFile()
+		importProviderConfig = importCmd.Flag("provider-config", "Name of the ProviderConfig the Repositories refer to.").Default("default").String()
+		importObserveOnly    = importCmd.Flag("observe-only", "Only observe the imported repositories, requires --enable-management-policies.").Default("true").Bool()
 	)
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	if kingpin.MustParse(app.Parse(os.Args[1:])) == importCmd.FullCommand() {
+		kingpin.FatalIfError(importRepositories(context.Background(), os.Stdout, importOptions{
+			org:            *importOrg,
+			credentials:    *importCredentials,
+			providerConfig: *importProviderConfig,
+			observeOnly:    *importObserveOnly,
+		}), "Cannot import repositories")
+		return
+	}
 
 	zl := zap.New(zap.UseDevMode(*debug))
 	log := logging.NewLogrLogger(zl.WithName("provider-github"))
@@ -89,6 +118,15 @@ func main() {
 	kingpin.FatalIfError(err, "Cannot create controller manager")
 	kingpin.FatalIfError(apis.AddToScheme(mgr.GetScheme()), "Cannot add GitHub APIs to scheme")
 
+	retry := backoff.Policy{Initial: *retryInitialBackoff, Max: *retryMaxBackoff, Jitter: *retryJitter}
+	kingpin.FatalIfError(retry.Validate(), "Invalid retry backoff")
+	backoff.Configure(retry)
+
+	dryrun.Configure(*dryRun)
+	if *dryRun {
+		log.Info("Dry run enabled, GitHub mutations are recorded as events instead of executed")
+	}
+
 	o := controller.Options{
 		Logger:                  log,
 		MaxConcurrentReconciles: *maxReconcileRate,
@@ -121,6 +159,16 @@ func main() {
 		log.Info("Alpha feature enabled", "flag", features.EnableAlphaManagementPolicies)
 	}
 
+	if *webhookAddress != "" {
+		if *webhookSecret == "" {
+			kingpin.Fatalf("Cannot receive webhooks without --webhook-secret")
+		}
+		receiver := webhook.NewReceiver(mgr.GetClient(), []byte(*webhookSecret), log)
+		kingpin.FatalIfError(mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+			return webhook.Serve(ctx, *webhookAddress, receiver)
+		})), "Cannot add webhook receiver")
+	}
+
 	kingpin.FatalIfError(github.Setup(mgr, o), "Cannot setup GitHub controllers")
 	kingpin.FatalIfError(mgr.Start(ctrl.SetupSignalHandler()), "Cannot start controller manager")
 }
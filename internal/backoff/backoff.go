@@ -0,0 +1,95 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backoff configures how the failed reconciles of managed resources
+// are retried, so that errors GitHub keeps returning, such as validation
+// failures, aren't retried as often as transient ones need to be.
+package backoff
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/ratelimiter"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+)
+
+// A Policy is how the failed reconciles of a managed resource are retried.
+// The delay of a retry doubles with every failure in a row, from Initial up
+// to Max, and is lengthened by up to Jitter of it at random.
+type Policy struct {
+	Initial time.Duration
+	Max     time.Duration
+	Jitter  float64
+}
+
+// DefaultPolicy is the policy of crossplane-runtime, which retries from a
+// second up to a minute without jitter.
+var DefaultPolicy = Policy{Initial: time.Second, Max: time.Minute}
+
+// policy is the policy of the controllers that are set up.
+var policy = DefaultPolicy
+
+// Validate returns an error if p can't be used.
+func (p Policy) Validate() error {
+	switch {
+	case p.Initial <= 0:
+		return errors.New("initial retry backoff must be positive")
+	case p.Max < p.Initial:
+		return errors.New("maximum retry backoff must not be shorter than the initial one")
+	case p.Jitter < 0 || p.Jitter > 1:
+		return errors.New("retry backoff jitter must be between 0 and 1")
+	}
+	return nil
+}
+
+// Configure sets the policy of the controllers that are set up afterwards.
+func Configure(p Policy) {
+	policy = p
+}
+
+// ForControllerRuntime returns the controller-runtime options of o, with a
+// rate limiter that retries failed reconciles by the configured policy.
+func ForControllerRuntime(o xpcontroller.Options) controller.Options {
+	opts := o.ForControllerRuntime()
+	opts.RateLimiter = NewRateLimiter(policy)
+	return opts
+}
+
+// NewRateLimiter returns a per-item rate limiter that delays the retries of
+// an item by p.
+func NewRateLimiter(p Policy) ratelimiter.RateLimiter {
+	return &jittered{
+		RateLimiter: workqueue.NewItemExponentialFailureRateLimiter(p.Initial, p.Max),
+		jitter:      p.Jitter,
+	}
+}
+
+// jittered lengthens the delays of a rate limiter at random, so that the
+// retries of resources that failed together are spread.
+type jittered struct {
+	workqueue.RateLimiter
+	jitter float64
+}
+
+func (j *jittered) When(item any) time.Duration {
+	d := j.RateLimiter.When(item)
+	return d + time.Duration(rand.Float64()*j.jitter*float64(d)) //nolint:gosec // Spreading retries needs no secure randomness.
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	xpcontroller "github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestValidate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		p      Policy
+		want   error
+	}{
+		"Default": {
+			reason: "The default policy should be valid.",
+			p:      DefaultPolicy,
+		},
+		"Jitter": {
+			reason: "A policy with jitter should be valid.",
+			p:      Policy{Initial: time.Second, Max: time.Hour, Jitter: 0.5},
+		},
+		"NoInitial": {
+			reason: "A policy without initial backoff should be invalid.",
+			p:      Policy{Max: time.Minute},
+			want:   errors.New("initial retry backoff must be positive"),
+		},
+		"MaxShorterThanInitial": {
+			reason: "A policy whose maximum backoff is shorter than its initial one should be invalid.",
+			p:      Policy{Initial: time.Minute, Max: time.Second},
+			want:   errors.New("maximum retry backoff must not be shorter than the initial one"),
+		},
+		"JitterAboveOne": {
+			reason: "A policy with a jitter above 1 should be invalid.",
+			p:      Policy{Initial: time.Second, Max: time.Minute, Jitter: 1.5},
+			want:   errors.New("retry backoff jitter must be between 0 and 1"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.p.Validate()
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nValidate(): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestForControllerRuntime(t *testing.T) {
+	defer Configure(DefaultPolicy)
+	Configure(Policy{Initial: 2 * time.Second, Max: 10 * time.Second})
+
+	opts := ForControllerRuntime(xpcontroller.Options{MaxConcurrentReconciles: 7})
+	if diff := cmp.Diff(7, opts.MaxConcurrentReconciles); diff != "" {
+		t.Errorf("ForControllerRuntime(...): -want MaxConcurrentReconciles, +got MaxConcurrentReconciles:\n%s\n", diff)
+	}
+	if opts.RecoverPanic == nil || !*opts.RecoverPanic {
+		t.Errorf("ForControllerRuntime(...): panics should be recovered as by crossplane-runtime")
+	}
+
+	// The delay doubles with every failure, up to the maximum.
+	want := []time.Duration{2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	got := make([]time.Duration, 0, len(want))
+	for range want {
+		got = append(got, opts.RateLimiter.When("item"))
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("ForControllerRuntime(...): -want delays, +got delays:\n%s\n", diff)
+	}
+
+	// The delays start over once the item was reconciled.
+	opts.RateLimiter.Forget("item")
+	if diff := cmp.Diff(2*time.Second, opts.RateLimiter.When("item")); diff != "" {
+		t.Errorf("ForControllerRuntime(...): -want delay after Forget, +got delay after Forget:\n%s\n", diff)
+	}
+}
+
+func TestNewRateLimiterJitter(t *testing.T) {
+	r := NewRateLimiter(Policy{Initial: time.Second, Max: time.Second, Jitter: 0.5})
+	for i := 0; i < 1000; i++ {
+		if d := r.When("item"); d < time.Second || d > 1500*time.Millisecond {
+			t.Fatalf("When(...): %s should be within [1s, 1.5s]", d)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// BranchProtectionRuleSettings are the settings of a branch protection rule
+// shared by the GraphQL objects and mutation inputs.
+type BranchProtectionRuleSettings struct {
+	Pattern                        string `json:"pattern"`
+	IsAdminEnforced                bool   `json:"isAdminEnforced"`
+	RequiresLinearHistory          bool   `json:"requiresLinearHistory"`
+	AllowsForcePushes              bool   `json:"allowsForcePushes"`
+	AllowsDeletions                bool   `json:"allowsDeletions"`
+	RequiresConversationResolution bool   `json:"requiresConversationResolution"`
+	LockBranch                     bool   `json:"lockBranch"`
+	LockAllowsFetchAndMerge        bool   `json:"lockAllowsFetchAndMerge"`
+	RequiresCommitSignatures       bool   `json:"requiresCommitSignatures"`
+	RestrictsPushes                bool   `json:"restrictsPushes"`
+	BlocksCreations                bool   `json:"blocksCreations"`
+	RequiresStatusChecks           bool   `json:"requiresStatusChecks"`
+	RequiresStrictStatusChecks     bool   `json:"requiresStrictStatusChecks"`
+	RequiresApprovingReviews       bool   `json:"requiresApprovingReviews"`
+	RequiredApprovingReviewCount   int    `json:"requiredApprovingReviewCount"`
+	DismissesStaleReviews          bool   `json:"dismissesStaleReviews"`
+	RequiresCodeOwnerReviews       bool   `json:"requiresCodeOwnerReviews"`
+	RequireLastPushApproval        bool   `json:"requireLastPushApproval"`
+}
+
+// BranchProtectionRule is a branch protection rule as returned by the GraphQL API.
+type BranchProtectionRule struct {
+	BranchProtectionRuleSettings
+	ID                   string                           `json:"id"`
+	RequiredStatusChecks []RequiredStatusCheckDescription `json:"requiredStatusChecks"`
+}
+
+// RequiredStatusCheckDescription is a status check required by a branch
+// protection rule, and the app that has to set it, if any.
+type RequiredStatusCheckDescription struct {
+	Context string `json:"context"`
+	App     *struct {
+		DatabaseID int64 `json:"databaseId"`
+	} `json:"app"`
+}
+
+// BranchProtectionRuleInput is the input of the branch protection rule mutations.
+// RepositoryID is only used when creating a rule, BranchProtectionRuleID only
+// when updating one.
+type BranchProtectionRuleInput struct {
+	BranchProtectionRuleSettings
+	RepositoryID           string                     `json:"repositoryId,omitempty"`
+	BranchProtectionRuleID string                     `json:"branchProtectionRuleId,omitempty"`
+	RequiredStatusChecks   []RequiredStatusCheckInput `json:"requiredStatusChecks"`
+}
+
+// RequiredStatusCheckInput is a status check to require. AppID is the node ID of
+// the app that has to set it, or "any".
+type RequiredStatusCheckInput struct {
+	Context string `json:"context"`
+	AppID   string `json:"appId"`
+}
+
+// RepositoryBranchProtectionRules are the branch protection rules of a repository.
+type RepositoryBranchProtectionRules struct {
+	// RepositoryID is the node ID of the repository, needed to create rules.
+	RepositoryID string
+	Rules        []*BranchProtectionRule
+}
+
+// branchProtectionRulesService manages branch protection rules through the
+// GraphQL API, which unlike the REST API supports rules for branch name
+// patterns, even if no branch matches them yet.
+type branchProtectionRulesService struct {
+	client *github.Client
+}
+
+// graphQLPath is the path of the GraphQL API relative to the REST API, which
+// is /graphql on github.com and /api/graphql on GitHub Enterprise Server.
+const graphQLPath = "../graphql"
+
+type graphQLRequest struct {
+	Query     string                 `json:"query"`
+	Variables map[string]interface{} `json:"variables,omitempty"`
+}
+
+type graphQLError struct {
+	Message string `json:"message"`
+}
+
+type graphQLResponse struct {
+	Data   interface{}    `json:"data"`
+	Errors []graphQLError `json:"errors"`
+}
+
+// do runs a GraphQL query and decodes its data into data.
+func (s *branchProtectionRulesService) do(ctx context.Context, query string, variables map[string]interface{}, data interface{}) error {
+	req, err := s.client.NewRequest("POST", graphQLPath, &graphQLRequest{Query: query, Variables: variables})
+	if err != nil {
+		return err
+	}
+
+	resp := &graphQLResponse{Data: data}
+	if _, err := s.client.Do(ctx, req, resp); err != nil {
+		return err
+	}
+	if len(resp.Errors) > 0 {
+		msgs := make([]string, len(resp.Errors))
+		for i, e := range resp.Errors {
+			msgs[i] = e.Message
+		}
+		return errors.New(strings.Join(msgs, "; "))
+	}
+	return nil
+}
+
+const branchProtectionRuleFields = `
+id
+pattern
+isAdminEnforced
+requiresLinearHistory
+allowsForcePushes
+allowsDeletions
+requiresConversationResolution
+lockBranch
+lockAllowsFetchAndMerge
+requiresCommitSignatures
+restrictsPushes
+blocksCreations
+requiresStatusChecks
+requiresStrictStatusChecks
+requiredStatusChecks { context app { databaseId } }
+requiresApprovingReviews
+requiredApprovingReviewCount
+dismissesStaleReviews
+requiresCodeOwnerReviews
+requireLastPushApproval
+`
+
+const listBranchProtectionRulesQuery = `
+query($owner: String!, $repo: String!, $cursor: String) {
+  repository(owner: $owner, name: $repo) {
+    id
+    branchProtectionRules(first: 100, after: $cursor) {
+      nodes {` + branchProtectionRuleFields + `}
+      pageInfo { hasNextPage endCursor }
+    }
+  }
+}`
+
+// ListBranchProtectionRules lists all branch protection rules of a repository.
+func (s *branchProtectionRulesService) ListBranchProtectionRules(ctx context.Context, owner, repo string) (*RepositoryBranchProtectionRules, error) {
+	res := &RepositoryBranchProtectionRules{}
+	variables := map[string]interface{}{"owner": owner, "repo": repo}
+
+	for {
+		var data struct {
+			Repository struct {
+				ID                    string `json:"id"`
+				BranchProtectionRules struct {
+					Nodes    []*BranchProtectionRule `json:"nodes"`
+					PageInfo struct {
+						HasNextPage bool   `json:"hasNextPage"`
+						EndCursor   string `json:"endCursor"`
+					} `json:"pageInfo"`
+				} `json:"branchProtectionRules"`
+			} `json:"repository"`
+		}
+		if err := s.do(ctx, listBranchProtectionRulesQuery, variables, &data); err != nil {
+			return nil, err
+		}
+
+		res.RepositoryID = data.Repository.ID
+		res.Rules = append(res.Rules, data.Repository.BranchProtectionRules.Nodes...)
+
+		if !data.Repository.BranchProtectionRules.PageInfo.HasNextPage {
+			break
+		}
+		variables["cursor"] = data.Repository.BranchProtectionRules.PageInfo.EndCursor
+	}
+
+	return res, nil
+}
+
+const createBranchProtectionRuleMutation = `
+mutation($input: CreateBranchProtectionRuleInput!) {
+  createBranchProtectionRule(input: $input) { clientMutationId }
+}`
+
+// CreateBranchProtectionRule creates a branch protection rule in the repository
+// identified by input.RepositoryID.
+func (s *branchProtectionRulesService) CreateBranchProtectionRule(ctx context.Context, input *BranchProtectionRuleInput) error {
+	return s.do(ctx, createBranchProtectionRuleMutation, map[string]interface{}{"input": input}, &struct{}{})
+}
+
+const updateBranchProtectionRuleMutation = `
+mutation($input: UpdateBranchProtectionRuleInput!) {
+  updateBranchProtectionRule(input: $input) { clientMutationId }
+}`
+
+// UpdateBranchProtectionRule updates the branch protection rule identified by
+// input.BranchProtectionRuleID.
+func (s *branchProtectionRulesService) UpdateBranchProtectionRule(ctx context.Context, input *BranchProtectionRuleInput) error {
+	return s.do(ctx, updateBranchProtectionRuleMutation, map[string]interface{}{"input": input}, &struct{}{})
+}
+
+const deleteBranchProtectionRuleMutation = `
+mutation($id: ID!) {
+  deleteBranchProtectionRule(input: {branchProtectionRuleId: $id}) { clientMutationId }
+}`
+
+// DeleteBranchProtectionRule deletes the branch protection rule with the given node ID.
+func (s *branchProtectionRulesService) DeleteBranchProtectionRule(ctx context.Context, id string) error {
+	return s.do(ctx, deleteBranchProtectionRuleMutation, map[string]interface{}{"id": id}, &struct{}{})
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"container/list"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/crossplane/provider-github/internal/metrics"
+)
+
+// etagCacheSize is the number of GET responses kept for conditional requests,
+// enough for the Observe calls of about a thousand repositories.
+const etagCacheSize = 20000
+
+// etags is shared by all clients, since a new client is created for every
+// reconcile.
+var etags = newETagCache(etagCacheSize)
+
+// cachedResponse is a GET response that GitHub returned with an ETag.
+type cachedResponse struct {
+	key    string
+	etag   string
+	header http.Header
+	body   []byte
+}
+
+// etagCache is a least recently used cache of GET responses.
+type etagCache struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+func newETagCache(size int) *etagCache {
+	return &etagCache{size: size, order: list.New(), entries: make(map[string]*list.Element)}
+}
+
+func (c *etagCache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(e)
+	return e.Value.(*cachedResponse), true
+}
+
+func (c *etagCache) add(r *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.entries[r.key]; ok {
+		e.Value = r
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[r.key] = c.order.PushFront(r)
+	if c.order.Len() > c.size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*cachedResponse).key)
+	}
+}
+
+// etagTransport makes GET requests conditional on the ETag of their last
+// response, and serves that response again when GitHub answers 304 Not
+// Modified. Conditional requests answered with 304 don't count against the
+// rate limit of GitHub.
+type etagTransport struct {
+	next  http.RoundTripper
+	cache *etagCache
+
+	// scope separates the responses of different credentials, which may
+	// see different resources.
+	scope string
+}
+
+func (t *etagTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return t.next.RoundTrip(req)
+	}
+
+	key := t.scope + " " + req.Header.Get("Accept") + " " + req.URL.String()
+	cached, ok := t.cache.get(key)
+	if ok {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", cached.etag)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if ok && resp.StatusCode == http.StatusNotModified {
+		metrics.RecordConditionalRequest(true)
+		_ = resp.Body.Close()
+		return cached.response(req, resp.Header), nil
+	}
+	metrics.RecordConditionalRequest(false)
+
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || etag == "" {
+		return resp, nil
+	}
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	t.cache.add(&cachedResponse{key: key, etag: etag, header: resp.Header.Clone(), body: body})
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, nil
+}
+
+// response returns the cached response to req, with its headers updated by
+// the ones of the 304 response, such as the current rate limit.
+func (r *cachedResponse) response(req *http.Request, notModified http.Header) *http.Response {
+	header := r.header.Clone()
+	for k, v := range notModified {
+		if k != "Content-Length" {
+			header[k] = v
+		}
+	}
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         req.Proto,
+		ProtoMajor:    req.ProtoMajor,
+		ProtoMinor:    req.ProtoMinor,
+		Header:        header,
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
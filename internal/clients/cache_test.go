@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// etagServer answers GET requests with an ETag, and with 304 Not Modified to
+// the requests conditional on it. It counts the requests it answered with a
+// body.
+func etagServer(t *testing.T, served *int32) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		etag := `"` + r.URL.Path + `"`
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		if r.Header.Get("If-None-Match") == etag {
+			w.Header().Set("X-RateLimit-Remaining", "4998")
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		atomic.AddInt32(served, 1)
+		w.Header().Set("ETag", etag)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = io.WriteString(w, `{"path":"`+r.URL.Path+`"}`)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func get(t *testing.T, rt http.RoundTripper, url string) *http.Response {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip(%s): %v", url, err)
+	}
+	return resp
+}
+
+func body(t *testing.T, resp *http.Response) string {
+	t.Helper()
+	defer func() { _ = resp.Body.Close() }()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b)
+}
+
+func TestETagTransport(t *testing.T) {
+	type want struct {
+		served    int32
+		body      string
+		status    int
+		remaining string
+		header    string
+	}
+
+	cases := map[string]struct {
+		reason string
+		// requests are the scopes and paths requested in order; the last one
+		// is checked.
+		requests [][2]string
+		size     int
+		want     want
+	}{
+		"NotModified": {
+			reason:   "A 304 response should replay the cached body and headers, with the rate limit of the 304 response.",
+			requests: [][2]string{{"a", "/repos/org/repo"}, {"a", "/repos/org/repo"}},
+			size:     10,
+			want:     want{served: 1, body: `{"path":"/repos/org/repo"}`, status: http.StatusOK, remaining: "4998", header: "application/json"},
+		},
+		"PerCredential": {
+			reason:   "The responses of a credential should not be replayed for another credential.",
+			requests: [][2]string{{"a", "/repos/org/repo"}, {"b", "/repos/org/repo"}},
+			size:     10,
+			want:     want{served: 2, body: `{"path":"/repos/org/repo"}`, status: http.StatusOK, remaining: "4999", header: "application/json"},
+		},
+		"PerURL": {
+			reason:   "The response of a URL should not be replayed for another URL.",
+			requests: [][2]string{{"a", "/repos/org/repo"}, {"a", "/repos/org/other"}},
+			size:     10,
+			want:     want{served: 2, body: `{"path":"/repos/org/other"}`, status: http.StatusOK, remaining: "4999", header: "application/json"},
+		},
+		"Evicted": {
+			reason:   "The least recently used response should be evicted once the cache is full.",
+			requests: [][2]string{{"a", "/one"}, {"a", "/two"}, {"a", "/one"}},
+			size:     1,
+			want:     want{served: 3, body: `{"path":"/one"}`, status: http.StatusOK, remaining: "4999", header: "application/json"},
+		},
+		"RecentlyUsedKept": {
+			reason:   "A response used again should be kept over the least recently used one.",
+			requests: [][2]string{{"a", "/one"}, {"a", "/two"}, {"a", "/one"}, {"a", "/three"}, {"a", "/one"}},
+			size:     2,
+			want:     want{served: 3, body: `{"path":"/one"}`, status: http.StatusOK, remaining: "4998", header: "application/json"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var served int32
+			srv := etagServer(t, &served)
+			cache := newETagCache(tc.size)
+
+			var resp *http.Response
+			for _, r := range tc.requests {
+				rt := &etagTransport{next: http.DefaultTransport, cache: cache, scope: r[0]}
+				resp = get(t, rt, srv.URL+r[1])
+				got := body(t, resp)
+				if r == tc.requests[len(tc.requests)-1] {
+					if diff := cmp.Diff(tc.want.body, got); diff != "" {
+						t.Errorf("\n%s\nRoundTrip(...): -want body, +got body:\n%s\n", tc.reason, diff)
+					}
+				}
+			}
+			if diff := cmp.Diff(tc.want.served, atomic.LoadInt32(&served)); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want served, +got served:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.status, resp.StatusCode); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.remaining, resp.Header.Get("X-RateLimit-Remaining")); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want rate limit, +got rate limit:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.header, resp.Header.Get("Content-Type")); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want Content-Type, +got Content-Type:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestETagTransportNotGET(t *testing.T) {
+	var served int32
+	srv := etagServer(t, &served)
+	rt := &etagTransport{next: http.DefaultTransport, cache: newETagCache(10), scope: "a"}
+
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodPost, srv.URL+"/repos", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := rt.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip(...): %v", err)
+		}
+		_ = body(t, resp)
+	}
+	if served != 2 {
+		t.Errorf("RoundTrip(...): requests other than GET should never be conditional, want 2 served, got %d", served)
+	}
+}
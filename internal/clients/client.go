@@ -22,6 +22,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/bradleyfalzon/ghinstallation/v2"
 	"github.com/google/go-github/v62/github"
@@ -29,11 +30,31 @@ import (
 
 type Client struct {
 	Actions       ActionsClient
+	Apps          AppsClient
+	Billing       BillingClient
+	CodeScanning  CodeScanningClient
+	Codespaces    CodespacesClient
 	Dependabot    DependabotClient
+	Git           GitClient
+	Issues        IssuesClient
 	Organizations OrganizationsClient
 	Users         UsersClient
 	Teams         TeamsClient
 	Repositories  RepositoriesClient
+
+	// BranchProtectionRules manages branch protection rules for branch name
+	// patterns, which the REST API does not support.
+	BranchProtectionRules BranchProtectionRulesClient
+	// OrganizationRoles manages organization roles and their assignments,
+	// which go-github does not support yet.
+	OrganizationRoles OrganizationRolesClient
+	// OrganizationRulesets manages the rulesets of organizations, whose
+	// repository property conditions go-github does not support yet.
+	OrganizationRulesets OrganizationRulesetsClient
+
+	// scope identifies the credentials of the client, whose rate limit is
+	// shared by all clients created with them.
+	scope string
 }
 
 type ActionsClient interface {
@@ -43,21 +64,113 @@ type ActionsClient interface {
 	GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
 	ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
 	SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	CreateWorkflowDispatchEventByFileName(ctx context.Context, owner, repo, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error)
+	ListWorkflowRunsByFileName(ctx context.Context, owner, repo, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	GetWorkflowRunByID(ctx context.Context, owner, repo string, runID int64) (*github.WorkflowRun, *github.Response, error)
+	GetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	SetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+	GetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	SetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+	GetActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error)
+	EditActionsPermissions(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error)
+	CreateOrganizationRegistrationToken(ctx context.Context, org string) (*github.RegistrationToken, *github.Response, error)
+	CreateOrganizationRemoveToken(ctx context.Context, org string) (*github.RemoveToken, *github.Response, error)
+}
+
+type AppsClient interface {
+	Get(ctx context.Context, appSlug string) (*github.App, *github.Response, error)
+}
+
+type BillingClient interface {
+	GetActionsBillingOrg(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error)
+	GetStorageBillingOrg(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error)
+}
+
+type BranchProtectionRulesClient interface {
+	ListBranchProtectionRules(ctx context.Context, owner, repo string) (*RepositoryBranchProtectionRules, error)
+	CreateBranchProtectionRule(ctx context.Context, input *BranchProtectionRuleInput) error
+	UpdateBranchProtectionRule(ctx context.Context, input *BranchProtectionRuleInput) error
+	DeleteBranchProtectionRule(ctx context.Context, id string) error
+}
+
+type CodeScanningClient interface {
+	GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*github.DefaultSetupConfiguration, *github.Response, error)
+	UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error)
+}
+
+type CodespacesClient interface {
+	GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
+	ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
 }
 
 type DependabotClient interface {
 	GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
 	ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
 	SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids github.DependabotSecretsSelectedRepoIDs) (*github.Response, error)
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error)
+	ListOrgAlerts(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error)
+	UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error)
+}
+
+type GitClient interface {
+	GetRef(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error)
+	CreateRef(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error)
+	DeleteRef(ctx context.Context, owner string, repo string, ref string) (*github.Response, error)
+}
+
+type IssuesClient interface {
+	Create(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	Get(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error)
+	Edit(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	ListByRepo(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+}
+
+type OrganizationRolesClient interface {
+	ListRoles(ctx context.Context, org string) (*OrganizationRoles, *github.Response, error)
+	CreateCustomOrgRole(ctx context.Context, org string, opts *OrganizationRoleOptions) (*OrganizationRole, *github.Response, error)
+	UpdateCustomOrgRole(ctx context.Context, org string, roleID int64, opts *OrganizationRoleOptions) (*OrganizationRole, *github.Response, error)
+	DeleteCustomOrgRole(ctx context.Context, org string, roleID int64) (*github.Response, error)
+	ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	AssignOrgRoleToTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error)
+	RemoveOrgRoleFromTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error)
+	ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	AssignOrgRoleToUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error)
+	RemoveOrgRoleFromUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error)
+}
+
+type OrganizationRulesetsClient interface {
+	GetAllOrganizationRulesets(ctx context.Context, org string, opts *github.ListOptions) ([]*OrganizationRuleset, *github.Response, error)
+	GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*OrganizationRuleset, *github.Response, error)
+	CreateOrganizationRuleset(ctx context.Context, org string, rs *OrganizationRuleset) (*OrganizationRuleset, *github.Response, error)
+	UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *OrganizationRuleset) (*OrganizationRuleset, *github.Response, error)
+	DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*github.Response, error)
 }
 
 type OrganizationsClient interface {
 	Get(ctx context.Context, org string) (*github.Organization, *github.Response, error)
 	Edit(ctx context.Context, name string, org *github.Organization) (*github.Organization, *github.Response, error)
 	GetOrgMembership(ctx context.Context, user, org string) (*github.Membership, *github.Response, error)
+	ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error)
 	CreateOrgInvitation(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error)
 	EditOrgMembership(ctx context.Context, user, org string, membership *github.Membership) (*github.Membership, *github.Response, error)
 	RemoveOrgMembership(ctx context.Context, user, org string) (*github.Response, error)
+	ListCustomRepoRoles(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error)
+	CreateCustomRepoRole(ctx context.Context, org string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	UpdateCustomRepoRole(ctx context.Context, org, roleID string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	DeleteCustomRepoRole(ctx context.Context, org, roleID string) (*github.Response, error)
+	GetAllCustomProperties(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error)
+	CreateOrUpdateCustomProperties(ctx context.Context, org string, properties []*github.CustomProperty) ([]*github.CustomProperty, *github.Response, error)
+	ListBlockedUsers(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	BlockUser(ctx context.Context, org string, user string) (*github.Response, error)
+	ListSecurityManagerTeams(ctx context.Context, org string) ([]*github.Team, *github.Response, error)
+	AddSecurityManagerTeam(ctx context.Context, org, team string) (*github.Response, error)
+	RemoveSecurityManagerTeam(ctx context.Context, org, team string) (*github.Response, error)
 }
 
 type UsersClient interface {
@@ -74,10 +187,15 @@ type TeamsClient interface {
 	DeleteTeamBySlug(ctx context.Context, org, slug string) (*github.Response, error)
 	AddTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error)
 	RemoveTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Response, error)
+	IsTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error)
+	ListIDPGroupsForTeamBySlug(ctx context.Context, org, slug string) (*github.IDPGroupList, *github.Response, error)
+	CreateOrUpdateIDPGroupConnectionsBySlug(ctx context.Context, org, slug string, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error)
 }
 
 type RepositoriesClient interface {
 	Get(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
+	GetByID(ctx context.Context, id int64) (*github.Repository, *github.Response, error)
+	ListByOrg(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error)
 	Edit(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error)
 	ListTeams(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
 	ListCollaborators(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error)
@@ -91,21 +209,48 @@ type RepositoriesClient interface {
 	EditHook(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error)
 	DeleteHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
 	ListHooks(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error)
+	PingHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+	ListHookDeliveries(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
 	ListBranches(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error)
+	GetBranch(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error)
+	CreateStatus(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error)
 	GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error)
 	UpdateBranchProtection(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error)
 	RemoveBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Response, error)
 	RequireSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*github.SignaturesProtectedBranch, *github.Response, error)
 	OptionalSignaturesOnProtectedBranch(ctx context.Context, owner, repo, branch string) (*github.Response, error)
-	GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool) ([]*github.Ruleset, *github.Response, error)
+	GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *github.ListOptions) ([]*github.Ruleset, *github.Response, error)
 	GetRuleset(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error)
 	CreateRuleset(ctx context.Context, owner, repo string, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error)
 	UpdateRuleset(ctx context.Context, owner, repo string, rulesetID int64, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error)
 	DeleteRuleset(ctx context.Context, owner, repo string, rulesetID int64) (*github.Response, error)
+	GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	CreateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error)
+	GetEnvironment(ctx context.Context, owner, repo, name string) (*github.Environment, *github.Response, error)
+	UpdateEnvironmentBranchPolicy(ctx context.Context, owner, repo, name string, policy *github.BranchPolicy) (*github.Environment, *github.Response, error)
+	ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string, opts *github.ListOptions) ([]*github.DeploymentBranchPolicy, *github.Response, error)
+	GetSecretScanningDelegatedBypass(ctx context.Context, owner, repo string) (*SecretScanningDelegatedBypass, *github.Response, error)
+	UpdateSecretScanningDelegatedBypass(ctx context.Context, owner, repo string, bypass *SecretScanningDelegatedBypass) (*github.Response, error)
+	CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error)
+	DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*github.Response, error)
+	ReplaceAllTopics(ctx context.Context, owner, repo string, topics []string) ([]string, *github.Response, error)
+	GetAllCustomPropertyValues(ctx context.Context, org, repo string) ([]*github.CustomPropertyValue, *github.Response, error)
+	CreateOrUpdateCustomProperties(ctx context.Context, org, repo string, customPropertyValues []*github.CustomPropertyValue) (*github.Response, error)
+	Dispatch(ctx context.Context, owner, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error)
+	RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*github.Branch, *github.Response, error)
+	GetCodeownersErrors(ctx context.Context, owner, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error)
 }
 
 // NewClient creates a new client.
-func NewClient(creds string) (*Client, error) {
+func NewClient(creds string, opts ...Option) (*Client, error) {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	if len(o.pool) > 0 {
+		creds = pickCredentials(append([]string{creds}, o.pool...), time.Now())
+	}
 	credss := strings.Split(creds, ",")
 	if len(credss) != 3 {
 		return nil, errors.New("Invalid format for credentials!")
@@ -121,23 +266,54 @@ func NewClient(creds string) (*Client, error) {
 		return nil, err
 	}
 
-	itr, err := ghinstallation.New(http.DefaultTransport, int64(appId), int64(installationId), []byte(credss[2]))
+	transport, err := transports.get(o.transport)
 	if err != nil {
 		return nil, err
 	}
 
-	ghclient := github.NewClient(&http.Client{Transport: itr})
+	itr, err := ghinstallation.New(transport, int64(appId), int64(installationId), []byte(credss[2]))
 	if err != nil {
 		return nil, err
 	}
 
+	scope := credentialsScope(creds)
+	ghclient := github.NewClient(&http.Client{Transport: &etagTransport{
+		next: &retryTransport{
+			next:    &rateLimitTransport{next: itr, tracker: rateLimits, scope: scope},
+			tracker: rateLimits,
+			scope:   scope,
+		},
+		cache: etags,
+		scope: scope,
+	}})
+	if o.baseURL != "" {
+		ghclient, err = ghclient.WithEnterpriseURLs(o.baseURL, o.uploadURL)
+		if err != nil {
+			return nil, err
+		}
+		// Installation tokens are minted by the same server.
+		itr.BaseURL = strings.TrimSuffix(ghclient.BaseURL.String(), "/")
+	}
+
 	return &Client{
 		Actions:       ghclient.Actions,
+		Apps:          ghclient.Apps,
+		Billing:       ghclient.Billing,
+		CodeScanning:  ghclient.CodeScanning,
+		Codespaces:    ghclient.Codespaces,
 		Dependabot:    ghclient.Dependabot,
+		Git:           ghclient.Git,
+		Issues:        ghclient.Issues,
 		Organizations: ghclient.Organizations,
 		Users:         ghclient.Users,
 		Teams:         ghclient.Teams,
-		Repositories:  ghclient.Repositories,
+		Repositories:  &repositoriesService{RepositoriesService: ghclient.Repositories, client: ghclient},
+
+		BranchProtectionRules: &branchProtectionRulesService{client: ghclient},
+		OrganizationRoles:     &organizationRolesService{OrganizationsService: ghclient.Organizations, client: ghclient},
+		OrganizationRulesets:  &organizationRulesetsService{client: ghclient},
+
+		scope: scope,
 	}, nil
 }
 
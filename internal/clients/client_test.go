@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+)
+
+func TestWithProviderConfig(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		spec   apisv1alpha1.ProviderConfigSpec
+		want   options
+	}{
+		"GitHub": {
+			reason: "A ProviderConfig without a base URL should connect to github.com.",
+			spec:   apisv1alpha1.ProviderConfigSpec{ProxyURL: pointer.String("http://proxy.example.org:3128")},
+			want:   options{transport: TransportConfig{ProxyURL: "http://proxy.example.org:3128"}},
+		},
+		"EnterpriseServer": {
+			reason: "A ProviderConfig with a base URL should connect to it and upload to it.",
+			spec:   apisv1alpha1.ProviderConfigSpec{BaseURL: pointer.String("https://github.example.org"), CABundle: pointer.String("bundle")},
+			want: options{
+				transport: TransportConfig{CABundle: "bundle"},
+				baseURL:   "https://github.example.org",
+				uploadURL: "https://github.example.org",
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := options{}
+			WithProviderConfig(&apisv1alpha1.ProviderConfig{Spec: tc.spec})(&got)
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(options{})); diff != "" {
+				t.Errorf("\n%s\nWithProviderConfig(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewClientEnterpriseURLs(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("rsa.GenerateKey(...): %v", err)
+	}
+	pemKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+
+	var mu sync.Mutex
+	var paths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.URL.Path)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v3/app/installations/2/access_tokens":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"token":"token","expires_at":"2099-01-01T00:00:00Z"}`))
+		case "/api/graphql":
+			_, _ = w.Write([]byte(`{"data":{"repository":{"id":"R_1","branchProtectionRules":{"nodes":[],"pageInfo":{"hasNextPage":false}}}}}`))
+		default:
+			_, _ = w.Write([]byte(`{}`))
+		}
+	}))
+	defer server.Close()
+
+	c, err := NewClient("1,2,"+string(pemKey), WithEnterpriseURLs(server.URL, ""))
+	if err != nil {
+		t.Fatalf("NewClient(...): %v", err)
+	}
+	if _, _, err := c.Repositories.Get(context.Background(), "org", "repo"); err != nil {
+		t.Fatalf("Repositories.Get(...): %v", err)
+	}
+	if _, err := c.BranchProtectionRules.ListBranchProtectionRules(context.Background(), "org", "repo"); err != nil {
+		t.Fatalf("ListBranchProtectionRules(...): %v", err)
+	}
+
+	// The installation token is minted once, by the Enterprise Server, before
+	// the REST and GraphQL APIs of the server are called with it.
+	want := []string{"/api/v3/app/installations/2/access_tokens", "/api/v3/repos/org/repo", "/api/graphql"}
+	if diff := cmp.Diff(want, paths); diff != "" {
+		t.Errorf("NewClient(...): -want requested paths, +got requested paths:\n%s\n", diff)
+	}
+}
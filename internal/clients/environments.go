@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// environmentBranchPolicy is the body of a request that only sets the
+// deployment branch policy of an environment.
+type environmentBranchPolicy struct {
+	DeploymentBranchPolicy *github.BranchPolicy `json:"deployment_branch_policy"`
+}
+
+// UpdateEnvironmentBranchPolicy creates or updates an environment with the
+// specified deployment branch policy, or without one if policy is nil. Unlike
+// CreateUpdateEnvironment, it leaves the wait timer and reviewers of an
+// existing environment as they are.
+func (s *repositoriesService) UpdateEnvironmentBranchPolicy(ctx context.Context, owner, repo, name string, policy *github.BranchPolicy) (*github.Environment, *github.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/environments/%s", owner, repo, url.PathEscape(name))
+
+	req, err := s.client.NewRequest("PUT", u, &environmentBranchPolicy{DeploymentBranchPolicy: policy})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	env := &github.Environment{}
+	resp, err := s.client.Do(ctx, req, env)
+	if err != nil {
+		return nil, resp, err
+	}
+	return env, resp, nil
+}
+
+// ListDeploymentBranchPolicies gets a page of the deployment branch policies
+// of an environment, which go-github can't request other pages than the first
+// of.
+func (s *repositoriesService) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string, opts *github.ListOptions) ([]*github.DeploymentBranchPolicy, *github.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s/environments/%s/deployment-branch-policies", owner, repo, url.PathEscape(environment))
+	if opts != nil {
+		u += fmt.Sprintf("?per_page=%v&page=%v", opts.PerPage, opts.Page)
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	policies := &github.DeploymentBranchPolicyResponse{}
+	resp, err := s.client.Do(ctx, req, policies)
+	if err != nil {
+		return nil, resp, err
+	}
+	return policies.BranchPolicies, resp, nil
+}
@@ -5,15 +5,31 @@ import (
 	"net/http"
 
 	"github.com/google/go-github/v62/github"
+
+	ghclient "github.com/crossplane/provider-github/internal/clients"
 )
 
 type MockActionsClient struct {
-	MockListEnabledReposInOrg         func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error)
-	MockAddEnabledReposInOrg          func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
-	MockRemoveEnabledReposInOrg       func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
-	MockGetOrgSecret                  func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
-	MockListSelectedReposForOrgSecret func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
-	MockSetSelectedReposForOrgSecret  func(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	MockListEnabledReposInOrg                 func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error)
+	MockAddEnabledReposInOrg                  func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
+	MockRemoveEnabledReposInOrg               func(ctx context.Context, owner string, repositoryID int64) (*github.Response, error)
+	MockGetOrgSecret                          func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
+	MockListSelectedReposForOrgSecret         func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	MockSetSelectedReposForOrgSecret          func(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	MockCreateWorkflowDispatchEventByFileName func(ctx context.Context, owner, repo, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error)
+	MockListWorkflowRunsByFileName            func(ctx context.Context, owner, repo, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error)
+	MockGetWorkflowRunByID                    func(ctx context.Context, owner, repo string, runID int64) (*github.WorkflowRun, *github.Response, error)
+
+	MockGetOrgOIDCSubjectClaimCustomTemplate  func(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	MockSetOrgOIDCSubjectClaimCustomTemplate  func(ctx context.Context, org string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+	MockGetRepoOIDCSubjectClaimCustomTemplate func(ctx context.Context, owner, repo string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error)
+	MockSetRepoOIDCSubjectClaimCustomTemplate func(ctx context.Context, owner, repo string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error)
+
+	MockGetActionsPermissions  func(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error)
+	MockEditActionsPermissions func(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error)
+
+	MockCreateOrganizationRegistrationToken func(ctx context.Context, org string) (*github.RegistrationToken, *github.Response, error)
+	MockCreateOrganizationRemoveToken       func(ctx context.Context, org string) (*github.RemoveToken, *github.Response, error)
 }
 
 func (m *MockActionsClient) ListEnabledReposInOrg(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
@@ -40,10 +56,149 @@ func (m *MockActionsClient) SetSelectedReposForOrgSecret(ctx context.Context, or
 	return m.MockSetSelectedReposForOrgSecret(ctx, org, name, ids)
 }
 
+func (m *MockActionsClient) CreateWorkflowDispatchEventByFileName(ctx context.Context, owner, repo, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error) {
+	return m.MockCreateWorkflowDispatchEventByFileName(ctx, owner, repo, workflowFileName, event)
+}
+
+func (m *MockActionsClient) ListWorkflowRunsByFileName(ctx context.Context, owner, repo, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+	return m.MockListWorkflowRunsByFileName(ctx, owner, repo, workflowFileName, opts)
+}
+
+func (m *MockActionsClient) GetWorkflowRunByID(ctx context.Context, owner, repo string, runID int64) (*github.WorkflowRun, *github.Response, error) {
+	return m.MockGetWorkflowRunByID(ctx, owner, repo, runID)
+}
+
+func (m *MockActionsClient) GetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error) {
+	return m.MockGetOrgOIDCSubjectClaimCustomTemplate(ctx, org)
+}
+
+func (m *MockActionsClient) SetOrgOIDCSubjectClaimCustomTemplate(ctx context.Context, org string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error) {
+	return m.MockSetOrgOIDCSubjectClaimCustomTemplate(ctx, org, template)
+}
+
+func (m *MockActionsClient) GetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error) {
+	return m.MockGetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repo)
+}
+
+func (m *MockActionsClient) SetRepoOIDCSubjectClaimCustomTemplate(ctx context.Context, owner, repo string, template *github.OIDCSubjectClaimCustomTemplate) (*github.Response, error) {
+	return m.MockSetRepoOIDCSubjectClaimCustomTemplate(ctx, owner, repo, template)
+}
+
+func (m *MockActionsClient) GetActionsPermissions(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error) {
+	return m.MockGetActionsPermissions(ctx, org)
+}
+
+func (m *MockActionsClient) EditActionsPermissions(ctx context.Context, org string, actionsPermissions github.ActionsPermissions) (*github.ActionsPermissions, *github.Response, error) {
+	return m.MockEditActionsPermissions(ctx, org, actionsPermissions)
+}
+
+func (m *MockActionsClient) CreateOrganizationRegistrationToken(ctx context.Context, org string) (*github.RegistrationToken, *github.Response, error) {
+	return m.MockCreateOrganizationRegistrationToken(ctx, org)
+}
+
+func (m *MockActionsClient) CreateOrganizationRemoveToken(ctx context.Context, org string) (*github.RemoveToken, *github.Response, error) {
+	return m.MockCreateOrganizationRemoveToken(ctx, org)
+}
+
+type MockBillingClient struct {
+	MockGetActionsBillingOrg func(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error)
+	MockGetStorageBillingOrg func(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error)
+}
+
+func (m *MockBillingClient) GetActionsBillingOrg(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error) {
+	return m.MockGetActionsBillingOrg(ctx, org)
+}
+
+func (m *MockBillingClient) GetStorageBillingOrg(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error) {
+	return m.MockGetStorageBillingOrg(ctx, org)
+}
+
+type MockBranchProtectionRulesClient struct {
+	MockListBranchProtectionRules  func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error)
+	MockCreateBranchProtectionRule func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error
+	MockUpdateBranchProtectionRule func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error
+	MockDeleteBranchProtectionRule func(ctx context.Context, id string) error
+}
+
+func (m *MockBranchProtectionRulesClient) ListBranchProtectionRules(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+	return m.MockListBranchProtectionRules(ctx, owner, repo)
+}
+
+func (m *MockBranchProtectionRulesClient) CreateBranchProtectionRule(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+	return m.MockCreateBranchProtectionRule(ctx, input)
+}
+
+func (m *MockBranchProtectionRulesClient) UpdateBranchProtectionRule(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+	return m.MockUpdateBranchProtectionRule(ctx, input)
+}
+
+func (m *MockBranchProtectionRulesClient) DeleteBranchProtectionRule(ctx context.Context, id string) error {
+	return m.MockDeleteBranchProtectionRule(ctx, id)
+}
+
+type MockAppsClient struct {
+	MockGet func(ctx context.Context, appSlug string) (*github.App, *github.Response, error)
+}
+
+func (m *MockAppsClient) Get(ctx context.Context, appSlug string) (*github.App, *github.Response, error) {
+	return m.MockGet(ctx, appSlug)
+}
+
+type MockCodeScanningClient struct {
+	MockGetDefaultSetupConfiguration    func(ctx context.Context, owner, repo string) (*github.DefaultSetupConfiguration, *github.Response, error)
+	MockUpdateDefaultSetupConfiguration func(ctx context.Context, owner, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error)
+}
+
+func (m *MockCodeScanningClient) GetDefaultSetupConfiguration(ctx context.Context, owner, repo string) (*github.DefaultSetupConfiguration, *github.Response, error) {
+	return m.MockGetDefaultSetupConfiguration(ctx, owner, repo)
+}
+
+func (m *MockCodeScanningClient) UpdateDefaultSetupConfiguration(ctx context.Context, owner, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error) {
+	return m.MockUpdateDefaultSetupConfiguration(ctx, owner, repo, options)
+}
+
+type MockCodespacesClient struct {
+	MockGetOrgSecret                  func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
+	MockListSelectedReposForOrgSecret func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
+	MockSetSelectedReposForOrgSecret  func(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error)
+	MockGetRepoPublicKey              func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	MockGetRepoSecret                 func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	MockCreateOrUpdateRepoSecret      func(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error)
+}
+
+func (m *MockCodespacesClient) GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetOrgSecret(ctx, org, name)
+}
+
+func (m *MockCodespacesClient) ListSelectedReposForOrgSecret(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+	return m.MockListSelectedReposForOrgSecret(ctx, org, name, opts)
+}
+
+func (m *MockCodespacesClient) SetSelectedReposForOrgSecret(ctx context.Context, org, name string, ids github.SelectedRepoIDs) (*github.Response, error) {
+	return m.MockSetSelectedReposForOrgSecret(ctx, org, name, ids)
+}
+
+func (m *MockCodespacesClient) GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+	return m.MockGetRepoPublicKey(ctx, owner, repo)
+}
+
+func (m *MockCodespacesClient) GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetRepoSecret(ctx, owner, repo, name)
+}
+
+func (m *MockCodespacesClient) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.EncryptedSecret) (*github.Response, error) {
+	return m.MockCreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+
 type MockDependabotClient struct {
 	MockGetOrgSecret                  func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error)
 	MockListSelectedReposForOrgSecret func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error)
 	MockSetSelectedReposForOrgSecret  func(ctx context.Context, org, name string, ids github.DependabotSecretsSelectedRepoIDs) (*github.Response, error)
+	MockGetRepoPublicKey              func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	MockGetRepoSecret                 func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	MockCreateOrUpdateRepoSecret      func(ctx context.Context, owner, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error)
+	MockListOrgAlerts                 func(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error)
+	MockUpdateAlert                   func(ctx context.Context, owner, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error)
 }
 
 func (m *MockDependabotClient) GetOrgSecret(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
@@ -58,13 +213,89 @@ func (m *MockDependabotClient) SetSelectedReposForOrgSecret(ctx context.Context,
 	return m.MockSetSelectedReposForOrgSecret(ctx, org, name, ids)
 }
 
+func (m *MockDependabotClient) GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+	return m.MockGetRepoPublicKey(ctx, owner, repo)
+}
+
+func (m *MockDependabotClient) GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+	return m.MockGetRepoSecret(ctx, owner, repo, name)
+}
+
+func (m *MockDependabotClient) CreateOrUpdateRepoSecret(ctx context.Context, owner, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error) {
+	return m.MockCreateOrUpdateRepoSecret(ctx, owner, repo, eSecret)
+}
+
+func (m *MockDependabotClient) ListOrgAlerts(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error) {
+	return m.MockListOrgAlerts(ctx, org, opts)
+}
+
+func (m *MockDependabotClient) UpdateAlert(ctx context.Context, owner, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error) {
+	return m.MockUpdateAlert(ctx, owner, repo, number, stateInfo)
+}
+
+type MockGitClient struct {
+	MockGetRef    func(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error)
+	MockCreateRef func(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error)
+	MockDeleteRef func(ctx context.Context, owner string, repo string, ref string) (*github.Response, error)
+}
+
+func (m *MockGitClient) GetRef(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error) {
+	return m.MockGetRef(ctx, owner, repo, ref)
+}
+
+func (m *MockGitClient) CreateRef(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+	return m.MockCreateRef(ctx, owner, repo, ref)
+}
+
+func (m *MockGitClient) DeleteRef(ctx context.Context, owner string, repo string, ref string) (*github.Response, error) {
+	return m.MockDeleteRef(ctx, owner, repo, ref)
+}
+
+type MockIssuesClient struct {
+	MockCreate     func(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	MockGet        func(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error)
+	MockEdit       func(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error)
+	MockListByRepo func(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error)
+}
+
+func (m *MockIssuesClient) Create(ctx context.Context, owner string, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	return m.MockCreate(ctx, owner, repo, issue)
+}
+
+func (m *MockIssuesClient) Get(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+	return m.MockGet(ctx, owner, repo, number)
+}
+
+func (m *MockIssuesClient) Edit(ctx context.Context, owner string, repo string, number int, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+	return m.MockEdit(ctx, owner, repo, number, issue)
+}
+
+func (m *MockIssuesClient) ListByRepo(ctx context.Context, owner string, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+	return m.MockListByRepo(ctx, owner, repo, opts)
+}
+
 type MockOrganizationsClient struct {
-	MockGet                 func(ctx context.Context, org string) (*github.Organization, *github.Response, error)
-	MockEdit                func(ctx context.Context, name string, org *github.Organization) (*github.Organization, *github.Response, error)
-	MockGetOrgMembership    func(ctx context.Context, user, org string) (*github.Membership, *github.Response, error)
-	MockCreateOrgInvitation func(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error)
-	MockEditOrgMembership   func(ctx context.Context, user, org string, membership *github.Membership) (*github.Membership, *github.Response, error)
-	MockRemoveOrgMembership func(ctx context.Context, user, org string) (*github.Response, error)
+	MockGet                  func(ctx context.Context, org string) (*github.Organization, *github.Response, error)
+	MockEdit                 func(ctx context.Context, name string, org *github.Organization) (*github.Organization, *github.Response, error)
+	MockGetOrgMembership     func(ctx context.Context, user, org string) (*github.Membership, *github.Response, error)
+	MockListMembers          func(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error)
+	MockCreateOrgInvitation  func(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error)
+	MockEditOrgMembership    func(ctx context.Context, user, org string, membership *github.Membership) (*github.Membership, *github.Response, error)
+	MockRemoveOrgMembership  func(ctx context.Context, user, org string) (*github.Response, error)
+	MockListCustomRepoRoles  func(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error)
+	MockCreateCustomRepoRole func(ctx context.Context, org string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	MockUpdateCustomRepoRole func(ctx context.Context, org, roleID string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error)
+	MockDeleteCustomRepoRole func(ctx context.Context, org, roleID string) (*github.Response, error)
+
+	MockGetAllCustomProperties         func(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error)
+	MockCreateOrUpdateCustomProperties func(ctx context.Context, org string, properties []*github.CustomProperty) ([]*github.CustomProperty, *github.Response, error)
+
+	MockListBlockedUsers func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	MockBlockUser        func(ctx context.Context, org string, user string) (*github.Response, error)
+
+	MockListSecurityManagerTeams  func(ctx context.Context, org string) ([]*github.Team, *github.Response, error)
+	MockAddSecurityManagerTeam    func(ctx context.Context, org, team string) (*github.Response, error)
+	MockRemoveSecurityManagerTeam func(ctx context.Context, org, team string) (*github.Response, error)
 }
 
 func (m *MockOrganizationsClient) Get(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
@@ -79,6 +310,10 @@ func (m *MockOrganizationsClient) GetOrgMembership(ctx context.Context, user, or
 	return m.MockGetOrgMembership(ctx, user, org)
 }
 
+func (m *MockOrganizationsClient) ListMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+	return m.MockListMembers(ctx, org, opts)
+}
+
 func (m *MockOrganizationsClient) CreateOrgInvitation(ctx context.Context, org string, opts *github.CreateOrgInvitationOptions) (*github.Invitation, *github.Response, error) {
 	return m.MockCreateOrgInvitation(ctx, org, opts)
 }
@@ -91,6 +326,131 @@ func (m *MockOrganizationsClient) RemoveOrgMembership(ctx context.Context, user,
 	return m.MockRemoveOrgMembership(ctx, user, org)
 }
 
+func (m *MockOrganizationsClient) ListCustomRepoRoles(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error) {
+	return m.MockListCustomRepoRoles(ctx, org)
+}
+
+func (m *MockOrganizationsClient) CreateCustomRepoRole(ctx context.Context, org string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error) {
+	return m.MockCreateCustomRepoRole(ctx, org, opts)
+}
+
+func (m *MockOrganizationsClient) UpdateCustomRepoRole(ctx context.Context, org, roleID string, opts *github.CreateOrUpdateCustomRoleOptions) (*github.CustomRepoRoles, *github.Response, error) {
+	return m.MockUpdateCustomRepoRole(ctx, org, roleID, opts)
+}
+
+func (m *MockOrganizationsClient) DeleteCustomRepoRole(ctx context.Context, org, roleID string) (*github.Response, error) {
+	return m.MockDeleteCustomRepoRole(ctx, org, roleID)
+}
+
+func (m *MockOrganizationsClient) GetAllCustomProperties(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error) {
+	return m.MockGetAllCustomProperties(ctx, org)
+}
+
+func (m *MockOrganizationsClient) CreateOrUpdateCustomProperties(ctx context.Context, org string, properties []*github.CustomProperty) ([]*github.CustomProperty, *github.Response, error) {
+	return m.MockCreateOrUpdateCustomProperties(ctx, org, properties)
+}
+
+func (m *MockOrganizationsClient) ListBlockedUsers(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return m.MockListBlockedUsers(ctx, org, opts)
+}
+
+func (m *MockOrganizationsClient) BlockUser(ctx context.Context, org string, user string) (*github.Response, error) {
+	return m.MockBlockUser(ctx, org, user)
+}
+
+func (m *MockOrganizationsClient) ListSecurityManagerTeams(ctx context.Context, org string) ([]*github.Team, *github.Response, error) {
+	return m.MockListSecurityManagerTeams(ctx, org)
+}
+
+func (m *MockOrganizationsClient) AddSecurityManagerTeam(ctx context.Context, org, team string) (*github.Response, error) {
+	return m.MockAddSecurityManagerTeam(ctx, org, team)
+}
+
+func (m *MockOrganizationsClient) RemoveSecurityManagerTeam(ctx context.Context, org, team string) (*github.Response, error) {
+	return m.MockRemoveSecurityManagerTeam(ctx, org, team)
+}
+
+type MockOrganizationRulesetsClient struct {
+	MockGetAllOrganizationRulesets func(ctx context.Context, org string, opts *github.ListOptions) ([]*ghclient.OrganizationRuleset, *github.Response, error)
+	MockGetOrganizationRuleset     func(ctx context.Context, org string, rulesetID int64) (*ghclient.OrganizationRuleset, *github.Response, error)
+	MockCreateOrganizationRuleset  func(ctx context.Context, org string, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error)
+	MockUpdateOrganizationRuleset  func(ctx context.Context, org string, rulesetID int64, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error)
+	MockDeleteOrganizationRuleset  func(ctx context.Context, org string, rulesetID int64) (*github.Response, error)
+}
+
+func (m *MockOrganizationRulesetsClient) GetAllOrganizationRulesets(ctx context.Context, org string, opts *github.ListOptions) ([]*ghclient.OrganizationRuleset, *github.Response, error) {
+	return m.MockGetAllOrganizationRulesets(ctx, org, opts)
+}
+
+func (m *MockOrganizationRulesetsClient) GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*ghclient.OrganizationRuleset, *github.Response, error) {
+	return m.MockGetOrganizationRuleset(ctx, org, rulesetID)
+}
+
+func (m *MockOrganizationRulesetsClient) CreateOrganizationRuleset(ctx context.Context, org string, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error) {
+	return m.MockCreateOrganizationRuleset(ctx, org, rs)
+}
+
+func (m *MockOrganizationRulesetsClient) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error) {
+	return m.MockUpdateOrganizationRuleset(ctx, org, rulesetID, rs)
+}
+
+func (m *MockOrganizationRulesetsClient) DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*github.Response, error) {
+	return m.MockDeleteOrganizationRuleset(ctx, org, rulesetID)
+}
+
+type MockOrganizationRolesClient struct {
+	MockListRoles                  func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error)
+	MockCreateCustomOrgRole        func(ctx context.Context, org string, opts *ghclient.OrganizationRoleOptions) (*ghclient.OrganizationRole, *github.Response, error)
+	MockUpdateCustomOrgRole        func(ctx context.Context, org string, roleID int64, opts *ghclient.OrganizationRoleOptions) (*ghclient.OrganizationRole, *github.Response, error)
+	MockDeleteCustomOrgRole        func(ctx context.Context, org string, roleID int64) (*github.Response, error)
+	MockListTeamsAssignedToOrgRole func(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
+	MockAssignOrgRoleToTeam        func(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error)
+	MockRemoveOrgRoleFromTeam      func(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error)
+	MockListUsersAssignedToOrgRole func(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.User, *github.Response, error)
+	MockAssignOrgRoleToUser        func(ctx context.Context, org, user string, roleID int64) (*github.Response, error)
+	MockRemoveOrgRoleFromUser      func(ctx context.Context, org, user string, roleID int64) (*github.Response, error)
+}
+
+func (m *MockOrganizationRolesClient) ListRoles(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+	return m.MockListRoles(ctx, org)
+}
+
+func (m *MockOrganizationRolesClient) CreateCustomOrgRole(ctx context.Context, org string, opts *ghclient.OrganizationRoleOptions) (*ghclient.OrganizationRole, *github.Response, error) {
+	return m.MockCreateCustomOrgRole(ctx, org, opts)
+}
+
+func (m *MockOrganizationRolesClient) UpdateCustomOrgRole(ctx context.Context, org string, roleID int64, opts *ghclient.OrganizationRoleOptions) (*ghclient.OrganizationRole, *github.Response, error) {
+	return m.MockUpdateCustomOrgRole(ctx, org, roleID, opts)
+}
+
+func (m *MockOrganizationRolesClient) DeleteCustomOrgRole(ctx context.Context, org string, roleID int64) (*github.Response, error) {
+	return m.MockDeleteCustomOrgRole(ctx, org, roleID)
+}
+
+func (m *MockOrganizationRolesClient) ListTeamsAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+	return m.MockListTeamsAssignedToOrgRole(ctx, org, roleID, opts)
+}
+
+func (m *MockOrganizationRolesClient) AssignOrgRoleToTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error) {
+	return m.MockAssignOrgRoleToTeam(ctx, org, teamSlug, roleID)
+}
+
+func (m *MockOrganizationRolesClient) RemoveOrgRoleFromTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error) {
+	return m.MockRemoveOrgRoleFromTeam(ctx, org, teamSlug, roleID)
+}
+
+func (m *MockOrganizationRolesClient) ListUsersAssignedToOrgRole(ctx context.Context, org string, roleID int64, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+	return m.MockListUsersAssignedToOrgRole(ctx, org, roleID, opts)
+}
+
+func (m *MockOrganizationRolesClient) AssignOrgRoleToUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error) {
+	return m.MockAssignOrgRoleToUser(ctx, org, user, roleID)
+}
+
+func (m *MockOrganizationRolesClient) RemoveOrgRoleFromUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error) {
+	return m.MockRemoveOrgRoleFromUser(ctx, org, user, roleID)
+}
+
 type MockUsersClient struct {
 	MockGet func(ctx context.Context, user string) (*github.User, *github.Response, error)
 }
@@ -101,6 +461,8 @@ func (m *MockUsersClient) Get(ctx context.Context, user string) (*github.User, *
 
 type MockRepositoriesClient struct {
 	MockGet                                 func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error)
+	MockGetByID                             func(ctx context.Context, id int64) (*github.Repository, *github.Response, error)
+	MockListByOrg                           func(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error)
 	MockEdit                                func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error)
 	MockListTeams                           func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error)
 	MockListCollaborators                   func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error)
@@ -114,7 +476,11 @@ type MockRepositoriesClient struct {
 	MockEditHook                            func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error)
 	MockDeleteHook                          func(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
 	MockListHooks                           func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error)
+	MockPingHook                            func(ctx context.Context, owner, repo string, id int64) (*github.Response, error)
+	MockListHookDeliveries                  func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error)
 	MockListBranches                        func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error)
+	MockGetBranch                           func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error)
+	MockCreateStatus                        func(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error)
 	MockGetBranchProtection                 func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error)
 	MockUpdateBranchProtection              func(ctx context.Context, owner, repo, branch string, preq *github.ProtectionRequest) (*github.Protection, *github.Response, error)
 	MockRemoveBranchProtection              func(ctx context.Context, owner, repo, branch string) (*github.Response, error)
@@ -125,12 +491,36 @@ type MockRepositoriesClient struct {
 	MockCreateRuleset                       func(ctx context.Context, owner, repo string, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error)
 	MockUpdateRuleset                       func(ctx context.Context, owner, repo string, rulesetID int64, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error)
 	MockDeleteRuleset                       func(ctx context.Context, owner, repo string, rulesetID int64) (*github.Response, error)
+	MockGetContents                         func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error)
+	MockCreateFile                          func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error)
+	MockCreateUpdateEnvironment             func(ctx context.Context, owner, repo, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error)
+	MockGetEnvironment                      func(ctx context.Context, owner, repo, name string) (*github.Environment, *github.Response, error)
+	MockUpdateEnvironmentBranchPolicy       func(ctx context.Context, owner, repo, name string, policy *github.BranchPolicy) (*github.Environment, *github.Response, error)
+	MockListDeploymentBranchPolicies        func(ctx context.Context, owner, repo, environment string, opts *github.ListOptions) ([]*github.DeploymentBranchPolicy, *github.Response, error)
+	MockGetSecretScanningDelegatedBypass    func(ctx context.Context, owner, repo string) (*ghclient.SecretScanningDelegatedBypass, *github.Response, error)
+	MockUpdateSecretScanningDelegatedBypass func(ctx context.Context, owner, repo string, bypass *ghclient.SecretScanningDelegatedBypass) (*github.Response, error)
+	MockCreateDeploymentBranchPolicy        func(ctx context.Context, owner, repo, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error)
+	MockDeleteDeploymentBranchPolicy        func(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*github.Response, error)
+	MockReplaceAllTopics                    func(ctx context.Context, owner, repo string, topics []string) ([]string, *github.Response, error)
+	MockGetAllCustomPropertyValues          func(ctx context.Context, org, repo string) ([]*github.CustomPropertyValue, *github.Response, error)
+	MockCreateOrUpdateCustomProperties      func(ctx context.Context, org, repo string, customPropertyValues []*github.CustomPropertyValue) (*github.Response, error)
+	MockDispatch                            func(ctx context.Context, owner, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error)
+	MockRenameBranch                        func(ctx context.Context, owner, repo, branch, newName string) (*github.Branch, *github.Response, error)
+	MockGetCodeownersErrors                 func(ctx context.Context, owner, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error)
 }
 
 func (m *MockRepositoriesClient) Get(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
 	return m.MockGet(ctx, owner, repo)
 }
 
+func (m *MockRepositoriesClient) GetByID(ctx context.Context, id int64) (*github.Repository, *github.Response, error) {
+	return m.MockGetByID(ctx, id)
+}
+
+func (m *MockRepositoriesClient) ListByOrg(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+	return m.MockListByOrg(ctx, org, opts)
+}
+
 func (m *MockRepositoriesClient) Edit(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
 	return m.MockEdit(ctx, owner, repo, repository)
 }
@@ -183,10 +573,26 @@ func (m *MockRepositoriesClient) ListHooks(ctx context.Context, owner, repo stri
 	return m.MockListHooks(ctx, owner, repo, opts)
 }
 
+func (m *MockRepositoriesClient) PingHook(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+	return m.MockPingHook(ctx, owner, repo, id)
+}
+
+func (m *MockRepositoriesClient) ListHookDeliveries(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+	return m.MockListHookDeliveries(ctx, owner, repo, id, opts)
+}
+
 func (m *MockRepositoriesClient) ListBranches(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
 	return m.MockListBranches(ctx, owner, repo, opts)
 }
 
+func (m *MockRepositoriesClient) GetBranch(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+	return m.MockGetBranch(ctx, owner, repo, branch, maxRedirects)
+}
+
+func (m *MockRepositoriesClient) CreateStatus(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error) {
+	return m.MockCreateStatus(ctx, owner, repo, ref, status)
+}
+
 func (m *MockRepositoriesClient) GetBranchProtection(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
 	return m.MockGetBranchProtection(ctx, owner, repo, branch)
 }
@@ -207,7 +613,7 @@ func (m *MockRepositoriesClient) OptionalSignaturesOnProtectedBranch(ctx context
 	return m.MockOptionalSignaturesOnProtectedBranch(ctx, owner, repo, branch)
 }
 
-func (m *MockRepositoriesClient) GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool) ([]*github.Ruleset, *github.Response, error) {
+func (m *MockRepositoriesClient) GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *github.ListOptions) ([]*github.Ruleset, *github.Response, error) {
 	return m.MockGetAllRulesets(ctx, owner, repo)
 }
 
@@ -227,6 +633,70 @@ func (m *MockRepositoriesClient) DeleteRuleset(ctx context.Context, owner, repo
 	return m.MockDeleteRuleset(ctx, owner, repo, rulesetID)
 }
 
+func (m *MockRepositoriesClient) GetContents(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+	return m.MockGetContents(ctx, owner, repo, path, opts)
+}
+
+func (m *MockRepositoriesClient) CreateFile(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+	return m.MockCreateFile(ctx, owner, repo, path, opts)
+}
+
+func (m *MockRepositoriesClient) CreateUpdateEnvironment(ctx context.Context, owner, repo, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error) {
+	return m.MockCreateUpdateEnvironment(ctx, owner, repo, name, environment)
+}
+
+func (m *MockRepositoriesClient) GetEnvironment(ctx context.Context, owner, repo, name string) (*github.Environment, *github.Response, error) {
+	return m.MockGetEnvironment(ctx, owner, repo, name)
+}
+
+func (m *MockRepositoriesClient) UpdateEnvironmentBranchPolicy(ctx context.Context, owner, repo, name string, policy *github.BranchPolicy) (*github.Environment, *github.Response, error) {
+	return m.MockUpdateEnvironmentBranchPolicy(ctx, owner, repo, name, policy)
+}
+
+func (m *MockRepositoriesClient) GetSecretScanningDelegatedBypass(ctx context.Context, owner, repo string) (*ghclient.SecretScanningDelegatedBypass, *github.Response, error) {
+	return m.MockGetSecretScanningDelegatedBypass(ctx, owner, repo)
+}
+
+func (m *MockRepositoriesClient) UpdateSecretScanningDelegatedBypass(ctx context.Context, owner, repo string, bypass *ghclient.SecretScanningDelegatedBypass) (*github.Response, error) {
+	return m.MockUpdateSecretScanningDelegatedBypass(ctx, owner, repo, bypass)
+}
+
+func (m *MockRepositoriesClient) ListDeploymentBranchPolicies(ctx context.Context, owner, repo, environment string, opts *github.ListOptions) ([]*github.DeploymentBranchPolicy, *github.Response, error) {
+	return m.MockListDeploymentBranchPolicies(ctx, owner, repo, environment, opts)
+}
+
+func (m *MockRepositoriesClient) CreateDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error) {
+	return m.MockCreateDeploymentBranchPolicy(ctx, owner, repo, environment, request)
+}
+
+func (m *MockRepositoriesClient) DeleteDeploymentBranchPolicy(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*github.Response, error) {
+	return m.MockDeleteDeploymentBranchPolicy(ctx, owner, repo, environment, branchPolicyID)
+}
+
+func (m *MockRepositoriesClient) ReplaceAllTopics(ctx context.Context, owner, repo string, topics []string) ([]string, *github.Response, error) {
+	return m.MockReplaceAllTopics(ctx, owner, repo, topics)
+}
+
+func (m *MockRepositoriesClient) GetAllCustomPropertyValues(ctx context.Context, org, repo string) ([]*github.CustomPropertyValue, *github.Response, error) {
+	return m.MockGetAllCustomPropertyValues(ctx, org, repo)
+}
+
+func (m *MockRepositoriesClient) CreateOrUpdateCustomProperties(ctx context.Context, org, repo string, customPropertyValues []*github.CustomPropertyValue) (*github.Response, error) {
+	return m.MockCreateOrUpdateCustomProperties(ctx, org, repo, customPropertyValues)
+}
+
+func (m *MockRepositoriesClient) Dispatch(ctx context.Context, owner, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error) {
+	return m.MockDispatch(ctx, owner, repo, opts)
+}
+
+func (m *MockRepositoriesClient) RenameBranch(ctx context.Context, owner, repo, branch, newName string) (*github.Branch, *github.Response, error) {
+	return m.MockRenameBranch(ctx, owner, repo, branch, newName)
+}
+
+func (m *MockRepositoriesClient) GetCodeownersErrors(ctx context.Context, owner, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error) {
+	return m.MockGetCodeownersErrors(ctx, owner, repo, opts)
+}
+
 type MockTeamsClient struct {
 	MockGetTeamBySlug              func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error)
 	MockListTeamMembersBySlug      func(ctx context.Context, org, slug string, opts *github.TeamListTeamMembersOptions) ([]*github.User, *github.Response, error)
@@ -237,6 +707,22 @@ type MockTeamsClient struct {
 	MockDeleteTeamBySlug           func(ctx context.Context, org, slug string) (*github.Response, error)
 	MockAddTeamRepoBySlug          func(ctx context.Context, org, slug, owner, repo string, opts *github.TeamAddTeamRepoOptions) (*github.Response, error)
 	MockRemoveTeamRepoBySlug       func(ctx context.Context, org, slug, owner, repo string) (*github.Response, error)
+	MockIsTeamRepoBySlug           func(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error)
+
+	MockListIDPGroupsForTeamBySlug              func(ctx context.Context, org, slug string) (*github.IDPGroupList, *github.Response, error)
+	MockCreateOrUpdateIDPGroupConnectionsBySlug func(ctx context.Context, org, slug string, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error)
+}
+
+func (m *MockTeamsClient) ListIDPGroupsForTeamBySlug(ctx context.Context, org, slug string) (*github.IDPGroupList, *github.Response, error) {
+	return m.MockListIDPGroupsForTeamBySlug(ctx, org, slug)
+}
+
+func (m *MockTeamsClient) CreateOrUpdateIDPGroupConnectionsBySlug(ctx context.Context, org, slug string, opts github.IDPGroupList) (*github.IDPGroupList, *github.Response, error) {
+	return m.MockCreateOrUpdateIDPGroupConnectionsBySlug(ctx, org, slug, opts)
+}
+
+func (m *MockTeamsClient) IsTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error) {
+	return m.MockIsTeamRepoBySlug(ctx, org, slug, owner, repo)
 }
 
 func (m *MockTeamsClient) RemoveTeamRepoBySlug(ctx context.Context, org, slug, owner, repo string) (*github.Response, error) {
@@ -0,0 +1,151 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// OrganizationRole is a predefined or custom role of an organization, which
+// grants its users and teams permissions across the organization.
+type OrganizationRole struct {
+	ID          *int64   `json:"id,omitempty"`
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions,omitempty"`
+	BaseRole    *string  `json:"base_role,omitempty"`
+	Source      *string  `json:"source,omitempty"`
+}
+
+// GetID returns the ID of the role, or 0 if it is not set.
+func (r *OrganizationRole) GetID() int64 {
+	if r == nil || r.ID == nil {
+		return 0
+	}
+	return *r.ID
+}
+
+// GetName returns the name of the role, or "" if it is not set.
+func (r *OrganizationRole) GetName() string {
+	if r == nil || r.Name == nil {
+		return ""
+	}
+	return *r.Name
+}
+
+// OrganizationRoles is the list of the roles of an organization.
+type OrganizationRoles struct {
+	TotalCount *int                `json:"total_count,omitempty"`
+	Roles      []*OrganizationRole `json:"roles,omitempty"`
+}
+
+// OrganizationRoleOptions are the settings of a custom organization role to
+// create or update.
+type OrganizationRoleOptions struct {
+	Name        *string  `json:"name,omitempty"`
+	Description *string  `json:"description,omitempty"`
+	Permissions []string `json:"permissions"`
+	BaseRole    *string  `json:"base_role,omitempty"`
+}
+
+// organizationRolesService implements the organization roles API, of which
+// go-github only supports listing the users and teams assigned to a role.
+type organizationRolesService struct {
+	*github.OrganizationsService
+	client *github.Client
+}
+
+// ListRoles lists the predefined and custom roles of an organization.
+func (s *organizationRolesService) ListRoles(ctx context.Context, org string) (*OrganizationRoles, *github.Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles", org)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	roles := new(OrganizationRoles)
+	resp, err := s.client.Do(ctx, req, roles)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return roles, resp, nil
+}
+
+// CreateCustomOrgRole creates a custom role in an organization.
+func (s *organizationRolesService) CreateCustomOrgRole(ctx context.Context, org string, opts *OrganizationRoleOptions) (*OrganizationRole, *github.Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles", org)
+	return s.editRole(ctx, "POST", u, opts)
+}
+
+// UpdateCustomOrgRole updates a custom role of an organization.
+func (s *organizationRolesService) UpdateCustomOrgRole(ctx context.Context, org string, roleID int64, opts *OrganizationRoleOptions) (*OrganizationRole, *github.Response, error) {
+	u := fmt.Sprintf("orgs/%v/organization-roles/%v", org, roleID)
+	return s.editRole(ctx, "PATCH", u, opts)
+}
+
+func (s *organizationRolesService) editRole(ctx context.Context, method, u string, opts *OrganizationRoleOptions) (*OrganizationRole, *github.Response, error) {
+	req, err := s.client.NewRequest(method, u, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	role := new(OrganizationRole)
+	resp, err := s.client.Do(ctx, req, role)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return role, resp, nil
+}
+
+// DeleteCustomOrgRole deletes a custom role of an organization.
+func (s *organizationRolesService) DeleteCustomOrgRole(ctx context.Context, org string, roleID int64) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("orgs/%v/organization-roles/%v", org, roleID))
+}
+
+// AssignOrgRoleToTeam assigns an organization role to a team of the organization.
+func (s *organizationRolesService) AssignOrgRoleToTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("orgs/%v/organization-roles/teams/%v/%v", org, teamSlug, roleID))
+}
+
+// RemoveOrgRoleFromTeam removes an organization role from a team of the organization.
+func (s *organizationRolesService) RemoveOrgRoleFromTeam(ctx context.Context, org, teamSlug string, roleID int64) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("orgs/%v/organization-roles/teams/%v/%v", org, teamSlug, roleID))
+}
+
+// AssignOrgRoleToUser assigns an organization role to a member of the organization.
+func (s *organizationRolesService) AssignOrgRoleToUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error) {
+	return s.do(ctx, "PUT", fmt.Sprintf("orgs/%v/organization-roles/users/%v/%v", org, user, roleID))
+}
+
+// RemoveOrgRoleFromUser removes an organization role from a member of the organization.
+func (s *organizationRolesService) RemoveOrgRoleFromUser(ctx context.Context, org, user string, roleID int64) (*github.Response, error) {
+	return s.do(ctx, "DELETE", fmt.Sprintf("orgs/%v/organization-roles/users/%v/%v", org, user, roleID))
+}
+
+func (s *organizationRolesService) do(ctx context.Context, method, u string) (*github.Response, error) {
+	req, err := s.client.NewRequest(method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
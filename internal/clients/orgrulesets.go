@@ -0,0 +1,125 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// OrganizationRuleset is a ruleset of an organization. Unlike the rulesets of
+// repositories, its conditions select the repositories it applies to, which
+// go-github can't do by their custom properties yet.
+type OrganizationRuleset struct {
+	github.Ruleset
+	Conditions *OrganizationRulesetConditions `json:"conditions,omitempty"`
+}
+
+// OrganizationRulesetConditions select the refs and the repositories an
+// organization ruleset applies to. Repositories are selected either by name
+// or by property.
+type OrganizationRulesetConditions struct {
+	RefName            *github.RulesetRefConditionParameters             `json:"ref_name,omitempty"`
+	RepositoryName     *github.RulesetRepositoryNamesConditionParameters `json:"repository_name,omitempty"`
+	RepositoryProperty *RulesetRepositoryPropertyConditionParameters     `json:"repository_property,omitempty"`
+}
+
+// RulesetRepositoryPropertyConditionParameters select the repositories an
+// organization ruleset applies to by the values of their custom properties.
+type RulesetRepositoryPropertyConditionParameters struct {
+	Include []*RulesetRepositoryPropertyTarget `json:"include"`
+	Exclude []*RulesetRepositoryPropertyTarget `json:"exclude"`
+}
+
+// RulesetRepositoryPropertyTarget matches the repositories whose custom
+// property Name has one of PropertyValues.
+type RulesetRepositoryPropertyTarget struct {
+	Name           string   `json:"name"`
+	PropertyValues []string `json:"property_values"`
+	Source         *string  `json:"source,omitempty"`
+}
+
+// organizationRulesetsService implements the organization rulesets API with
+// the repository property conditions go-github does not support.
+type organizationRulesetsService struct {
+	client *github.Client
+}
+
+// GetAllOrganizationRulesets lists the rulesets of an organization, without
+// their conditions and rules.
+func (s *organizationRulesetsService) GetAllOrganizationRulesets(ctx context.Context, org string, opts *github.ListOptions) ([]*OrganizationRuleset, *github.Response, error) {
+	u := fmt.Sprintf("orgs/%v/rulesets?per_page=%v&page=%v", org, opts.PerPage, opts.Page)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rulesets []*OrganizationRuleset
+	resp, err := s.client.Do(ctx, req, &rulesets)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return rulesets, resp, nil
+}
+
+// GetOrganizationRuleset gets a ruleset of an organization with its conditions
+// and rules.
+func (s *organizationRulesetsService) GetOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*OrganizationRuleset, *github.Response, error) {
+	return s.editRuleset(ctx, "GET", fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID), nil)
+}
+
+// CreateOrganizationRuleset creates a ruleset in an organization.
+func (s *organizationRulesetsService) CreateOrganizationRuleset(ctx context.Context, org string, rs *OrganizationRuleset) (*OrganizationRuleset, *github.Response, error) {
+	return s.editRuleset(ctx, "POST", fmt.Sprintf("orgs/%v/rulesets", org), rs)
+}
+
+// UpdateOrganizationRuleset updates a ruleset of an organization.
+func (s *organizationRulesetsService) UpdateOrganizationRuleset(ctx context.Context, org string, rulesetID int64, rs *OrganizationRuleset) (*OrganizationRuleset, *github.Response, error) {
+	return s.editRuleset(ctx, "PUT", fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID), rs)
+}
+
+func (s *organizationRulesetsService) editRuleset(ctx context.Context, method, u string, rs *OrganizationRuleset) (*OrganizationRuleset, *github.Response, error) {
+	var body interface{}
+	if rs != nil {
+		body = rs
+	}
+	req, err := s.client.NewRequest(method, u, body)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ruleset := new(OrganizationRuleset)
+	resp, err := s.client.Do(ctx, req, ruleset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return ruleset, resp, nil
+}
+
+// DeleteOrganizationRuleset deletes a ruleset of an organization.
+func (s *organizationRulesetsService) DeleteOrganizationRuleset(ctx context.Context, org string, rulesetID int64) (*github.Response, error) {
+	req, err := s.client.NewRequest("DELETE", fmt.Sprintf("orgs/%v/rulesets/%v", org, rulesetID), nil)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"github.com/google/go-github/v62/github"
+)
+
+// PerPage is the largest page size of the list calls of GitHub.
+const PerPage = 100
+
+// ListAll returns the items of all pages of a paginated list call. list makes
+// the call for a page, where page 0 is the first one.
+func ListAll[T any](list func(page int) ([]T, *github.Response, error)) ([]T, error) {
+	var all []T
+	for page := 0; ; {
+		items, resp, err := list(page)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if resp == nil || resp.NextPage == 0 {
+			return all, nil
+		}
+		page = resp.NextPage
+	}
+}
+
+// ListAllAfter returns the items of all pages of a list call that is
+// paginated with cursors, like the Dependabot alerts of an organization. list
+// makes the call for the page after the cursor, where "" is the first one.
+func ListAllAfter[T any](list func(after string) ([]T, *github.Response, error)) ([]T, error) {
+	var all []T
+	for after := ""; ; {
+		items, resp, err := list(after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, items...)
+
+		if resp == nil || resp.After == "" {
+			return all, nil
+		}
+		after = resp.After
+	}
+}
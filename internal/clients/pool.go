@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+)
+
+const errGetPoolCredentials = "cannot get credentials %s/%s of the credentials pool"
+
+// poolCursor rotates the credentials a client starts looking at, so that
+// credentials with the same share of their rate limit left take turns.
+var poolCursor atomic.Uint64
+
+// WithCredentialsPool lets a client use any of the credentials of pool
+// instead of its own, whichever have the largest share of their rate limit
+// left.
+func WithCredentialsPool(pool []string) Option {
+	return func(o *options) {
+		o.pool = pool
+	}
+}
+
+// ExtractCredentialsPool returns the credentials of the credentials pool of
+// pc.
+func ExtractCredentialsPool(ctx context.Context, kube client.Client, pc *apisv1alpha1.ProviderConfig) ([]string, error) {
+	pool := make([]string, 0, len(pc.Spec.CredentialsPool))
+	for i := range pc.Spec.CredentialsPool {
+		ref := &pc.Spec.CredentialsPool[i]
+		data, err := resource.ExtractSecret(ctx, kube, xpv1.CommonCredentialSelectors{SecretRef: ref})
+		if err != nil {
+			return nil, errors.Wrapf(err, errGetPoolCredentials, ref.Namespace, ref.Name)
+		}
+		pool = append(pool, string(data))
+	}
+	return pool, nil
+}
+
+// credentialsScope identifies credentials by their App and installation, the
+// rate limit of GitHub applies to each installation.
+func credentialsScope(creds string) string {
+	c := strings.SplitN(creds, ",", 3)
+	if len(c) < 2 {
+		return ""
+	}
+	return c[0] + "/" + c[1]
+}
+
+// pickCredentials returns the credentials of pool with the largest share of
+// their rate limit left.
+func pickCredentials(pool []string, now time.Time) string {
+	start := int(poolCursor.Add(1) % uint64(len(pool)))
+	best, bestLeft := pool[start], -1.0
+	for i := range pool {
+		c := pool[(start+i)%len(pool)]
+		if left := rateLimits.left(credentialsScope(c), now); left > bestLeft {
+			best, bestLeft = c, left
+		}
+	}
+	return best
+}
@@ -0,0 +1,134 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimitReserve is the share of the rate limit that is kept for requests
+// that can't wait, such as the ones of a Create or Update, once the remaining
+// requests drop below it.
+const rateLimitReserve = 0.05
+
+// rateLimits is shared by all clients, since a new client is created for every
+// reconcile.
+var rateLimits = newRateLimitTracker()
+
+// rateLimit is the primary rate limit that GitHub last reported.
+type rateLimit struct {
+	limit     int
+	remaining int
+	reset     time.Time
+}
+
+// rateLimitTracker records the rate limit of each credentials, and until when
+// they have to back off from a secondary rate limit.
+type rateLimitTracker struct {
+	mu       sync.Mutex
+	limits   map[string]rateLimit
+	backoffs map[string]time.Time
+}
+
+func newRateLimitTracker() *rateLimitTracker {
+	return &rateLimitTracker{limits: make(map[string]rateLimit), backoffs: make(map[string]time.Time)}
+}
+
+func (t *rateLimitTracker) backOff(scope string, until time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until.After(t.backoffs[scope]) {
+		t.backoffs[scope] = until
+	}
+}
+
+func (t *rateLimitTracker) record(scope string, h http.Header) {
+	// Search and GraphQL requests have rate limits of their own.
+	if r := h.Get("X-RateLimit-Resource"); r != "" && r != "core" {
+		return
+	}
+	limit, err := strconv.Atoi(h.Get("X-RateLimit-Limit"))
+	if err != nil {
+		return
+	}
+	remaining, err := strconv.Atoi(h.Get("X-RateLimit-Remaining"))
+	if err != nil {
+		return
+	}
+	reset, err := strconv.ParseInt(h.Get("X-RateLimit-Reset"), 10, 64)
+	if err != nil {
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.limits[scope] = rateLimit{limit: limit, remaining: remaining, reset: time.Unix(reset, 0)}
+}
+
+func (t *rateLimitTracker) throttled(scope string, now time.Time) (time.Time, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := t.backoffs[scope]; now.Before(until) {
+		return until, true
+	}
+	l, ok := t.limits[scope]
+	if !ok || !now.Before(l.reset) {
+		return time.Time{}, false
+	}
+	return l.reset, float64(l.remaining) < float64(l.limit)*rateLimitReserve
+}
+
+// left returns the share of the rate limit of scope that is left, 1 if it
+// isn't known and 0 while it has to back off.
+func (t *rateLimitTracker) left(scope string, now time.Time) float64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if until := t.backoffs[scope]; now.Before(until) {
+		return 0
+	}
+	l, ok := t.limits[scope]
+	if !ok || !now.Before(l.reset) || l.limit == 0 {
+		return 1
+	}
+	return float64(l.remaining) / float64(l.limit)
+}
+
+// rateLimitTransport records the rate limit headers of the responses of
+// GitHub.
+type rateLimitTransport struct {
+	next    http.RoundTripper
+	tracker *rateLimitTracker
+	scope   string
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	t.tracker.record(t.scope, resp.Header)
+	return resp, nil
+}
+
+// Throttled returns whether the rate limit of the credentials of the client is
+// nearly used up or they hit a secondary rate limit, and the time at which
+// requests may resume.
+func (c *Client) Throttled(now time.Time) (time.Time, bool) {
+	return rateLimits.throttled(c.scope, now)
+}
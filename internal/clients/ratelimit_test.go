@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func rateLimitHeader(resource string, limit, remaining int, reset time.Time) http.Header {
+	h := http.Header{}
+	if resource != "" {
+		h.Set("X-RateLimit-Resource", resource)
+	}
+	h.Set("X-RateLimit-Limit", strconv.Itoa(limit))
+	h.Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+	h.Set("X-RateLimit-Reset", strconv.FormatInt(reset.Unix(), 10))
+	return h
+}
+
+func TestRateLimitTracker(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	reset := now.Add(30 * time.Minute)
+
+	type want struct {
+		until     time.Time
+		throttled bool
+		left      float64
+	}
+
+	cases := map[string]struct {
+		reason  string
+		headers []http.Header
+		backoff time.Time
+		want    want
+	}{
+		"Unknown": {
+			reason: "Credentials whose rate limit is unknown should not be throttled.",
+			want:   want{left: 1},
+		},
+		"Plenty": {
+			reason:  "Credentials with most of their rate limit left should not be throttled.",
+			headers: []http.Header{rateLimitHeader("core", 5000, 4000, reset)},
+			want:    want{until: reset, left: 0.8},
+		},
+		"BelowReserve": {
+			reason:  "Credentials whose remaining requests dropped below the reserve should be throttled until the reset.",
+			headers: []http.Header{rateLimitHeader("core", 5000, 200, reset)},
+			want:    want{until: reset, throttled: true, left: 0.04},
+		},
+		"Reset": {
+			reason:  "Credentials whose rate limit was reset should not be throttled any more.",
+			headers: []http.Header{rateLimitHeader("core", 5000, 0, now.Add(-time.Second))},
+			want:    want{left: 1},
+		},
+		"LatestHeaders": {
+			reason:  "The latest rate limit reported should be the one that counts.",
+			headers: []http.Header{rateLimitHeader("core", 5000, 10, reset), rateLimitHeader("", 5000, 5000, reset)},
+			want:    want{until: reset, left: 1},
+		},
+		"OtherResource": {
+			reason:  "The rate limits of search and GraphQL requests should not throttle the credentials.",
+			headers: []http.Header{rateLimitHeader("search", 30, 0, reset)},
+			want:    want{left: 1},
+		},
+		"InvalidHeaders": {
+			reason:  "Responses without valid rate limit headers should be ignored.",
+			headers: []http.Header{{"X-Ratelimit-Limit": []string{"many"}}},
+			want:    want{left: 1},
+		},
+		"SecondaryRateLimit": {
+			reason:  "Credentials backing off from a secondary rate limit should be throttled until then, whatever their rate limit.",
+			headers: []http.Header{rateLimitHeader("core", 5000, 5000, reset)},
+			backoff: now.Add(time.Minute),
+			want:    want{until: now.Add(time.Minute), throttled: true, left: 0},
+		},
+		"SecondaryRateLimitPassed": {
+			reason:  "Credentials whose backoff has passed should not be throttled any more.",
+			backoff: now.Add(-time.Minute),
+			want:    want{left: 1},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tracker := newRateLimitTracker()
+			for _, h := range tc.headers {
+				tracker.record("test", h)
+			}
+			if !tc.backoff.IsZero() {
+				tracker.backOff("test", tc.backoff)
+			}
+			until, throttled := tracker.throttled("test", now)
+			if diff := cmp.Diff(tc.want.throttled, throttled); diff != "" {
+				t.Errorf("\n%s\nthrottled(...): -want throttled, +got throttled:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.until, until); diff != "" {
+				t.Errorf("\n%s\nthrottled(...): -want until, +got until:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.left, tracker.left("test", now)); diff != "" {
+				t.Errorf("\n%s\nleft(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if _, throttled := tracker.throttled("other", now); throttled {
+				t.Errorf("\n%s\nthrottled(...): the rate limit of other credentials should not throttle them", tc.reason)
+			}
+		})
+	}
+}
+
+func TestRateLimitBackOffKeepsLatest(t *testing.T) {
+	now := time.Now()
+	tracker := newRateLimitTracker()
+	tracker.backOff("test", now.Add(time.Hour))
+	tracker.backOff("test", now.Add(time.Minute))
+	until, _ := tracker.throttled("test", now)
+	if diff := cmp.Diff(now.Add(time.Hour), until); diff != "" {
+		t.Errorf("backOff(...): a shorter backoff should not shorten a longer one: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestRateLimitTransport(t *testing.T) {
+	reset := time.Now().Add(time.Hour).Truncate(time.Second)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range rateLimitHeader("core", 5000, 100, reset) {
+			w.Header()[k] = v
+		}
+	}))
+	defer srv.Close()
+
+	tracker := newRateLimitTracker()
+	rt := &rateLimitTransport{next: http.DefaultTransport, tracker: tracker, scope: "test"}
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip(...): %v", err)
+	}
+	_ = resp.Body.Close()
+
+	until, throttled := tracker.throttled("test", time.Now())
+	if !throttled || !until.Equal(reset) {
+		t.Errorf("RoundTrip(...): the rate limit of the response should be recorded, want throttled until %v, got %v until %v", reset, throttled, until)
+	}
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// maxRetries is the number of times a request is retried after a
+	// secondary rate limit.
+	maxRetries = 2
+
+	// maxRetryDelay is the longest delay advised by GitHub that is waited
+	// for. The credentials are throttled for longer ones instead, see
+	// Client.Throttled.
+	maxRetryDelay = 30 * time.Second
+
+	// secondaryRateLimitDelay is waited for secondary rate limits without a
+	// Retry-After header, as GitHub asks for at least a minute then.
+	secondaryRateLimitDelay = time.Minute
+
+	// notFoundRetryDelay is waited for before a change GitHub answered with
+	// 404 Not Found is retried during a creation grace period.
+	notFoundRetryDelay = time.Second
+)
+
+type creationGraceKey struct{}
+
+// WithCreationGrace returns a context whose changes are retried while GitHub
+// answers them with 404 Not Found, until grace has passed. GitHub takes a
+// moment before the sub-resources of a newly created repository, such as its
+// rulesets or branch protections, can be changed. Reads aren't retried, since
+// reading a sub-resource that doesn't exist yet is answered the same.
+func WithCreationGrace(ctx context.Context, grace time.Duration) context.Context {
+	return context.WithValue(ctx, creationGraceKey{}, time.Now().Add(grace))
+}
+
+// retryNotFound reports whether req, answered with resp, is a change that is
+// retried because its creation grace period lasts beyond the retry delay.
+func retryNotFound(req *http.Request, resp *http.Response) bool {
+	if resp.StatusCode != http.StatusNotFound || req.Method == http.MethodGet || !canReplay(req) {
+		return false
+	}
+	until, ok := req.Context().Value(creationGraceKey{}).(time.Time)
+	return ok && time.Until(until) > notFoundRetryDelay
+}
+
+// retryTransport retries requests that GitHub rejected because of a secondary
+// rate limit, after the delay GitHub advised, and changes that GitHub answered
+// with 404 Not Found during a creation grace period.
+type retryTransport struct {
+	next    http.RoundTripper
+	tracker *rateLimitTracker
+	scope   string
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for limited := 0; ; {
+		resp, err := t.next.RoundTrip(req)
+		if err != nil {
+			return resp, err
+		}
+		delay, err := retryDelay(resp)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case delay > 0 && limited == maxRetries:
+			return resp, nil
+		case delay > 0:
+			limited++
+			deadline, ok := req.Context().Deadline()
+			if delay > maxRetryDelay || !canReplay(req) || (ok && time.Until(deadline) < delay) {
+				t.tracker.backOff(t.scope, time.Now().Add(delay))
+				return resp, nil
+			}
+		case retryNotFound(req, resp):
+			delay = notFoundRetryDelay
+		default:
+			return resp, nil
+		}
+		_ = resp.Body.Close()
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			req = req.Clone(req.Context())
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// retryDelay returns the delay after which a request may be retried, or zero
+// if resp is not a secondary rate limit. The body of resp is restored after
+// it was inspected.
+func retryDelay(resp *http.Response) (time.Duration, error) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, nil
+	}
+	if s, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(s) * time.Second, nil
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return 0, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if strings.Contains(strings.ToLower(string(body)), "secondary rate limit") {
+		return secondaryRateLimitDelay, nil
+	}
+	return 0, nil
+}
+
+// canReplay reports whether the body of req can be sent again.
+func canReplay(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// scriptedResponse is a response of a scriptedServer.
+type scriptedResponse struct {
+	status     int
+	retryAfter string
+	body       string
+}
+
+// scriptedServer answers requests with its responses in order, and with 200
+// OK once they are used up. It records the bodies of the requests.
+type scriptedServer struct {
+	mu        sync.Mutex
+	responses []scriptedResponse
+	bodies    []string
+}
+
+func (s *scriptedServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	b, _ := io.ReadAll(r.Body)
+	s.mu.Lock()
+	s.bodies = append(s.bodies, string(b))
+	resp := scriptedResponse{status: http.StatusOK, body: "ok"}
+	if len(s.responses) > 0 {
+		resp, s.responses = s.responses[0], s.responses[1:]
+	}
+	s.mu.Unlock()
+	if resp.retryAfter != "" {
+		w.Header().Set("Retry-After", resp.retryAfter)
+	}
+	w.WriteHeader(resp.status)
+	_, _ = io.WriteString(w, resp.body)
+}
+
+func TestRetryTransport(t *testing.T) {
+	secondary := `{"message":"You have exceeded a secondary rate limit."}`
+
+	type want struct {
+		status  int
+		bodies  []string
+		backoff bool
+	}
+
+	cases := map[string]struct {
+		reason    string
+		method    string
+		body      string
+		noReplay  bool
+		grace     time.Duration
+		deadline  time.Duration
+		responses []scriptedResponse
+		want      want
+	}{
+		"Success": {
+			reason: "A successful request should not be retried.",
+			method: http.MethodGet,
+			want:   want{status: http.StatusOK, bodies: []string{""}},
+		},
+		"Forbidden": {
+			reason:    "A 403 that isn't a secondary rate limit should not be retried.",
+			method:    http.MethodGet,
+			responses: []scriptedResponse{{status: http.StatusForbidden, body: `{"message":"Resource not accessible by integration"}`}},
+			want:      want{status: http.StatusForbidden, bodies: []string{""}},
+		},
+		"RetryAfter": {
+			reason:    "A 429 with a Retry-After header should be retried after the advised delay, replaying its body.",
+			method:    http.MethodPost,
+			body:      `{"name":"repo"}`,
+			responses: []scriptedResponse{{status: http.StatusTooManyRequests, retryAfter: "1"}},
+			want:      want{status: http.StatusOK, bodies: []string{`{"name":"repo"}`, `{"name":"repo"}`}},
+		},
+		"RetryCeiling": {
+			reason: "A request should be retried at most maxRetries times.",
+			method: http.MethodGet,
+			responses: []scriptedResponse{
+				{status: http.StatusForbidden, retryAfter: "1"},
+				{status: http.StatusForbidden, retryAfter: "1"},
+				{status: http.StatusForbidden, retryAfter: "1"},
+			},
+			want: want{status: http.StatusForbidden, bodies: []string{"", "", ""}},
+		},
+		"LongRetryAfter": {
+			reason:    "A delay longer than maxRetryDelay should not be waited for, but back off the credentials instead.",
+			method:    http.MethodGet,
+			responses: []scriptedResponse{{status: http.StatusForbidden, retryAfter: strconv.Itoa(int(maxRetryDelay/time.Second) + 1)}},
+			want:      want{status: http.StatusForbidden, bodies: []string{""}, backoff: true},
+		},
+		"SecondaryRateLimitWithoutRetryAfter": {
+			reason:    "A secondary rate limit without a Retry-After header should back off for secondaryRateLimitDelay, which is longer than maxRetryDelay.",
+			method:    http.MethodGet,
+			responses: []scriptedResponse{{status: http.StatusForbidden, body: secondary}},
+			want:      want{status: http.StatusForbidden, bodies: []string{""}, backoff: true},
+		},
+		"NotReplayable": {
+			reason:    "A request whose body can't be sent again should not be retried.",
+			method:    http.MethodPost,
+			body:      `{"name":"repo"}`,
+			noReplay:  true,
+			responses: []scriptedResponse{{status: http.StatusTooManyRequests, retryAfter: "1"}},
+			want:      want{status: http.StatusTooManyRequests, bodies: []string{`{"name":"repo"}`}, backoff: true},
+		},
+		"DeadlineBeforeRetry": {
+			reason:    "A request whose deadline passes before the advised delay should not be retried.",
+			method:    http.MethodGet,
+			deadline:  500 * time.Millisecond,
+			responses: []scriptedResponse{{status: http.StatusTooManyRequests, retryAfter: "1"}},
+			want:      want{status: http.StatusTooManyRequests, bodies: []string{""}, backoff: true},
+		},
+		"NotFoundDuringCreationGrace": {
+			reason:    "A change answered with 404 during a creation grace period should be retried.",
+			method:    http.MethodPut,
+			body:      `{"enabled":true}`,
+			grace:     time.Minute,
+			responses: []scriptedResponse{{status: http.StatusNotFound}},
+			want:      want{status: http.StatusOK, bodies: []string{`{"enabled":true}`, `{"enabled":true}`}},
+		},
+		"NotFoundWithoutCreationGrace": {
+			reason:    "A change answered with 404 outside of a creation grace period should not be retried.",
+			method:    http.MethodPut,
+			responses: []scriptedResponse{{status: http.StatusNotFound}},
+			want:      want{status: http.StatusNotFound, bodies: []string{""}},
+		},
+		"ReadNotFoundDuringCreationGrace": {
+			reason:    "A read answered with 404 during a creation grace period should not be retried.",
+			method:    http.MethodGet,
+			grace:     time.Minute,
+			responses: []scriptedResponse{{status: http.StatusNotFound}},
+			want:      want{status: http.StatusNotFound, bodies: []string{""}},
+		},
+	}
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			s := &scriptedServer{responses: tc.responses}
+			srv := httptest.NewServer(s)
+			defer srv.Close()
+
+			ctx := context.Background()
+			if tc.grace > 0 {
+				ctx = WithCreationGrace(ctx, tc.grace)
+			}
+			if tc.deadline > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.deadline)
+				defer cancel()
+			}
+
+			var body io.Reader
+			if tc.body != "" {
+				body = bytes.NewBufferString(tc.body)
+			}
+			req, err := http.NewRequestWithContext(ctx, tc.method, srv.URL+"/repos/org/repo", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.noReplay {
+				req.GetBody = nil
+			}
+
+			tracker := newRateLimitTracker()
+			rt := &retryTransport{next: http.DefaultTransport, tracker: tracker, scope: "test"}
+			resp, err := rt.RoundTrip(req)
+			if err != nil {
+				t.Fatalf("\n%s\nRoundTrip(...): %v", tc.reason, err)
+			}
+			_ = resp.Body.Close()
+
+			if diff := cmp.Diff(tc.want.status, resp.StatusCode); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.bodies, s.bodies); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want request bodies, +got request bodies:\n%s\n", tc.reason, diff)
+			}
+			_, backoff := tracker.throttled("test", time.Now())
+			if diff := cmp.Diff(tc.want.backoff, backoff); diff != "" {
+				t.Errorf("\n%s\nRoundTrip(...): -want backoff, +got backoff:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRetryTransportCanceled(t *testing.T) {
+	s := &scriptedServer{responses: []scriptedResponse{{status: http.StatusTooManyRequests, retryAfter: "1"}}}
+	srv := httptest.NewServer(s)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	time.AfterFunc(100*time.Millisecond, cancel)
+
+	rt := &retryTransport{next: http.DefaultTransport, tracker: newRateLimitTracker(), scope: "test"}
+	if _, err := rt.RoundTrip(req); err != context.Canceled {
+		t.Errorf("RoundTrip(...): a request canceled while waiting for its retry should return %v, got %v", context.Canceled, err)
+	}
+}
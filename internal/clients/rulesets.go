@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// repositoriesService wraps the go-github RepositoriesService, so that rulesets
+// containing rule types go-github can't unmarshal yet, like the file rules of
+// push rulesets, can still be read.
+type repositoriesService struct {
+	*github.RepositoriesService
+	client *github.Client
+}
+
+// rawRuleset is a Ruleset whose rules keep their parameters as raw JSON.
+type rawRuleset struct {
+	*github.Ruleset
+	Rules []*rawRepositoryRule `json:"rules,omitempty"`
+}
+
+type rawRepositoryRule struct {
+	Type              string           `json:"type"`
+	Parameters        *json.RawMessage `json:"parameters,omitempty"`
+	RulesetSourceType string           `json:"ruleset_source_type"`
+	RulesetSource     string           `json:"ruleset_source"`
+	RulesetID         int64            `json:"ruleset_id"`
+}
+
+// GetAllRulesets gets a page of the rulesets of the specified repository,
+// which go-github can't request other pages than the first of.
+func (s *repositoriesService) GetAllRulesets(ctx context.Context, owner, repo string, includesParents bool, opts *github.ListOptions) ([]*github.Ruleset, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets?includes_parents=%v", owner, repo, includesParents)
+	if opts != nil {
+		u += fmt.Sprintf("&per_page=%v&page=%v", opts.PerPage, opts.Page)
+	}
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var rulesets []*github.Ruleset
+	resp, err := s.client.Do(ctx, req, &rulesets)
+	if err != nil {
+		return nil, resp, err
+	}
+	return rulesets, resp, nil
+}
+
+// GetRuleset gets a ruleset for the specified repository.
+func (s *repositoriesService) GetRuleset(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+	u := fmt.Sprintf("repos/%v/%v/rulesets/%v?includes_parents=%v", owner, repo, rulesetID, includesParents)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	raw := &rawRuleset{Ruleset: &github.Ruleset{}}
+	resp, err := s.client.Do(ctx, req, raw)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	ruleset := raw.Ruleset
+	ruleset.Rules = make([]*github.RepositoryRule, len(raw.Rules))
+	for i, rule := range raw.Rules {
+		ruleset.Rules[i] = &github.RepositoryRule{
+			Type:              rule.Type,
+			Parameters:        rule.Parameters,
+			RulesetSourceType: rule.RulesetSourceType,
+			RulesetSource:     rule.RulesetSource,
+			RulesetID:         rule.RulesetID,
+		}
+	}
+
+	return ruleset, resp, nil
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+)
+
+const (
+	errDecodePublicKey = "cannot decode secrets public key"
+	errEncryptSecret   = "cannot encrypt secret value"
+)
+
+// EncryptSecret encrypts a secret value with a public key of the secrets API,
+// as a libsodium sealed box. It returns the base64 encoded encrypted value.
+func EncryptSecret(key *github.PublicKey, value []byte) (string, error) {
+	raw, err := base64.StdEncoding.DecodeString(key.GetKey())
+	if err != nil {
+		return "", errors.Wrap(err, errDecodePublicKey)
+	}
+	if len(raw) != 32 {
+		return "", errors.New(errDecodePublicKey)
+	}
+
+	var pk [32]byte
+	copy(pk[:], raw)
+	encrypted, err := box.SealAnonymous(nil, value, &pk, rand.Reader)
+	if err != nil {
+		return "", errors.Wrap(err, errEncryptSecret)
+	}
+	return base64.StdEncoding.EncodeToString(encrypted), nil
+}
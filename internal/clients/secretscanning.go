@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-github/v62/github"
+)
+
+// SecretScanningDelegatedBypass is the delegated bypass of secret scanning
+// push protection of a repository, which go-github doesn't know yet. While it
+// is enabled, pushes blocked by push protection need the approval of one of
+// the reviewers.
+type SecretScanningDelegatedBypass struct {
+	// Status is enabled or disabled.
+	Status string
+
+	Reviewers []*SecretScanningBypassReviewer
+}
+
+// SecretScanningBypassReviewer is a team or role that reviews requests to
+// bypass push protection.
+type SecretScanningBypassReviewer struct {
+	ReviewerID   int64  `json:"reviewer_id"`
+	ReviewerType string `json:"reviewer_type"`
+}
+
+type delegatedBypassStatus struct {
+	Status string `json:"status"`
+}
+
+type delegatedBypassOptions struct {
+	Reviewers []*SecretScanningBypassReviewer `json:"reviewers"`
+}
+
+// delegatedBypassRepository is a repository with only the delegated bypass
+// of its security and analysis settings.
+type delegatedBypassRepository struct {
+	SecurityAndAnalysis struct {
+		DelegatedBypass        *delegatedBypassStatus  `json:"secret_scanning_delegated_bypass,omitempty"`
+		DelegatedBypassOptions *delegatedBypassOptions `json:"secret_scanning_delegated_bypass_options,omitempty"`
+	} `json:"security_and_analysis"`
+}
+
+// GetSecretScanningDelegatedBypass gets the delegated bypass of push
+// protection of a repository.
+func (s *repositoriesService) GetSecretScanningDelegatedBypass(ctx context.Context, owner, repo string) (*SecretScanningDelegatedBypass, *github.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s", owner, repo)
+
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	r := &delegatedBypassRepository{}
+	resp, err := s.client.Do(ctx, req, r)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	bypass := &SecretScanningDelegatedBypass{}
+	if sa := r.SecurityAndAnalysis; sa.DelegatedBypass != nil {
+		bypass.Status = sa.DelegatedBypass.Status
+	}
+	if sa := r.SecurityAndAnalysis; sa.DelegatedBypassOptions != nil {
+		bypass.Reviewers = sa.DelegatedBypassOptions.Reviewers
+	}
+	return bypass, resp, nil
+}
+
+// UpdateSecretScanningDelegatedBypass sets the delegated bypass of push
+// protection of a repository. The reviewers are only sent while it is
+// enabled, the other security and analysis settings are left as they are.
+func (s *repositoriesService) UpdateSecretScanningDelegatedBypass(ctx context.Context, owner, repo string, bypass *SecretScanningDelegatedBypass) (*github.Response, error) {
+	u := fmt.Sprintf("repos/%s/%s", owner, repo)
+
+	body := &delegatedBypassRepository{}
+	body.SecurityAndAnalysis.DelegatedBypass = &delegatedBypassStatus{Status: bypass.Status}
+	if bypass.Status == "enabled" {
+		body.SecurityAndAnalysis.DelegatedBypassOptions = &delegatedBypassOptions{Reviewers: bypass.Reviewers}
+	}
+
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, err
+	}
+	return s.client.Do(ctx, req, nil)
+}
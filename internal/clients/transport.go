@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+)
+
+const (
+	errParseProxyURL  = "cannot parse proxy URL"
+	errSystemCertPool = "cannot load the certificate authorities of the system"
+	errParseCABundle  = "cannot parse CA bundle: no PEM encoded certificates found"
+)
+
+// TransportConfig configures how a client connects to GitHub.
+type TransportConfig struct {
+	ProxyURL           string
+	CABundle           string
+	InsecureSkipVerify bool
+}
+
+// An Option configures a client.
+type Option func(*options)
+
+type options struct {
+	transport TransportConfig
+	baseURL   string
+	uploadURL string
+	pool      []string
+}
+
+// WithTransport connects a client to GitHub as configured.
+func WithTransport(c TransportConfig) Option {
+	return func(o *options) {
+		o.transport = c
+	}
+}
+
+// WithEnterpriseURLs connects a client to the GitHub Enterprise Server at
+// baseURL, and uploads to uploadURL, which defaults to baseURL. The /api/v3
+// and /api/uploads paths are appended unless the URLs have them already.
+func WithEnterpriseURLs(baseURL, uploadURL string) Option {
+	return func(o *options) {
+		o.baseURL = baseURL
+		o.uploadURL = uploadURL
+		if uploadURL == "" {
+			o.uploadURL = baseURL
+		}
+	}
+}
+
+// WithProviderConfig connects a client to GitHub as configured by pc.
+func WithProviderConfig(pc *apisv1alpha1.ProviderConfig) Option {
+	return func(o *options) {
+		WithTransport(TransportConfig{
+			ProxyURL:           pointer.StringDeref(pc.Spec.ProxyURL, ""),
+			CABundle:           pointer.StringDeref(pc.Spec.CABundle, ""),
+			InsecureSkipVerify: pointer.BoolDeref(pc.Spec.InsecureSkipVerify, false),
+		})(o)
+		if pc.Spec.BaseURL != nil {
+			WithEnterpriseURLs(*pc.Spec.BaseURL, "")(o)
+		}
+	}
+}
+
+// transports is shared by all clients, since a new client is created for every
+// reconcile and each transport keeps its own pool of connections.
+var transports = &transportCache{transports: make(map[TransportConfig]http.RoundTripper)}
+
+type transportCache struct {
+	mu         sync.Mutex
+	transports map[TransportConfig]http.RoundTripper
+}
+
+// get returns the transport for c, the default transport if c is empty.
+func (tc *transportCache) get(c TransportConfig) (http.RoundTripper, error) {
+	if c == (TransportConfig{}) {
+		return http.DefaultTransport, nil
+	}
+
+	tc.mu.Lock()
+	defer tc.mu.Unlock()
+	if t, ok := tc.transports[c]; ok {
+		return t, nil
+	}
+	t, err := newTransport(c)
+	if err != nil {
+		return nil, err
+	}
+	tc.transports[c] = t
+	return t, nil
+}
+
+func newTransport(c TransportConfig) (*http.Transport, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if c.ProxyURL != "" {
+		u, err := url.Parse(c.ProxyURL)
+		if err != nil {
+			return nil, errors.Wrap(err, errParseProxyURL)
+		}
+		t.Proxy = http.ProxyURL(u)
+	}
+
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: c.InsecureSkipVerify} //nolint:gosec // Only if the ProviderConfig asks for it.
+	if c.CABundle != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			return nil, errors.Wrap(err, errSystemCertPool)
+		}
+		if !pool.AppendCertsFromPEM([]byte(c.CABundle)) {
+			return nil, errors.New(errParseCABundle)
+		}
+		cfg.RootCAs = pool
+	}
+	t.TLSClientConfig = cfg
+	return t, nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clients
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func TestNewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+	caBundle := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	type want struct {
+		err   error
+		proxy string
+		ok    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		c      TransportConfig
+		want   want
+	}{
+		"Proxy": {
+			reason: "Requests should be sent through the proxy.",
+			c:      TransportConfig{ProxyURL: "http://proxy.example.com:3128"},
+			want:   want{proxy: "http://proxy.example.com:3128"},
+		},
+		"InvalidProxyURL": {
+			reason: "An invalid proxy URL should be returned as an error.",
+			c:      TransportConfig{ProxyURL: "://proxy"},
+			want:   want{err: errors.Wrap(&url.Error{Op: "parse", URL: "://proxy", Err: errors.New("missing protocol scheme")}, errParseProxyURL)},
+		},
+		"CABundle": {
+			reason: "Servers whose certificate is signed by the CA bundle should be trusted.",
+			c:      TransportConfig{CABundle: caBundle},
+			want:   want{ok: true},
+		},
+		"InvalidCABundle": {
+			reason: "A CA bundle without PEM encoded certificates should be returned as an error.",
+			c:      TransportConfig{CABundle: "not a certificate"},
+			want:   want{err: errors.New(errParseCABundle)},
+		},
+		"UntrustedCertificate": {
+			reason: "Servers whose certificate isn't signed by the system certificate authorities should not be trusted.",
+			c:      TransportConfig{},
+			want:   want{ok: false},
+		},
+		"InsecureSkipVerify": {
+			reason: "Servers should be trusted without verifying their certificate if asked for.",
+			c:      TransportConfig{InsecureSkipVerify: true},
+			want:   want{ok: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			tr, err := newTransport(tc.c)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Fatalf("\n%s\nnewTransport(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if err != nil {
+				return
+			}
+
+			proxy := ""
+			if tr.Proxy != nil {
+				u, err := tr.Proxy(httptest.NewRequest(http.MethodGet, "https://api.github.com", nil))
+				if err != nil {
+					t.Fatalf("Proxy(...): %v", err)
+				}
+				if u != nil {
+					proxy = u.String()
+				}
+			}
+			if diff := cmp.Diff(tc.want.proxy, proxy); diff != "" {
+				t.Errorf("\n%s\nnewTransport(...): -want proxy, +got proxy:\n%s\n", tc.reason, diff)
+			}
+
+			if proxy != "" {
+				// The test server can't be reached through the proxy.
+				return
+			}
+			resp, err := (&http.Client{Transport: tr}).Get(server.URL)
+			if err == nil {
+				_ = resp.Body.Close()
+			}
+			if diff := cmp.Diff(tc.want.ok, err == nil); diff != "" {
+				t.Errorf("\n%s\nGet(...): -want trusted, +got trusted:\n%s\n%v", tc.reason, diff, err)
+			}
+		})
+	}
+}
+
+func TestTransportCache(t *testing.T) {
+	tc := &transportCache{transports: make(map[TransportConfig]http.RoundTripper)}
+	get := func(c TransportConfig) http.RoundTripper {
+		tr, err := tc.get(c)
+		if err != nil {
+			t.Fatalf("get(%+v): %v", c, err)
+		}
+		return tr
+	}
+
+	if get(TransportConfig{}) != http.DefaultTransport {
+		t.Errorf("get(...): the default transport should be used without a configuration")
+	}
+
+	proxy := TransportConfig{ProxyURL: "http://proxy.example.com:3128"}
+	first := get(proxy)
+	if get(proxy) != first {
+		t.Errorf("get(...): the transport should be reused for the same configuration")
+	}
+	if get(TransportConfig{ProxyURL: "http://other.example.com:3128"}) == first {
+		t.Errorf("get(...): a new transport should be created for another configuration")
+	}
+	if get(TransportConfig{ProxyURL: proxy.ProxyURL, InsecureSkipVerify: true}) == first {
+		t.Errorf("get(...): a new transport should be created for another configuration")
+	}
+
+	if _, err := tc.get(TransportConfig{CABundle: "not a certificate"}); err == nil {
+		t.Errorf("get(...): an invalid configuration should be returned as an error")
+	}
+	if _, ok := tc.transports[TransportConfig{CABundle: "not a certificate"}]; ok {
+		t.Errorf("get(...): an invalid configuration should not be cached")
+	}
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actionsrunnertoken
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+)
+
+const (
+	errNotActionsRunnerToken   = "managed resource is not an ActionsRunnerToken custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCreds                = "cannot get credentials"
+	errNewClient               = "cannot create new Service"
+	errParseExpiresAt          = "cannot parse token expiry"
+	errCreateRegistrationToken = "cannot create runner registration token"
+	errCreateRemoveToken       = "cannot create runner remove token"
+
+	// AnnotationKeyExpiresAt records when the registration token minted on
+	// Create expires, and AnnotationKeyRemoveTokenExpiresAt when the remove
+	// token does. They are kept as annotations rather than in the status,
+	// because only annotations are persisted after a successful Create.
+	AnnotationKeyExpiresAt            = "github.crossplane.io/token-expires-at"
+	AnnotationKeyRemoveTokenExpiresAt = "github.crossplane.io/remove-token-expires-at"
+
+	defaultRefreshBefore = 10 * time.Minute
+)
+
+// Keys of the connection details published for an ActionsRunnerToken.
+const (
+	ConnectionDetailToken       = "token"
+	ConnectionDetailRemoveToken = "removeToken"
+	ConnectionDetailExpiresAt   = "expiresAt"
+)
+
+// Setup adds a controller that reconciles ActionsRunnerToken managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.ActionsRunnerTokenGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.ActionsRunnerTokenGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.ActionsRunnerToken{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.ActionsRunnerTokenList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.ActionsRunnerTokenGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.ActionsRunnerToken)
+	if !ok {
+		return nil, errors.New(errNotActionsRunnerToken)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.ActionsRunnerTokenKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.ActionsRunnerToken)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotActionsRunnerToken)
+	}
+
+	// Minted tokens can't be revoked, so report them as gone once the
+	// ActionsRunnerToken is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The status records the tokens minted by an Update, the annotations
+	// the ones minted by the Create.
+	if cr.Status.AtProvider.ExpiresAt == nil {
+		obs, err := expiryAnnotations(cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if obs == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.Status.AtProvider = *obs
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// The tokens are minted anew once either of them is about to expire.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: !refreshDue(cr, time.Now()),
+	}, nil
+}
+
+// expiryAnnotations returns the expiry of the tokens recorded in the
+// annotations of cr, or nil if no tokens were minted yet.
+func expiryAnnotations(cr *v1alpha1.ActionsRunnerToken) (*v1alpha1.ActionsRunnerTokenObservation, error) {
+	a := cr.GetAnnotations()
+	if a[AnnotationKeyExpiresAt] == "" {
+		return nil, nil
+	}
+	obs := &v1alpha1.ActionsRunnerTokenObservation{}
+	for key, at := range map[string]**metav1.Time{
+		AnnotationKeyExpiresAt:            &obs.ExpiresAt,
+		AnnotationKeyRemoveTokenExpiresAt: &obs.RemoveTokenExpiresAt,
+	} {
+		if a[key] == "" {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, a[key])
+		if err != nil {
+			return nil, errors.Wrap(err, errParseExpiresAt)
+		}
+		mt := metav1.NewTime(t)
+		*at = &mt
+	}
+	return obs, nil
+}
+
+// refreshDue reports whether either token of cr expires within its
+// refreshBefore of now.
+func refreshDue(cr *v1alpha1.ActionsRunnerToken, now time.Time) bool {
+	before := defaultRefreshBefore
+	if rb := cr.Spec.ForProvider.RefreshBefore; rb != nil {
+		before = rb.Duration
+	}
+	obs := cr.Status.AtProvider
+	for _, at := range []*metav1.Time{obs.ExpiresAt, obs.RemoveTokenExpiresAt} {
+		if at == nil || !now.Add(before).Before(at.Time) {
+			return true
+		}
+	}
+	return false
+}
+
+// mint creates a registration and a remove token for the organization of cr,
+// records when they expire in the status of cr and returns them as connection
+// details.
+func (c *external) mint(ctx context.Context, cr *v1alpha1.ActionsRunnerToken) (managed.ConnectionDetails, error) {
+	org := cr.Spec.ForProvider.Org
+	reg, _, err := c.github.Actions.CreateOrganizationRegistrationToken(ctx, org)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRegistrationToken)
+	}
+	remove, _, err := c.github.Actions.CreateOrganizationRemoveToken(ctx, org)
+	if err != nil {
+		return nil, errors.Wrap(err, errCreateRemoveToken)
+	}
+
+	cr.Status.AtProvider = v1alpha1.ActionsRunnerTokenObservation{
+		ExpiresAt:            expiry(reg.ExpiresAt),
+		RemoveTokenExpiresAt: expiry(remove.ExpiresAt),
+	}
+	cd := managed.ConnectionDetails{
+		ConnectionDetailToken:       []byte(reg.GetToken()),
+		ConnectionDetailRemoveToken: []byte(remove.GetToken()),
+	}
+	if at := cr.Status.AtProvider.ExpiresAt; at != nil {
+		cd[ConnectionDetailExpiresAt] = []byte(at.Format(time.RFC3339))
+	}
+	return cd, nil
+}
+
+// expiry converts the expiry of a token, if GitHub returned one.
+func expiry(ts *github.Timestamp) *metav1.Time {
+	if ts == nil {
+		return nil
+	}
+	at := metav1.NewTime(ts.UTC())
+	return &at
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.ActionsRunnerToken)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotActionsRunnerToken)
+	}
+
+	cd, err := c.mint(ctx, cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	a := map[string]string{}
+	if at := cr.Status.AtProvider.ExpiresAt; at != nil {
+		a[AnnotationKeyExpiresAt] = at.Format(time.RFC3339)
+	}
+	if at := cr.Status.AtProvider.RemoveTokenExpiresAt; at != nil {
+		a[AnnotationKeyRemoveTokenExpiresAt] = at.Format(time.RFC3339)
+	}
+	meta.AddAnnotations(cr, a)
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalCreation{ConnectionDetails: cd}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.ActionsRunnerToken)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotActionsRunnerToken)
+	}
+
+	cd, err := c.mint(ctx, cr)
+	return managed.ExternalUpdate{ConnectionDetails: cd}, err
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.ActionsRunnerToken)
+	if !ok {
+		return errors.New(errNotActionsRunnerToken)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	return nil
+}
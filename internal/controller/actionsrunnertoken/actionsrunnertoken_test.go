@@ -0,0 +1,251 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package actionsrunnertoken
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org         = "test-org"
+	token       = "AABF3JGZDX3P5PMEXLND6TS6FCWO6"
+	removeToken = "AABF3JGZDX3P5PMEXLND6TS6FCWO7"
+	expiresAt   = "2024-01-01T01:00:00Z"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type actionsRunnerTokenModifier func(*v1alpha1.ActionsRunnerToken)
+
+func actionsRunnerToken(m ...actionsRunnerTokenModifier) *v1alpha1.ActionsRunnerToken {
+	cr := &v1alpha1.ActionsRunnerToken{}
+	cr.Spec.ForProvider = v1alpha1.ActionsRunnerTokenParameters{Org: org}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExpiryAnnotations(at string) actionsRunnerTokenModifier {
+	return func(r *v1alpha1.ActionsRunnerToken) {
+		meta.AddAnnotations(r, map[string]string{
+			AnnotationKeyExpiresAt:            at,
+			AnnotationKeyRemoveTokenExpiresAt: at,
+		})
+	}
+}
+
+func withExpiresAt(at time.Time) actionsRunnerTokenModifier {
+	return func(r *v1alpha1.ActionsRunnerToken) {
+		mt := metav1.NewTime(at)
+		r.Status.AtProvider = v1alpha1.ActionsRunnerTokenObservation{ExpiresAt: &mt, RemoveTokenExpiresAt: &mt}
+	}
+}
+
+func withRefreshBefore(d time.Duration) actionsRunnerTokenModifier {
+	return func(r *v1alpha1.ActionsRunnerToken) {
+		r.Spec.ForProvider.RefreshBefore = &metav1.Duration{Duration: d}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o     managed.ExternalObservation
+		ready bool
+		err   error
+	}
+
+	_, errParse := time.Parse(time.RFC3339, "soon")
+	inAnHour := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.ActionsRunnerToken
+		want   want
+	}{
+		"NotMinted": {
+			reason: "An ActionsRunnerToken without tokens should mint them.",
+			cr:     actionsRunnerToken(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"MintedOnCreate": {
+			reason: "The expiry recorded in the annotations should be observed.",
+			cr:     actionsRunnerToken(withExpiryAnnotations(inAnHour.Format(time.RFC3339))),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				ready: true,
+			},
+		},
+		"MintedOnUpdate": {
+			reason: "The expiry recorded in the status should take precedence over the annotations.",
+			cr:     actionsRunnerToken(withExpiryAnnotations(expiresAt), withExpiresAt(inAnHour)),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				ready: true,
+			},
+		},
+		"Expiring": {
+			reason: "Tokens that expire within refreshBefore should be minted anew.",
+			cr:     actionsRunnerToken(withExpiresAt(inAnHour), withRefreshBefore(2*time.Hour)),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				ready: true,
+			},
+		},
+		"Expired": {
+			reason: "Expired tokens should be minted anew.",
+			cr:     actionsRunnerToken(withExpiryAnnotations(expiresAt)),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				ready: true,
+			},
+		},
+		"InvalidAnnotation": {
+			reason: "Errors parsing the expiry should be returned.",
+			cr:     actionsRunnerToken(withExpiryAnnotations("soon")),
+			want: want{
+				err: errors.Wrap(errParse, errParseExpiresAt),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{}}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if ready := tc.cr.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()); ready != tc.want.ready {
+				t.Errorf("\n%s\ne.Observe(...): want ready %t, got %t\n", tc.reason, tc.want.ready, ready)
+			}
+		})
+	}
+}
+
+func mockActions(err error) *fake.MockActionsClient {
+	at, _ := time.Parse(time.RFC3339, expiresAt)
+	return &fake.MockActionsClient{
+		MockCreateOrganizationRegistrationToken: func(ctx context.Context, owner string) (*github.RegistrationToken, *github.Response, error) {
+			if owner != org {
+				return nil, nil, errors.New("unexpected org")
+			}
+			return &github.RegistrationToken{Token: github.String(token), ExpiresAt: &github.Timestamp{Time: at}}, nil, nil
+		},
+		MockCreateOrganizationRemoveToken: func(ctx context.Context, owner string) (*github.RemoveToken, *github.Response, error) {
+			if err != nil {
+				return nil, nil, err
+			}
+			return &github.RemoveToken{Token: github.String(removeToken), ExpiresAt: &github.Timestamp{Time: at}}, nil, nil
+		},
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		o         managed.ExternalCreation
+		expiresAt string
+		err       error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason  string
+		actions *fake.MockActionsClient
+		want    want
+	}{
+		"Minted": {
+			reason:  "Create should mint the tokens, publish them and record their expiry.",
+			actions: mockActions(nil),
+			want: want{
+				o: managed.ExternalCreation{ConnectionDetails: managed.ConnectionDetails{
+					ConnectionDetailToken:       []byte(token),
+					ConnectionDetailRemoveToken: []byte(removeToken),
+					ConnectionDetailExpiresAt:   []byte(expiresAt),
+				}},
+				expiresAt: expiresAt,
+			},
+		},
+		"RemoveTokenError": {
+			reason:  "Errors minting the remove token should be returned.",
+			actions: mockActions(errBoom),
+			want: want{
+				err: errors.Wrap(errBoom, errCreateRemoveToken),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{Actions: tc.actions}}
+			cr := actionsRunnerToken()
+			got, err := e.Create(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.expiresAt, cr.GetAnnotations()[AnnotationKeyExpiresAt]); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want expiresAt, +got expiresAt:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	e := external{github: &ghclient.Client{Actions: mockActions(nil)}}
+	cr := actionsRunnerToken(withExpiryAnnotations("2024-01-01T00:00:00Z"))
+	got, err := e.Update(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff(token, string(got.ConnectionDetails[ConnectionDetailToken])); diff != "" {
+		t.Errorf("\nUpdate should publish the new registration token.\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(expiresAt, cr.Status.AtProvider.ExpiresAt.Format(time.RFC3339)); diff != "" {
+		t.Errorf("\nUpdate should record the expiry of the new tokens in the status.\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
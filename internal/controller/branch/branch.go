@@ -0,0 +1,266 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branch
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+)
+
+const (
+	errNotBranch     = "managed resource is not a Branch custom resource"
+	errTrackPCUsage  = "cannot track ProviderConfig usage"
+	errGetPC         = "cannot get ProviderConfig"
+	errGetCreds      = "cannot get credentials"
+	errNewClient     = "cannot create new Service"
+	errGetBranch     = "cannot get branch %s"
+	errGetRepository = "cannot get repository"
+	errCreateBranch  = "cannot create branch"
+	errRenameBranch  = "cannot rename branch %s"
+	errDeleteBranch  = "cannot delete branch"
+	errNoSource      = "source branch %s does not exist"
+
+	refsHeads = "refs/heads/"
+)
+
+// Setup adds a controller that reconciles Branch managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BranchGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BranchGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.Branch{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.BranchList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BranchGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return nil, errors.New(errNotBranch)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.BranchKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBranch)
+	}
+
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+	sha, err := getBranchSHA(ctx, c.github, p.Org, p.Repo, name)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if sha == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.SHA = &sha
+	cr.SetConditions(xpv1.Available())
+
+	// The source of a branch only matters when it is created.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// getBranchSHA returns the commit a branch points to, or "" if the repository
+// has no such branch.
+func getBranchSHA(ctx context.Context, gh *ghclient.Client, org, repo, branch string) (string, error) {
+	ref, _, err := gh.Git.GetRef(ctx, org, repo, refsHeads+branch)
+	if ghclient.Is404(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrapf(err, errGetBranch, branch)
+	}
+	return ref.GetObject().GetSHA(), nil
+}
+
+// sourceSHA returns the commit a branch is created from.
+func sourceSHA(ctx context.Context, gh *ghclient.Client, p v1alpha1.BranchParameters) (string, error) {
+	if p.SourceSHA != nil {
+		return *p.SourceSHA, nil
+	}
+
+	source := pointer.StringDeref(p.SourceBranch, "")
+	if source == "" {
+		repo, _, err := gh.Repositories.Get(ctx, p.Org, p.Repo)
+		if err != nil {
+			return "", errors.Wrap(err, errGetRepository)
+		}
+		source = repo.GetDefaultBranch()
+	}
+
+	sha, err := getBranchSHA(ctx, gh, p.Org, p.Repo, source)
+	if err != nil {
+		return "", err
+	}
+	if sha == "" {
+		return "", errors.Errorf(errNoSource, source)
+	}
+	return sha, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBranch)
+	}
+
+	p := cr.Spec.ForProvider
+	name := meta.GetExternalName(cr)
+
+	// A branch that was renamed before is created from scratch.
+	if from := pointer.StringDeref(p.RenameFrom, ""); from != "" {
+		sha, err := getBranchSHA(ctx, c.github, p.Org, p.Repo, from)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		if sha != "" {
+			_, _, err := c.github.Repositories.RenameBranch(ctx, p.Org, p.Repo, from, name)
+			return managed.ExternalCreation{}, errors.Wrapf(err, errRenameBranch, from)
+		}
+	}
+
+	sha, err := sourceSHA(ctx, c.github, p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	ref := &github.Reference{Ref: github.String(refsHeads + name), Object: &github.GitObject{SHA: &sha}}
+	if _, _, err := c.github.Git.CreateRef(ctx, p.Org, p.Repo, ref); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateBranch)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.Branch); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBranch)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Branch)
+	if !ok {
+		return errors.New(errNotBranch)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	p := cr.Spec.ForProvider
+	_, err := c.github.Git.DeleteRef(ctx, p.Org, p.Repo, refsHeads+meta.GetExternalName(cr))
+	if ghclient.Is404(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteBranch)
+}
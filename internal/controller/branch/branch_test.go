@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branch
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org           = "test-org"
+	repo          = "test-repo"
+	branch        = "develop"
+	defaultBranch = "main"
+	oldBranch     = "dev"
+	sha           = "6dcb09b5b57875f334f61aebed695e2e4193db5e"
+	mainSHA       = "aa218f56b14c9653891f9e74264a383fa43fefbd"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type branchModifier func(*v1alpha1.Branch)
+
+func branchResource(m ...branchModifier) *v1alpha1.Branch {
+	cr := &v1alpha1.Branch{}
+	meta.SetExternalName(cr, branch)
+	cr.Spec.ForProvider = v1alpha1.BranchParameters{
+		Org:  org,
+		Repo: repo,
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withSourceSHA(s string) branchModifier {
+	return func(r *v1alpha1.Branch) {
+		r.Spec.ForProvider.SourceSHA = &s
+	}
+}
+
+func withRenameFrom(b string) branchModifier {
+	return func(r *v1alpha1.Branch) {
+		r.Spec.ForProvider.RenameFrom = &b
+	}
+}
+
+var errNotFound = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+// mockGetRef returns the refs of the branches of refs, and 404 for others.
+func mockGetRef(refs map[string]string) func(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error) {
+	return func(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error) {
+		s, ok := refs[ref]
+		if !ok {
+			return nil, nil, errNotFound
+		}
+		return &github.Reference{Ref: &ref, Object: &github.GitObject{SHA: &s}}, nil, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		sha *string
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.Branch
+		want   want
+	}{
+		"NotFound": {
+			reason: "A Branch whose branch doesn't exist should be created.",
+			github: &ghclient.Client{
+				Git: &fake.MockGitClient{MockGetRef: mockGetRef(nil)},
+			},
+			cr: branchResource(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Exists": {
+			reason: "A Branch whose branch exists should be up to date and observe its commit.",
+			github: &ghclient.Client{
+				Git: &fake.MockGitClient{MockGetRef: mockGetRef(map[string]string{refsHeads + branch: sha})},
+			},
+			cr: branchResource(withSourceSHA(mainSHA)),
+			want: want{
+				o:   managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				sha: &sha,
+			},
+		},
+		"GetRefError": {
+			reason: "Errors getting the branch should be returned.",
+			github: &ghclient.Client{
+				Git: &fake.MockGitClient{
+					MockGetRef: func(ctx context.Context, owner string, repo string, ref string) (*github.Reference, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			cr: branchResource(),
+			want: want{
+				err: errors.Wrapf(errBoom, errGetBranch, branch),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.sha, tc.cr.Status.AtProvider.SHA); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want sha, +got sha:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		created string
+		renamed string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason string
+		refs   map[string]string
+		cr     *v1alpha1.Branch
+		want   want
+	}{
+		"FromDefaultBranch": {
+			reason: "A Branch without a source should be created from the default branch.",
+			refs:   map[string]string{refsHeads + defaultBranch: mainSHA},
+			cr:     branchResource(),
+			want: want{
+				created: mainSHA,
+			},
+		},
+		"FromSHA": {
+			reason: "A Branch with a source commit should be created from it.",
+			cr:     branchResource(withSourceSHA(sha)),
+			want: want{
+				created: sha,
+			},
+		},
+		"NoSourceBranch": {
+			reason: "A Branch whose source branch doesn't exist should report so.",
+			cr:     branchResource(),
+			want: want{
+				err: errors.Errorf(errNoSource, defaultBranch),
+			},
+		},
+		"Renamed": {
+			reason: "A Branch should rename the branch it is renamed from if it exists.",
+			refs:   map[string]string{refsHeads + oldBranch: sha},
+			cr:     branchResource(withRenameFrom(oldBranch), withSourceSHA(mainSHA)),
+			want: want{
+				renamed: oldBranch,
+			},
+		},
+		"RenamedBefore": {
+			reason: "A Branch should be created if the branch it is renamed from doesn't exist.",
+			cr:     branchResource(withRenameFrom(oldBranch), withSourceSHA(mainSHA)),
+			want: want{
+				created: mainSHA,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var created, renamed string
+			gh := &ghclient.Client{
+				Git: &fake.MockGitClient{
+					MockGetRef: mockGetRef(tc.refs),
+					MockCreateRef: func(ctx context.Context, owner string, repo string, ref *github.Reference) (*github.Reference, *github.Response, error) {
+						if ref.GetRef() != refsHeads+branch {
+							return nil, nil, errors.New("unexpected ref")
+						}
+						created = ref.GetObject().GetSHA()
+						return ref, nil, nil
+					},
+				},
+				Repositories: &fake.MockRepositoriesClient{
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return &github.Repository{DefaultBranch: &defaultBranch}, nil, nil
+					},
+					MockRenameBranch: func(ctx context.Context, owner, repo, from, newName string) (*github.Branch, *github.Response, error) {
+						if newName != branch {
+							return nil, nil, errors.New("unexpected name")
+						}
+						renamed = from
+						return &github.Branch{Name: &newName}, nil, nil
+					},
+				},
+			}
+			e := external{github: gh}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want created from, +got created from:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.renamed, renamed); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want renamed from, +got renamed from:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
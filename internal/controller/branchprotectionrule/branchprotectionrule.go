@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branchprotectionrule
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotBranchProtectionRule = "managed resource is not a BranchProtectionRule custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCreds                = "cannot get credentials"
+	errNewClient               = "cannot create new Service"
+	errListRules               = "cannot list branch protection rules"
+	errCreateRule              = "cannot create branch protection rule"
+	errUpdateRule              = "cannot update branch protection rule"
+	errDeleteRule              = "cannot delete branch protection rule"
+
+	// unsupportedFor names BranchProtectionRules in the problems of their
+	// settings, as they are managed through the GraphQL API.
+	unsupportedFor = "BranchProtectionRules"
+)
+
+// Setup adds a controller that reconciles BranchProtectionRule managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.BranchProtectionRuleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.BranchProtectionRuleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		// The external name is the node ID GitHub gives the rule, which is
+		// looked up by branch pattern rather than set from the name of the
+		// BranchProtectionRule.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.BranchProtectionRule{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.BranchProtectionRuleList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.BranchProtectionRuleGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.BranchProtectionRule)
+	if !ok {
+		return nil, errors.New(errNotBranchProtectionRule)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.BranchProtectionRuleKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+// findRule returns the rule with the node ID id, or the rule for pattern if
+// there is none, e.g. because the rule is yet to be imported.
+func findRule(rules []*ghclient.BranchProtectionRule, id, pattern string) *ghclient.BranchProtectionRule {
+	var byPattern *ghclient.BranchProtectionRule
+	for _, rule := range rules {
+		if id != "" && rule.ID == id {
+			return rule
+		}
+		if rule.Pattern == pattern && byPattern == nil {
+			byPattern = rule
+		}
+	}
+	return byPattern
+}
+
+// validate returns an error listing the settings of a rule that can't be
+// managed through the GraphQL API.
+func validate(rule v1alpha1.RepositoryBranchProtectionRule) error {
+	if problems := util.UnsupportedGraphQLSettings(rule, unsupportedFor); len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.BranchProtectionRule)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotBranchProtectionRule)
+	}
+
+	p := cr.Spec.ForProvider
+	rules, err := c.github.BranchProtectionRules.ListBranchProtectionRules(ctx, p.Org, p.Repo)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListRules)
+	}
+	rule := findRule(rules.Rules, meta.GetExternalName(cr), p.Branch)
+	if rule == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The rule was created or imported by pattern.
+	lateInitialized := meta.GetExternalName(cr) != rule.ID
+	meta.SetExternalName(cr, rule.ID)
+	cr.Status.AtProvider.ID = rule.ID
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cmp.Equal(util.NormalizeBranchProtectionRule(p.RepositoryBranchProtectionRule), util.BranchProtectionRuleFromGraphQL(rule)),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.BranchProtectionRule)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotBranchProtectionRule)
+	}
+
+	p := cr.Spec.ForProvider
+	if err := validate(p.RepositoryBranchProtectionRule); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	rules, err := c.github.BranchProtectionRules.ListBranchProtectionRules(ctx, p.Org, p.Repo)
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errListRules)
+	}
+
+	// The mutation doesn't return the rule, its node ID is looked up by
+	// pattern at the next observation.
+	input := util.BranchProtectionRuleInput(p.RepositoryBranchProtectionRule)
+	input.RepositoryID = rules.RepositoryID
+	return managed.ExternalCreation{}, errors.Wrap(c.github.BranchProtectionRules.CreateBranchProtectionRule(ctx, input), errCreateRule)
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.BranchProtectionRule)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotBranchProtectionRule)
+	}
+
+	p := cr.Spec.ForProvider
+	if err := validate(p.RepositoryBranchProtectionRule); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// Updating the rule by its node ID also changes its pattern.
+	input := util.BranchProtectionRuleInput(p.RepositoryBranchProtectionRule)
+	input.BranchProtectionRuleID = meta.GetExternalName(cr)
+	return managed.ExternalUpdate{}, errors.Wrap(c.github.BranchProtectionRules.UpdateBranchProtectionRule(ctx, input), errUpdateRule)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.BranchProtectionRule)
+	if !ok {
+		return errors.New(errNotBranchProtectionRule)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	id := meta.GetExternalName(cr)
+	if id == "" {
+		return nil
+	}
+	return errors.Wrap(c.github.BranchProtectionRules.DeleteBranchProtectionRule(ctx, id), errDeleteRule)
+}
@@ -0,0 +1,280 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package branchprotectionrule
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org          = "test-org"
+	repo         = "test-repo"
+	pattern      = "release/*"
+	ruleID       = "BPR_kwDOAAABCD4AAAAB"
+	otherRuleID  = "BPR_kwDOAAABCD4AAAAC"
+	repositoryID = "R_kgDOAAABCD"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type ruleModifier func(*v1alpha1.BranchProtectionRule)
+
+func ruleResource(m ...ruleModifier) *v1alpha1.BranchProtectionRule {
+	cr := &v1alpha1.BranchProtectionRule{}
+	cr.Spec.ForProvider = v1alpha1.BranchProtectionRuleParameters{
+		Org:  org,
+		Repo: repo,
+		RepositoryBranchProtectionRule: v1alpha1.RepositoryBranchProtectionRule{
+			Branch:        pattern,
+			EnforceAdmins: true,
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExternalName(id string) ruleModifier {
+	return func(r *v1alpha1.BranchProtectionRule) {
+		meta.SetExternalName(r, id)
+	}
+}
+
+func githubRule(id, pattern string) *ghclient.BranchProtectionRule {
+	rule := &ghclient.BranchProtectionRule{ID: id}
+	rule.Pattern = pattern
+	rule.IsAdminEnforced = true
+	return rule
+}
+
+func mockListRules(rules ...*ghclient.BranchProtectionRule) func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+	return func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+		return &ghclient.RepositoryBranchProtectionRules{RepositoryID: repositoryID, Rules: rules}, nil
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		rules  *fake.MockBranchProtectionRulesClient
+		cr     *v1alpha1.BranchProtectionRule
+		want   want
+	}{
+		"NotFound": {
+			reason: "A BranchProtectionRule whose rule doesn't exist should be created.",
+			rules:  &fake.MockBranchProtectionRulesClient{MockListBranchProtectionRules: mockListRules(githubRule(otherRuleID, "main"))},
+			cr:     ruleResource(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FoundByPattern": {
+			reason: "A BranchProtectionRule without an external name should take the node ID of the rule for its pattern.",
+			rules:  &fake.MockBranchProtectionRulesClient{MockListBranchProtectionRules: mockListRules(githubRule(ruleID, pattern))},
+			cr:     ruleResource(),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				externalName: ruleID,
+			},
+		},
+		"FoundByID": {
+			reason: "A BranchProtectionRule whose pattern changed should update the rule with its node ID rather than the one for its pattern.",
+			rules:  &fake.MockBranchProtectionRulesClient{MockListBranchProtectionRules: mockListRules(githubRule(otherRuleID, pattern), githubRule(ruleID, "main"))},
+			cr:     ruleResource(withExternalName(ruleID)),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: ruleID,
+			},
+		},
+		"Differs": {
+			reason: "A BranchProtectionRule whose settings differ from the rule should be updated.",
+			rules: &fake.MockBranchProtectionRulesClient{MockListBranchProtectionRules: mockListRules(func() *ghclient.BranchProtectionRule {
+				rule := githubRule(ruleID, pattern)
+				rule.AllowsDeletions = true
+				return rule
+			}())},
+			cr: ruleResource(withExternalName(ruleID)),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: ruleID,
+			},
+		},
+		"ListError": {
+			reason: "Errors listing the rules should be returned.",
+			rules: &fake.MockBranchProtectionRulesClient{
+				MockListBranchProtectionRules: func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+					return nil, errBoom
+				},
+			},
+			cr: ruleResource(),
+			want: want{
+				err: errors.Wrap(errBoom, errListRules),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{BranchProtectionRules: tc.rules}}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		input *ghclient.BranchProtectionRuleInput
+		err   error
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.BranchProtectionRule
+		want   want
+	}{
+		"Created": {
+			reason: "A BranchProtectionRule should create its rule in its repository.",
+			cr:     ruleResource(),
+			want: want{
+				input: &ghclient.BranchProtectionRuleInput{
+					BranchProtectionRuleSettings: ghclient.BranchProtectionRuleSettings{Pattern: pattern, IsAdminEnforced: true},
+					RepositoryID:                 repositoryID,
+					RequiredStatusChecks:         []ghclient.RequiredStatusCheckInput{},
+				},
+			},
+		},
+		"Unsupported": {
+			reason: "A BranchProtectionRule with settings the GraphQL API can't manage should not be created.",
+			cr: ruleResource(func(r *v1alpha1.BranchProtectionRule) {
+				r.Spec.ForProvider.RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{
+					DismissalRestrictions: &v1alpha1.DismissalRestrictionsRequest{},
+				}
+			}),
+			want: want{
+				err: errors.New("dismissalRestrictions are not supported for " + unsupportedFor),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *ghclient.BranchProtectionRuleInput
+			e := external{github: &ghclient.Client{BranchProtectionRules: &fake.MockBranchProtectionRulesClient{
+				MockListBranchProtectionRules: mockListRules(),
+				MockCreateBranchProtectionRule: func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+					got = input
+					return nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.input, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want input, +got input:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var got string
+	e := external{github: &ghclient.Client{BranchProtectionRules: &fake.MockBranchProtectionRulesClient{
+		MockUpdateBranchProtectionRule: func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+			got = input.BranchProtectionRuleID + " " + input.Pattern
+			return nil
+		},
+	}}}
+	if _, err := e.Update(context.Background(), ruleResource(withExternalName(ruleID))); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff(ruleID+" "+pattern, got); diff != "" {
+		t.Errorf("\nA BranchProtectionRule should update its rule by its node ID.\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.BranchProtectionRule
+		want   []string
+	}{
+		"Deleted": {
+			reason: "A BranchProtectionRule should delete its rule by its node ID.",
+			cr:     ruleResource(withExternalName(ruleID)),
+			want:   []string{ruleID},
+		},
+		"NeverCreated": {
+			reason: "A BranchProtectionRule without an external name has no rule to delete.",
+			cr:     ruleResource(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			e := external{github: &ghclient.Client{BranchProtectionRules: &fake.MockBranchProtectionRulesClient{
+				MockDeleteBranchProtectionRule: func(ctx context.Context, id string) error {
+					got = append(got, id)
+					return nil
+				},
+			}}}
+			if err := e.Delete(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Delete(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
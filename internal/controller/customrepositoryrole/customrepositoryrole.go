@@ -0,0 +1,263 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customrepositoryrole
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+)
+
+const (
+	errNotCustomRepositoryRole = "managed resource is not a CustomRepositoryRole custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCreds                = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+)
+
+// Setup adds a controller that reconciles CustomRepositoryRole managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.CustomRepositoryRoleGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.CustomRepositoryRoleGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.CustomRepositoryRole{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.CustomRepositoryRoleList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.CustomRepositoryRoleGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.CustomRepositoryRole)
+	if !ok {
+		return nil, errors.New(errNotCustomRepositoryRole)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.CustomRepositoryRoleKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.CustomRepositoryRole)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotCustomRepositoryRole)
+	}
+
+	role, err := getCustomRepoRole(ctx, c.github, cr.Spec.ForProvider.Org, meta.GetExternalName(cr))
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if role == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.Status.AtProvider.ID = role.ID
+
+	if !isUpToDate(cr.Spec.ForProvider, role) {
+		return managed.ExternalObservation{
+			ResourceExists:   true,
+			ResourceUpToDate: false,
+		}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.CustomRepositoryRole)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotCustomRepositoryRole)
+	}
+
+	_, _, err := c.github.Organizations.CreateCustomRepoRole(ctx, cr.Spec.ForProvider.Org, roleOptions(meta.GetExternalName(cr), cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.CustomRepositoryRole)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotCustomRepositoryRole)
+	}
+
+	name := meta.GetExternalName(cr)
+	role, err := getCustomRepoRole(ctx, c.github, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if role == nil {
+		return managed.ExternalUpdate{}, errors.Errorf("cannot find custom repository role %s", name)
+	}
+
+	_, _, err = c.github.Organizations.UpdateCustomRepoRole(ctx, cr.Spec.ForProvider.Org, strconv.FormatInt(role.GetID(), 10), roleOptions(name, cr.Spec.ForProvider))
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.CustomRepositoryRole)
+	if !ok {
+		return errors.New(errNotCustomRepositoryRole)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	role, err := getCustomRepoRole(ctx, c.github, cr.Spec.ForProvider.Org, meta.GetExternalName(cr))
+	if err != nil || role == nil {
+		return err
+	}
+
+	_, err = c.github.Organizations.DeleteCustomRepoRole(ctx, cr.Spec.ForProvider.Org, strconv.FormatInt(role.GetID(), 10))
+	return err
+}
+
+// getCustomRepoRole returns the custom repository role of org called name, or
+// nil if the organization has no such role.
+func getCustomRepoRole(ctx context.Context, gh *ghclient.Client, org, name string) (*github.CustomRepoRoles, error) {
+	roles, _, err := gh.Organizations.ListCustomRepoRoles(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles.CustomRepoRoles {
+		if role.GetName() == name {
+			return role, nil
+		}
+	}
+	return nil, nil
+}
+
+func isUpToDate(p v1alpha1.CustomRepositoryRoleParameters, role *github.CustomRepoRoles) bool {
+	return pointer.StringDeref(p.Description, "") == role.GetDescription() &&
+		p.BaseRole == role.GetBaseRole() &&
+		cmp.Equal(sorted(p.Permissions), sorted(role.Permissions))
+}
+
+// sorted returns a sorted copy of s, so that the spec of the live CR is not
+// changed.
+func sorted(s []string) []string {
+	out := append([]string{}, s...)
+	slices.Sort(out)
+	return out
+}
+
+func roleOptions(name string, p v1alpha1.CustomRepositoryRoleParameters) *github.CreateOrUpdateCustomRoleOptions {
+	return &github.CreateOrUpdateCustomRoleOptions{
+		Name:        &name,
+		Description: p.Description,
+		BaseRole:    &p.BaseRole,
+		Permissions: p.Permissions,
+	}
+}
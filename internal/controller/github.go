@@ -20,11 +20,23 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/controller"
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/crossplane/provider-github/internal/controller/actionsrunnertoken"
+	"github.com/crossplane/provider-github/internal/controller/branch"
+	"github.com/crossplane/provider-github/internal/controller/branchprotectionrule"
 	"github.com/crossplane/provider-github/internal/controller/config"
+	"github.com/crossplane/provider-github/internal/controller/customrepositoryrole"
+	"github.com/crossplane/provider-github/internal/controller/issue"
 	"github.com/crossplane/provider-github/internal/controller/membership"
+	"github.com/crossplane/provider-github/internal/controller/membershipsnapshot"
 	"github.com/crossplane/provider-github/internal/controller/organization"
+	"github.com/crossplane/provider-github/internal/controller/organizationrole"
+	"github.com/crossplane/provider-github/internal/controller/organizationroleassignment"
+	"github.com/crossplane/provider-github/internal/controller/organizationruleset"
 	"github.com/crossplane/provider-github/internal/controller/repository"
+	"github.com/crossplane/provider-github/internal/controller/repositorydispatch"
+	"github.com/crossplane/provider-github/internal/controller/repositoryruleset"
 	"github.com/crossplane/provider-github/internal/controller/team"
+	"github.com/crossplane/provider-github/internal/controller/workflowdispatch"
 )
 
 // Setup creates all GitHub controllers with the supplied logger and adds them to
@@ -35,7 +47,19 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		organization.Setup,
 		repository.Setup,
 		membership.Setup,
+		membershipsnapshot.Setup,
 		team.Setup,
+		customrepositoryrole.Setup,
+		organizationrole.Setup,
+		organizationroleassignment.Setup,
+		organizationruleset.Setup,
+		workflowdispatch.Setup,
+		repositorydispatch.Setup,
+		issue.Setup,
+		branch.Setup,
+		branchprotectionrule.Setup,
+		repositoryruleset.Setup,
+		actionsrunnertoken.Setup,
 	} {
 		if err := setup(mgr, o); err != nil {
 			return err
@@ -0,0 +1,317 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issue
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/pointer"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotIssue       = "managed resource is not an Issue custom resource"
+	errTrackPCUsage   = "cannot track ProviderConfig usage"
+	errGetPC          = "cannot get ProviderConfig"
+	errGetCreds       = "cannot get credentials"
+	errNewClient      = "cannot create new Service"
+	errInvalidNumber  = "external name %q is not the number of an issue"
+	errGetIssue       = "cannot get issue"
+	errCreateIssue    = "cannot create issue"
+	errUpdateIssue    = "cannot update issue"
+	errCloseIssue     = "cannot close issue"
+	errIssueNotExists = "issue was not created yet"
+
+	stateOpen   = "open"
+	stateClosed = "closed"
+)
+
+// Setup adds a controller that reconciles Issue managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.IssueGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.IssueGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		// The external name is the number GitHub gives the issue on
+		// Create, rather than the name of the Issue.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.Issue{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.IssueList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.IssueGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return nil, errors.New(errNotIssue)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.IssueKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotIssue)
+	}
+
+	number, ok, err := issueNumber(cr)
+	if err != nil || !ok {
+		return managed.ExternalObservation{ResourceExists: false}, err
+	}
+
+	p := cr.Spec.ForProvider
+	issue, _, err := c.github.Issues.Get(ctx, p.Org, p.Repo, number)
+	if ghclient.Is404(err) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetIssue)
+	}
+
+	cr.Status.AtProvider = v1alpha1.IssueObservation{
+		Number:  issue.Number,
+		State:   issue.State,
+		HTMLURL: issue.HTMLURL,
+	}
+
+	// Issues can't be deleted, a closed issue is as good as gone.
+	if meta.WasDeleted(cr) && issue.GetState() == stateClosed {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: isUpToDate(p, issue),
+	}, nil
+}
+
+// issueNumber returns the number of the issue of cr, and false if it was not
+// created yet.
+func issueNumber(cr *v1alpha1.Issue) (int, bool, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return 0, false, nil
+	}
+	number, err := strconv.Atoi(name)
+	if err != nil {
+		return 0, false, errors.Errorf(errInvalidNumber, name)
+	}
+	return number, true, nil
+}
+
+func isUpToDate(p v1alpha1.IssueParameters, issue *github.Issue) bool {
+	if p.Title != issue.GetTitle() ||
+		pointer.StringDeref(p.State, stateOpen) != issue.GetState() {
+		return false
+	}
+	if p.Body != nil && *p.Body != issue.GetBody() {
+		return false
+	}
+	if p.Milestone != nil && *p.Milestone != issue.GetMilestone().GetNumber() {
+		return false
+	}
+	if p.Labels != nil {
+		labels := make([]string, len(issue.Labels))
+		for i, l := range issue.Labels {
+			labels[i] = l.GetName()
+		}
+		// Label names are case-insensitive, like logins.
+		if !slices.Equal(util.NormalizeNames(p.Labels), util.NormalizeNames(labels)) {
+			return false
+		}
+	}
+	if p.Assignees != nil {
+		assignees := make([]string, len(issue.Assignees))
+		for i, a := range issue.Assignees {
+			assignees[i] = a.GetLogin()
+		}
+		if !slices.Equal(util.NormalizeNames(p.Assignees), util.NormalizeNames(assignees)) {
+			return false
+		}
+	}
+	return true
+}
+
+// issueRequest returns the request that sets the fields of p that are set.
+func issueRequest(p v1alpha1.IssueParameters) *github.IssueRequest {
+	req := &github.IssueRequest{
+		Title:     github.String(p.Title),
+		Body:      p.Body,
+		Milestone: p.Milestone,
+	}
+	if p.Labels != nil {
+		req.Labels = &p.Labels
+	}
+	if p.Assignees != nil {
+		req.Assignees = &p.Assignees
+	}
+	return req
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotIssue)
+	}
+
+	// Issues are always opened, an Issue that should be closed is closed by
+	// the Update that follows.
+	p := cr.Spec.ForProvider
+	issue, _, err := c.github.Issues.Create(ctx, p.Org, p.Repo, issueRequest(p))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateIssue)
+	}
+
+	meta.SetExternalName(cr, strconv.Itoa(issue.GetNumber()))
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotIssue)
+	}
+
+	number, ok, err := issueNumber(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errIssueNotExists)
+	}
+
+	p := cr.Spec.ForProvider
+	req := issueRequest(p)
+	req.State = github.String(pointer.StringDeref(p.State, stateOpen))
+	if _, _, err := c.github.Issues.Edit(ctx, p.Org, p.Repo, number, req); err != nil {
+		return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateIssue)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.Issue)
+	if !ok {
+		return errors.New(errNotIssue)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	number, ok, err := issueNumber(cr)
+	if err != nil || !ok {
+		return err
+	}
+
+	p := cr.Spec.ForProvider
+	_, _, err = c.github.Issues.Edit(ctx, p.Org, p.Repo, number, &github.IssueRequest{State: github.String(stateClosed)})
+	if ghclient.Is404(err) {
+		return nil
+	}
+	return errors.Wrap(err, errCloseIssue)
+}
@@ -0,0 +1,404 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issue
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org    = "test-org"
+	repo   = "test-repo"
+	title  = "Onboarding checklist"
+	body   = "- [ ] Add a CODEOWNERS file"
+	label  = "onboarding"
+	user   = "test-user"
+	number = 7
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type issueModifier func(*v1alpha1.Issue)
+
+func issue(m ...issueModifier) *v1alpha1.Issue {
+	cr := &v1alpha1.Issue{}
+	cr.Spec.ForProvider = v1alpha1.IssueParameters{
+		Org:       org,
+		Repo:      repo,
+		Title:     title,
+		Body:      &body,
+		Labels:    []string{label},
+		Assignees: []string{user},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExternalName(name string) issueModifier {
+	return func(r *v1alpha1.Issue) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withState(state string) issueModifier {
+	return func(r *v1alpha1.Issue) {
+		r.Spec.ForProvider.State = &state
+	}
+}
+
+func withDeletion() issueModifier {
+	return func(r *v1alpha1.Issue) {
+		now := metav1.NewTime(time.Now())
+		r.SetDeletionTimestamp(&now)
+	}
+}
+
+type githubIssueModifier func(*github.Issue)
+
+func githubIssue(m ...githubIssueModifier) *github.Issue {
+	i := &github.Issue{
+		Number:    &number,
+		Title:     &title,
+		Body:      &body,
+		State:     github.String(stateOpen),
+		Labels:    []*github.Label{{Name: github.String("Onboarding")}},
+		Assignees: []*github.User{{Login: github.String("Test-User")}},
+	}
+	for _, f := range m {
+		f(i)
+	}
+	return i
+}
+
+func withGithubState(state string) githubIssueModifier {
+	return func(i *github.Issue) {
+		i.State = &state
+	}
+}
+
+func withGithubTitle(t string) githubIssueModifier {
+	return func(i *github.Issue) {
+		i.Title = &t
+	}
+}
+
+func mockGet(i *github.Issue, err error) func(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+	return func(ctx context.Context, owner string, repo string, number int) (*github.Issue, *github.Response, error) {
+		return i, nil, err
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o     managed.ExternalObservation
+		ready bool
+		err   error
+	}
+
+	errBoom := errors.New("boom")
+	errNotFound := &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.Issue
+		want   want
+	}{
+		"NotCreated": {
+			reason: "An Issue without an external name should be created.",
+			github: &ghclient.Client{},
+			cr:     issue(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"InvalidExternalName": {
+			reason: "An external name that isn't a number should be reported.",
+			github: &ghclient.Client{},
+			cr:     issue(withExternalName("onboarding")),
+			want: want{
+				err: errors.Errorf(errInvalidNumber, "onboarding"),
+			},
+		},
+		"NotFound": {
+			reason: "An Issue whose issue doesn't exist should be created.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(nil, errNotFound)},
+			},
+			cr: issue(withExternalName("7")),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"GetError": {
+			reason: "Errors getting the issue should be returned.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(nil, errBoom)},
+			},
+			cr: issue(withExternalName("7")),
+			want: want{
+				err: errors.Wrap(errBoom, errGetIssue),
+			},
+		},
+		"UpToDate": {
+			reason: "Labels and assignees should be compared case-insensitively.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(githubIssue(), nil)},
+			},
+			cr: issue(withExternalName("7")),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				ready: true,
+			},
+		},
+		"TitleDiffers": {
+			reason: "An issue with another title should be updated.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(githubIssue(withGithubTitle("Checklist")), nil)},
+			},
+			cr: issue(withExternalName("7")),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				ready: true,
+			},
+		},
+		"StateDiffers": {
+			reason: "An issue that was closed should be reopened by default.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(githubIssue(withGithubState(stateClosed)), nil)},
+			},
+			cr: issue(withExternalName("7")),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				ready: true,
+			},
+		},
+		"DeletedAndClosed": {
+			reason: "A deleted Issue whose issue is closed should be reported as gone.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{MockGet: mockGet(githubIssue(withGithubState(stateClosed)), nil)},
+			},
+			cr: issue(withExternalName("7"), withDeletion()),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if ready := tc.cr.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()); ready != tc.want.ready {
+				t.Errorf("\n%s\ne.Observe(...): want ready %t, got %t\n", tc.reason, tc.want.ready, ready)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		externalName string
+		err          error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.Issue
+		want   want
+	}{
+		"Created": {
+			reason: "Create should open the issue and use its number as external name.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockCreate: func(ctx context.Context, owner string, repo string, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						if req.GetTitle() != title || req.GetBody() != body || len(req.GetLabels()) != 1 || len(req.GetAssignees()) != 1 {
+							return nil, nil, errBoom
+						}
+						return githubIssue(), nil, nil
+					},
+				},
+			},
+			cr: issue(),
+			want: want{
+				externalName: "7",
+			},
+		},
+		"CreateError": {
+			reason: "Errors creating the issue should be returned.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockCreate: func(ctx context.Context, owner string, repo string, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			cr: issue(),
+			want: want{
+				err: errors.Wrap(errBoom, errCreateIssue),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.Issue
+		want   error
+	}{
+		"Closed": {
+			reason: "Update should set the state of the issue.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockEdit: func(ctx context.Context, owner string, repo string, n int, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						if n != number || req.GetState() != stateClosed || req.GetTitle() != title {
+							return nil, nil, errBoom
+						}
+						return githubIssue(), nil, nil
+					},
+				},
+			},
+			cr: issue(withExternalName("7"), withState(stateClosed)),
+		},
+		"EditError": {
+			reason: "Errors editing the issue should be returned.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockEdit: func(ctx context.Context, owner string, repo string, n int, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			cr:   issue(withExternalName("7")),
+			want: errors.Wrap(errBoom, errUpdateIssue),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			_, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.Issue
+		want   error
+	}{
+		"Closed": {
+			reason: "Delete should close the issue.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockEdit: func(ctx context.Context, owner string, repo string, n int, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						if n != number || req.GetState() != stateClosed {
+							return nil, nil, errBoom
+						}
+						return githubIssue(withGithubState(stateClosed)), nil, nil
+					},
+				},
+			},
+			cr: issue(withExternalName("7")),
+		},
+		"NotCreated": {
+			reason: "Delete should do nothing if the issue was never created.",
+			github: &ghclient.Client{},
+			cr:     issue(),
+		},
+		"CloseError": {
+			reason: "Errors closing the issue should be returned.",
+			github: &ghclient.Client{
+				Issues: &fake.MockIssuesClient{
+					MockEdit: func(ctx context.Context, owner string, repo string, n int, req *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			cr:   issue(withExternalName("7")),
+			want: errors.Wrap(errBoom, errCloseIssue),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			err := e.Delete(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
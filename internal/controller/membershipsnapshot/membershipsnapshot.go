@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membershipsnapshot
+
+import (
+	"context"
+	"sort"
+
+	"github.com/pkg/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotMembershipSnapshot = "managed resource is not a MembershipSnapshot custom resource"
+	errTrackPCUsage          = "cannot track ProviderConfig usage"
+	errListTeams             = "cannot list Team managed resources"
+	errListRepositories      = "cannot list Repository managed resources"
+)
+
+// Setup adds a controller that reconciles MembershipSnapshot managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.MembershipSnapshotGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.MembershipSnapshotGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:  mgr.GetClient(),
+			usage: resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{})}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		WithEventFilter(resource.DesiredStateChanged()).
+		For(&v1alpha1.MembershipSnapshot{}).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.MembershipSnapshotGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube  client.Client
+	usage resource.Tracker
+}
+
+// Connect only tracks the ProviderConfig usage, a MembershipSnapshot is built
+// from the managed resources in the cluster and never calls the GitHub API.
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	if _, ok := mg.(*v1alpha1.MembershipSnapshot); !ok {
+		return nil, errors.New(errNotMembershipSnapshot)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	return &external{kube: c.kube}, nil
+}
+
+type external struct {
+	kube client.Reader
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.MembershipSnapshot)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotMembershipSnapshot)
+	}
+
+	// Nothing exists in GitHub, so report the snapshot as gone once it is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	teams := &v1alpha1.TeamList{}
+	if err := c.kube.List(ctx, teams); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListTeams)
+	}
+
+	repos := &v1alpha1.RepositoryList{}
+	if err := c.kube.List(ctx, repos); err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListRepositories)
+	}
+
+	cr.Status.AtProvider = v1alpha1.MembershipSnapshotObservation{
+		Teams:        getUserTeams(teams.Items, cr.Spec.ForProvider.Org, cr.Spec.ForProvider.User),
+		Repositories: getUserRepositories(repos.Items, cr.Spec.ForProvider.Org, cr.Spec.ForProvider.User),
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// A snapshot is observe-only, it always exists and is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// getUserTeams returns the sorted team memberships of user in the Teams of org.
+// Logins and the names of organizations are compared case-insensitively.
+func getUserTeams(teams []v1alpha1.Team, org, user string) []v1alpha1.MembershipSnapshotTeam {
+	var out []v1alpha1.MembershipSnapshotTeam
+	for _, t := range teams {
+		if util.NormalizeName(t.Spec.ForProvider.Org) != util.NormalizeName(org) {
+			continue
+		}
+		for _, m := range t.Spec.ForProvider.Members {
+			if util.NormalizeName(m.User) == util.NormalizeName(user) {
+				out = append(out, v1alpha1.MembershipSnapshotTeam{
+					Team:         meta.GetExternalName(&t),
+					Role:         m.Role,
+					ResourceName: t.GetName(),
+				})
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Team < out[j].Team
+	})
+	return out
+}
+
+// getUserRepositories returns the sorted direct repository grants of user in the Repositories of org.
+// Logins and the names of organizations are compared case-insensitively.
+func getUserRepositories(repos []v1alpha1.Repository, org, user string) []v1alpha1.MembershipSnapshotRepository {
+	var out []v1alpha1.MembershipSnapshotRepository
+	for _, r := range repos {
+		owner, name := v1alpha1.ParseRepositoryExternalName(meta.GetExternalName(&r))
+		if owner == "" {
+			owner = r.Spec.ForProvider.Org
+		}
+		if util.NormalizeName(owner) != util.NormalizeName(org) {
+			continue
+		}
+		for _, u := range r.Spec.ForProvider.Permissions.Users {
+			if util.NormalizeName(u.User) == util.NormalizeName(user) {
+				out = append(out, v1alpha1.MembershipSnapshotRepository{
+					Repo:         name,
+					Role:         u.Role,
+					ResourceName: r.GetName(),
+				})
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Repo < out[j].Repo
+	})
+	return out
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if _, ok := mg.(*v1alpha1.MembershipSnapshot); !ok {
+		return managed.ExternalCreation{}, errors.New(errNotMembershipSnapshot)
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.MembershipSnapshot); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotMembershipSnapshot)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.MembershipSnapshot)
+	if !ok {
+		return errors.New(errNotMembershipSnapshot)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	return nil
+}
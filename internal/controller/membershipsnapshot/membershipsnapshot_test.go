@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membershipsnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+var (
+	org   = "test-org"
+	user1 = "test-user-1"
+	team1 = "test-team-1"
+	repo1 = "test-repo-1"
+)
+
+func snapshot() *v1alpha1.MembershipSnapshot {
+	cr := &v1alpha1.MembershipSnapshot{}
+	cr.Spec.ForProvider.Org = org
+	cr.Spec.ForProvider.User = user1
+	return cr
+}
+
+func mockList(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	switch l := list.(type) {
+	case *v1alpha1.TeamList:
+		t := v1alpha1.Team{}
+		t.SetName("team-1")
+		meta.SetExternalName(&t, team1)
+		t.Spec.ForProvider.Org = org
+		t.Spec.ForProvider.Members = []v1alpha1.TeamMemberUser{{User: user1, Role: "maintainer"}, {User: "other", Role: "member"}}
+		other := v1alpha1.Team{}
+		other.Spec.ForProvider.Org = "other-org"
+		other.Spec.ForProvider.Members = []v1alpha1.TeamMemberUser{{User: user1, Role: "member"}}
+		l.Items = []v1alpha1.Team{t, other}
+	case *v1alpha1.RepositoryList:
+		r := v1alpha1.Repository{}
+		r.SetName("repo-1")
+		meta.SetExternalName(&r, repo1)
+		r.Spec.ForProvider.Org = org
+		r.Spec.ForProvider.Permissions.Users = []v1alpha1.RepositoryUser{{User: user1, Role: "admin"}}
+		l.Items = []v1alpha1.Repository{r}
+	}
+	return nil
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o   managed.ExternalObservation
+		obs v1alpha1.MembershipSnapshotObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Reader
+		mg     resource.Managed
+		want   want
+	}{
+		"ReportsAccess": {
+			reason: "Teams and direct repository grants of the user in the organization should be reported.",
+			kube:   &test.MockClient{MockList: mockList},
+			mg:     snapshot(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				obs: v1alpha1.MembershipSnapshotObservation{
+					Teams:        []v1alpha1.MembershipSnapshotTeam{{Team: team1, Role: "maintainer", ResourceName: "team-1"}},
+					Repositories: []v1alpha1.MembershipSnapshotRepository{{Repo: repo1, Role: "admin", ResourceName: "repo-1"}},
+				},
+			},
+		},
+		"ReportsAccessOfMixedCaseLogin": {
+			reason: "Teams and direct repository grants should be reported whatever the case the login and organization are written in.",
+			kube:   &test.MockClient{MockList: mockList},
+			mg: func() resource.Managed {
+				cr := snapshot()
+				cr.Spec.ForProvider.Org = "Test-Org"
+				cr.Spec.ForProvider.User = "Test-User-1"
+				return cr
+			}(),
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				obs: v1alpha1.MembershipSnapshotObservation{
+					Teams:        []v1alpha1.MembershipSnapshotTeam{{Team: team1, Role: "maintainer", ResourceName: "team-1"}},
+					Repositories: []v1alpha1.MembershipSnapshotRepository{{Repo: repo1, Role: "admin", ResourceName: "repo-1"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{kube: tc.kube}
+			got, err := e.Observe(context.Background(), tc.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.obs, tc.mg.(*v1alpha1.MembershipSnapshot).Status.AtProvider); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want atProvider, +got atProvider:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
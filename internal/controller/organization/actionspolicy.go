@@ -0,0 +1,49 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetActionsPermissions  = "cannot get Actions permissions"
+	errEditActionsPermissions = "cannot set Actions enabled repositories policy"
+)
+
+// observeEnabledRepositories returns the policy of the repositories of an
+// organization that Actions are enabled for, i.e. all, none or selected.
+func observeEnabledRepositories(ctx context.Context, gh *ghclient.Client, org string) (string, error) {
+	p, _, err := gh.Actions.GetActionsPermissions(ctx, org)
+	if err != nil {
+		return "", errors.Wrap(err, errGetActionsPermissions)
+	}
+	return p.GetEnabledRepositories(), nil
+}
+
+// updateEnabledRepositories sets the policy of the repositories of an
+// organization that Actions are enabled for. The allowed actions are left as
+// they are.
+func updateEnabledRepositories(ctx context.Context, gh *ghclient.Client, org, policy string) error {
+	_, _, err := gh.Actions.EditActionsPermissions(ctx, org, github.ActionsPermissions{EnabledRepositories: &policy})
+	return errors.Wrap(err, errEditActionsPermissions)
+}
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListOrgRepositories = "cannot list repositories of the organization"
+	errGetOrgRepository    = "cannot get repository %s"
+
+	defaultBaselineRefreshInterval = time.Hour
+
+	// maxNonCompliantRepositories is the number of non-compliant
+	// repositories listed in the status, to keep it small in large
+	// organizations.
+	maxNonCompliantRepositories = 100
+
+	securityEnabled = "enabled"
+)
+
+// baselineRefreshDue reports whether the repository baseline audit of an
+// Organization is missing or older than its refresh interval.
+func baselineRefreshDue(cr *v1alpha1.Organization, now time.Time) bool {
+	obs := cr.Status.AtProvider.RepositoryBaseline
+	if obs == nil || obs.RefreshTime == nil {
+		return true
+	}
+
+	interval := defaultBaselineRefreshInterval
+	if ri := cr.Spec.ForProvider.RepositoryBaseline.RefreshInterval; ri != nil {
+		interval = ri.Duration
+	}
+	return !now.Before(obs.RefreshTime.Add(interval))
+}
+
+// baselineSetting is a setting of the repository baseline with the value a
+// repository has.
+type baselineSetting struct {
+	name     string
+	desired  *bool
+	observed bool
+}
+
+func baselineSettings(b *v1alpha1.RepositoryBaseline, repo *github.Repository) []baselineSetting {
+	sa := repo.GetSecurityAndAnalysis()
+	return []baselineSetting{
+		{name: "allowMergeCommit", desired: b.AllowMergeCommit, observed: repo.GetAllowMergeCommit()},
+		{name: "allowSquashMerge", desired: b.AllowSquashMerge, observed: repo.GetAllowSquashMerge()},
+		{name: "allowRebaseMerge", desired: b.AllowRebaseMerge, observed: repo.GetAllowRebaseMerge()},
+		{name: "deleteBranchOnMerge", desired: b.DeleteBranchOnMerge, observed: repo.GetDeleteBranchOnMerge()},
+		{name: "secretScanning", desired: b.SecretScanning, observed: sa.GetSecretScanning().GetStatus() == securityEnabled},
+		{name: "secretScanningPushProtection", desired: b.SecretScanningPushProtection, observed: sa.GetSecretScanningPushProtection().GetStatus() == securityEnabled},
+		{name: "dependabotSecurityUpdates", desired: b.DependabotSecurityUpdates, observed: sa.GetDependabotSecurityUpdates().GetStatus() == securityEnabled},
+	}
+}
+
+// auditsSettings reports whether the baseline audits settings that the list
+// of repositories of an organization doesn't include, so that each
+// repository has to be read.
+func auditsSettings(b *v1alpha1.RepositoryBaseline) bool {
+	for _, s := range baselineSettings(b, &github.Repository{}) {
+		if s.desired != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// baselineViolations returns the settings of the baseline that repo doesn't
+// have.
+func baselineViolations(b *v1alpha1.RepositoryBaseline, repo *github.Repository) []string {
+	var violations []string
+	for _, t := range b.Topics {
+		if !slices.Contains(repo.Topics, t) {
+			violations = append(violations, "topics["+t+"]")
+		}
+	}
+	for _, s := range baselineSettings(b, repo) {
+		if s.desired != nil && *s.desired != s.observed {
+			violations = append(violations, s.name)
+		}
+	}
+	return violations
+}
+
+// auditRepositories audits the repositories of an organization that aren't
+// archived against its repository baseline.
+func auditRepositories(ctx context.Context, gh *ghclient.Client, org string, b *v1alpha1.RepositoryBaseline, now time.Time) (*v1alpha1.RepositoryBaselineObservation, error) {
+	repos, err := ghclient.ListAll(func(page int) ([]*github.Repository, *github.Response, error) {
+		return gh.Repositories.ListByOrg(ctx, org, &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page}})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListOrgRepositories)
+	}
+
+	refreshTime := metav1.NewTime(now)
+	obs := &v1alpha1.RepositoryBaselineObservation{RefreshTime: &refreshTime}
+	get := auditsSettings(b)
+	for _, repo := range repos {
+		if repo.GetArchived() {
+			continue
+		}
+		if get {
+			name := repo.GetName()
+			if repo, _, err = gh.Repositories.Get(ctx, org, name); err != nil {
+				return nil, errors.Wrapf(err, errGetOrgRepository, name)
+			}
+		}
+		obs.Repositories++
+		violations := baselineViolations(b, repo)
+		if len(violations) == 0 {
+			continue
+		}
+		obs.NonCompliant++
+		obs.NonCompliantRepositories = append(obs.NonCompliantRepositories, v1alpha1.NonCompliantRepository{Name: repo.GetName(), Violations: violations})
+	}
+
+	sort.Slice(obs.NonCompliantRepositories, func(i, j int) bool {
+		return obs.NonCompliantRepositories[i].Name < obs.NonCompliantRepositories[j].Name
+	})
+	if len(obs.NonCompliantRepositories) > maxNonCompliantRepositories {
+		obs.NonCompliantRepositories = obs.NonCompliantRepositories[:maxNonCompliantRepositories]
+	}
+	return obs, nil
+}
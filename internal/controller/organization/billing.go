@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetActionsBilling = "cannot get Actions billing"
+	errGetStorageBilling = "cannot get storage billing"
+
+	defaultBillingRefreshInterval = time.Hour
+)
+
+// billingRefreshDue reports whether the billing observation of an Organization
+// is missing or older than its refresh interval.
+func billingRefreshDue(cr *v1alpha1.Organization, now time.Time) bool {
+	obs := cr.Status.AtProvider.Billing
+	if obs == nil || obs.RefreshTime == nil {
+		return true
+	}
+
+	interval := defaultBillingRefreshInterval
+	if ri := cr.Spec.ForProvider.Billing.RefreshInterval; ri != nil {
+		interval = ri.Duration
+	}
+	return !now.Before(obs.RefreshTime.Add(interval))
+}
+
+// getBilling retrieves the Actions usage of the current billing cycle of an organization.
+func getBilling(ctx context.Context, gh *ghclient.Client, org string, now time.Time) (*v1alpha1.BillingObservation, error) {
+	actions, _, err := gh.Billing.GetActionsBillingOrg(ctx, org)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetActionsBilling)
+	}
+	storage, _, err := gh.Billing.GetStorageBillingOrg(ctx, org)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetStorageBilling)
+	}
+
+	refreshTime := metav1.NewTime(now)
+	return &v1alpha1.BillingObservation{
+		RefreshTime:                  &refreshTime,
+		ActionsMinutesUsed:           int64(math.Round(actions.TotalMinutesUsed)),
+		ActionsPaidMinutesUsed:       int64(math.Round(actions.TotalPaidMinutesUsed)),
+		ActionsIncludedMinutes:       int64(math.Round(actions.IncludedMinutes)),
+		ActionsMinutesUsedBreakdown:  actions.MinutesUsedBreakdown,
+		EstimatedStorageForMonth:     strconv.FormatFloat(storage.EstimatedStorageForMonth, 'f', -1, 64),
+		EstimatedPaidStorageForMonth: strconv.FormatFloat(storage.EstimatedPaidStorageForMonth, 'f', -1, 64),
+		DaysLeftInBillingCycle:       storage.DaysLeftInBillingCycle,
+	}, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListBlockedUsers = "cannot list blocked users"
+	errBlockUser        = "cannot block user %s"
+)
+
+// getBlockedUsers returns the sorted logins of the users blocked from an
+// organization.
+func getBlockedUsers(ctx context.Context, gh *ghclient.Client, org string) ([]string, error) {
+	users, err := ghclient.ListAll(func(page int) ([]*github.User, *github.Response, error) {
+		return gh.Organizations.ListBlockedUsers(ctx, org, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListBlockedUsers)
+	}
+
+	logins := make([]string, 0, len(users))
+	for _, u := range users {
+		logins = append(logins, u.GetLogin())
+	}
+	sort.Strings(logins)
+	return logins, nil
+}
+
+// getMissingBlocks returns the users of the spec that aren't blocked. Logins
+// are case-insensitive.
+func getMissingBlocks(desired, blocked []string) []string {
+	isBlocked := make(map[string]bool, len(blocked))
+	for _, u := range blocked {
+		isBlocked[strings.ToLower(u)] = true
+	}
+
+	var missing []string
+	for _, u := range desired {
+		if !isBlocked[strings.ToLower(u)] {
+			missing = append(missing, u)
+		}
+	}
+	return missing
+}
+
+// updateBlockedUsers blocks the users of the spec that aren't blocked. It
+// never unblocks users, so that blocks made by moderators aren't lifted.
+func updateBlockedUsers(ctx context.Context, gh *ghclient.Client, org string, users []string) error {
+	blocked, err := getBlockedUsers(ctx, gh, org)
+	if err != nil {
+		return err
+	}
+	for _, u := range getMissingBlocks(users, blocked) {
+		if _, err := gh.Organizations.BlockUser(ctx, org, u); err != nil {
+			return errors.Wrapf(err, errBlockUser, u)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetCustomProperties    = "cannot get custom properties"
+	errUpdateCustomProperties = "cannot update custom properties"
+)
+
+// observeCustomProperties returns whether all custom property schemas of the
+// spec exist on the organization with the same settings.
+func observeCustomProperties(ctx context.Context, gh *ghclient.Client, org string, properties []v1alpha1.CustomPropertyDefinition) (bool, error) {
+	ghProperties, _, err := gh.Organizations.GetAllCustomProperties(ctx, org)
+	if err != nil {
+		return false, errors.Wrap(err, errGetCustomProperties)
+	}
+
+	existing := make(map[string]*github.CustomProperty, len(ghProperties))
+	for _, p := range ghProperties {
+		existing[p.GetPropertyName()] = p
+	}
+
+	for _, p := range properties {
+		ghProperty, ok := existing[p.Name]
+		if !ok || !isCustomPropertyUpToDate(p, ghProperty) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func isCustomPropertyUpToDate(p v1alpha1.CustomPropertyDefinition, ghProperty *github.CustomProperty) bool {
+	if p.ValueType != ghProperty.ValueType {
+		return false
+	}
+	if pointer.BoolDeref(p.Required, false) != ghProperty.GetRequired() {
+		return false
+	}
+	if pointer.StringDeref(p.DefaultValue, "") != ghProperty.GetDefaultValue() {
+		return false
+	}
+	if p.Description != nil && *p.Description != ghProperty.GetDescription() {
+		return false
+	}
+	// The order of allowed values is the order they are offered in on GitHub.
+	return slices.Equal(p.AllowedValues, ghProperty.AllowedValues)
+}
+
+// updateCustomProperties creates or updates all custom property schemas of the
+// spec in a single request.
+func updateCustomProperties(ctx context.Context, gh *ghclient.Client, org string, properties []v1alpha1.CustomPropertyDefinition) error {
+	req := make([]*github.CustomProperty, 0, len(properties))
+	for _, p := range properties {
+		req = append(req, &github.CustomProperty{
+			PropertyName:  pointer.String(p.Name),
+			ValueType:     p.ValueType,
+			Required:      p.Required,
+			DefaultValue:  p.DefaultValue,
+			Description:   p.Description,
+			AllowedValues: p.AllowedValues,
+		})
+	}
+
+	_, _, err := gh.Organizations.CreateOrUpdateCustomProperties(ctx, org, req)
+	return errors.Wrap(err, errUpdateCustomProperties)
+}
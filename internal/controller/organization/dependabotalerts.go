@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListDependabotAlerts   = "cannot list Dependabot alerts"
+	errDismissDependabotAlert = "cannot dismiss Dependabot alert %d of repository %s"
+
+	dependabotAlertOpen      = "open"
+	dependabotAlertDismissed = "dismissed"
+)
+
+// dismissibleAlert is an open Dependabot alert with the first dismissal rule
+// it matches.
+type dismissibleAlert struct {
+	alert *github.DependabotAlert
+	rule  v1alpha1.DependabotAlertDismissalRule
+}
+
+// matchesDismissalRule returns whether a Dependabot alert matches all criteria
+// of a dismissal rule. Ecosystems are case-insensitive.
+func matchesDismissalRule(r v1alpha1.DependabotAlertDismissalRule, a *github.DependabotAlert) bool {
+	dep := a.GetDependency()
+	if r.Scope != nil && *r.Scope != dep.GetScope() {
+		return false
+	}
+	if r.Severities != nil {
+		severity := a.GetSecurityVulnerability().GetSeverity()
+		if severity == "" {
+			severity = a.GetSecurityAdvisory().GetSeverity()
+		}
+		if !slices.Contains(r.Severities, severity) {
+			return false
+		}
+	}
+	if r.Ecosystems != nil && !slices.ContainsFunc(r.Ecosystems, func(e string) bool {
+		return strings.EqualFold(e, dep.GetPackage().GetEcosystem())
+	}) {
+		return false
+	}
+	if r.Packages != nil && !slices.Contains(r.Packages, dep.GetPackage().GetName()) {
+		return false
+	}
+	return true
+}
+
+// getDismissibleAlerts returns the open Dependabot alerts of the repositories
+// of an organization that match one of the dismissal rules.
+func getDismissibleAlerts(ctx context.Context, gh *ghclient.Client, org string, rules []v1alpha1.DependabotAlertDismissalRule) ([]dismissibleAlert, error) {
+	alerts, err := ghclient.ListAllAfter(func(after string) ([]*github.DependabotAlert, *github.Response, error) {
+		return gh.Dependabot.ListOrgAlerts(ctx, org, &github.ListAlertsOptions{
+			State:             github.String(dependabotAlertOpen),
+			ListCursorOptions: github.ListCursorOptions{PerPage: ghclient.PerPage, After: after},
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListDependabotAlerts)
+	}
+
+	var dismissible []dismissibleAlert
+	for _, a := range alerts {
+		for _, r := range rules {
+			if matchesDismissalRule(r, a) {
+				dismissible = append(dismissible, dismissibleAlert{alert: a, rule: r})
+				break
+			}
+		}
+	}
+	return dismissible, nil
+}
+
+// dismissAlerts dismisses the open Dependabot alerts of the repositories of
+// an organization that match one of the dismissal rules.
+func dismissAlerts(ctx context.Context, gh *ghclient.Client, org string, rules []v1alpha1.DependabotAlertDismissalRule) error {
+	dismissible, err := getDismissibleAlerts(ctx, gh, org, rules)
+	if err != nil {
+		return err
+	}
+	for _, d := range dismissible {
+		repo := d.alert.GetRepository().GetName()
+		state := &github.DependabotAlertState{
+			State:            dependabotAlertDismissed,
+			DismissedReason:  github.String(d.rule.DismissedReason),
+			DismissedComment: github.String(fmt.Sprintf("Dismissed by the dismissal rule %s", d.rule.Name)),
+		}
+		if _, _, err := gh.Dependabot.UpdateAlert(ctx, org, repo, d.alert.GetNumber(), state); err != nil {
+			return errors.Wrapf(err, errDismissDependabotAlert, d.alert.GetNumber(), repo)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetOIDCSubjectClaim = "cannot get OIDC subject claim template"
+	errSetOIDCSubjectClaim = "cannot set OIDC subject claim template"
+)
+
+// defaultOIDCClaimKeys are the claims of the subject claim GitHub uses unless
+// an organization customizes it.
+var defaultOIDCClaimKeys = []string{"repo", "context"}
+
+// desiredOIDCClaimKeys returns the claims of the subject claim of the spec.
+func desiredOIDCClaimKeys(claim *v1alpha1.OIDCSubjectClaim) []string {
+	if claim.UseDefault {
+		return defaultOIDCClaimKeys
+	}
+	return claim.IncludeClaimKeys
+}
+
+// observeOIDCSubjectClaim returns whether the subject claim template of an
+// organization is the one of the spec. The order of the claims matters.
+func observeOIDCSubjectClaim(ctx context.Context, gh *ghclient.Client, org string, claim *v1alpha1.OIDCSubjectClaim) (bool, error) {
+	tmpl, _, err := gh.Actions.GetOrgOIDCSubjectClaimCustomTemplate(ctx, org)
+	if err != nil {
+		return false, errors.Wrap(err, errGetOIDCSubjectClaim)
+	}
+	return slices.Equal(desiredOIDCClaimKeys(claim), tmpl.IncludeClaimKeys), nil
+}
+
+// updateOIDCSubjectClaim sets the subject claim template of an organization.
+func updateOIDCSubjectClaim(ctx context.Context, gh *ghclient.Client, org string, claim *v1alpha1.OIDCSubjectClaim) error {
+	_, err := gh.Actions.SetOrgOIDCSubjectClaimCustomTemplate(ctx, org, &github.OIDCSubjectClaimCustomTemplate{IncludeClaimKeys: desiredOIDCClaimKeys(claim)})
+	return errors.Wrap(err, errSetOIDCSubjectClaim)
+}
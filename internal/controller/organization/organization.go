@@ -21,6 +21,7 @@ import (
 	"reflect"
 	"slices"
 	"sort"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -32,17 +33,27 @@ import (
 	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/provider-github/internal/backoff"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
 	"github.com/crossplane/provider-github/internal/util"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
 	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
 
 	"github.com/google/go-github/v62/github"
 )
@@ -65,29 +76,38 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind),
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{
 			kube:        mgr.GetClient(),
 			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
 			newClientFn: ghclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...))
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind), opts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.Organization{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.Organization{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.OrganizationList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.OrganizationGroupVersionKind), r), o.GlobalRateLimiter))
 }
 
 type connector struct {
 	kube        client.Client
 	usage       resource.Tracker
-	newClientFn func(string) (*ghclient.Client, error)
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
 }
 
 // Initializes external client
@@ -112,12 +132,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	gh, err := c.newClientFn(string(data))
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{github: gh}, nil
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.OrganizationKind, &external{github: gh}))))), nil
 }
 
 type external struct {
@@ -147,54 +172,177 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
+	setObservation(cr, org)
+	lateInitialized := lateInitialize(&cr.Spec.ForProvider, org)
+
+	skipped := &permissions.Skipped{}
+	defer func() { cr.SetConditions(skipped.Condition()) }()
+
+	switch {
+	case cr.Spec.ForProvider.Billing == nil:
+		cr.Status.AtProvider.Billing = nil
+	case billingRefreshDue(cr, time.Now()):
+		// The last billing observed is kept if it can't be read anymore.
+		billing, err := getBilling(ctx, c.github, name, time.Now())
+		skip, err := skipped.Skip("billing", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			cr.Status.AtProvider.Billing = billing
+		}
+	}
+
 	notUpToDate := managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: false,
+		ResourceExists:          true,
+		ResourceUpToDate:        false,
+		ResourceLateInitialized: lateInitialized,
+	}
+
+	members, err := countMembers(ctx, c.github, name)
+	skip, err := skipped.Skip("members", err)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !skip {
+		cr.Status.AtProvider.Members = &members
+	}
+
+	switch {
+	case cr.Spec.ForProvider.RepositoryBaseline == nil:
+		cr.Status.AtProvider.RepositoryBaseline = nil
+	case baselineRefreshDue(cr, time.Now()):
+		// The audit only reports on the repositories, it never makes the
+		// organization not up to date.
+		baseline, err := auditRepositories(ctx, c.github, name, cr.Spec.ForProvider.RepositoryBaseline, time.Now())
+		skip, err := skipped.Skip("repository baseline", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			cr.Status.AtProvider.RepositoryBaseline = baseline
+		}
+	}
+
+	// The policy is compared first, the enabled repositories can only be
+	// listed once it is selected.
+	if policy := cr.Spec.ForProvider.Actions.EnabledRepositories; policy != nil {
+		observed, err := observeEnabledRepositories(ctx, c.github, name)
+		skip, err := skipped.Skip("actions enabled repositories policy", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && observed != *policy {
+			return notUpToDate, nil
+		}
 	}
 
 	// To use this function, the organization permission policy for enabled_repositories must be configured to selected, otherwise you get error 409 Conflict
 	if cr.Spec.ForProvider.Actions.EnabledRepos != nil {
-		aResp, _, err := c.github.Actions.ListEnabledReposInOrg(ctx, name, &github.ListOptions{PerPage: 100})
-
+		enabled, err := listEnabledRepos(ctx, c.github, name)
+		skip, err := skipped.Skip("actions enabled repositories", err)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
 
-		crARepos := getSortedEnabledReposFromCr(cr.Spec.ForProvider.Actions.EnabledRepos)
-		aRepos := getSortedRepoNames(aResp.Repositories)
+		if !skip {
+			crARepos := getSortedEnabledReposFromCr(cr.Spec.ForProvider.Actions.EnabledRepos)
+			aRepos := getSortedRepoNames(enabled)
+
+			if !reflect.DeepEqual(aRepos, crARepos) {
+				return notUpToDate, nil
+			}
+		}
+	}
 
+	if cr.Spec.ForProvider.Actions.OIDCSubjectClaim != nil {
+		upToDate, err := observeOIDCSubjectClaim(ctx, c.github, name, cr.Spec.ForProvider.Actions.OIDCSubjectClaim)
+		skip, err := skipped.Skip("OIDC subject claim", err)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
-		if !reflect.DeepEqual(aRepos, crARepos) {
+		if !skip && !upToDate {
 			return notUpToDate, nil
 		}
 	}
 
 	if cr.Spec.ForProvider.Secrets != nil {
 		if cr.Spec.ForProvider.Secrets.ActionsSecrets != nil {
-			crActionsSecretsToConfig, err := getOrgSecretsMapFromCr(ctx, c.github, name, cr.Spec.ForProvider.Secrets.ActionsSecrets)
+			upToDate, err := observeOrgSecrets(ctx, c.github, c.github.Actions, name, cr.Spec.ForProvider.Secrets.ActionsSecrets)
+			skip, err := skipped.Skip("actions secrets", err)
 			if err != nil {
 				return managed.ExternalObservation{}, err
 			}
-			ghActionsSecretsToConfig, err := getOrgSecretsWithConfig(ctx, c.github.Actions, name, cr.Spec.ForProvider.Secrets.ActionsSecrets)
-			if err != nil {
-				return managed.ExternalObservation{}, err
-			}
-			if !cmp.Equal(crActionsSecretsToConfig, ghActionsSecretsToConfig) {
+			if !skip && !upToDate {
 				return notUpToDate, nil
 			}
 		}
 		if cr.Spec.ForProvider.Secrets.DependabotSecrets != nil {
-			crDependabotSecretsToConfig, err := getOrgSecretsMapFromCr(ctx, c.github, name, cr.Spec.ForProvider.Secrets.DependabotSecrets)
+			upToDate, err := observeOrgSecrets(ctx, c.github, c.github.Dependabot, name, cr.Spec.ForProvider.Secrets.DependabotSecrets)
+			skip, err := skipped.Skip("dependabot secrets", err)
 			if err != nil {
 				return managed.ExternalObservation{}, err
 			}
-			ghDependabotSecretsToConfig, err := getOrgSecretsWithConfig(ctx, c.github.Dependabot, name, cr.Spec.ForProvider.Secrets.DependabotSecrets)
+			if !skip && !upToDate {
+				return notUpToDate, nil
+			}
+		}
+		if cr.Spec.ForProvider.Secrets.CodespacesSecrets != nil {
+			upToDate, err := observeOrgSecrets(ctx, c.github, c.github.Codespaces, name, cr.Spec.ForProvider.Secrets.CodespacesSecrets)
+			skip, err := skipped.Skip("codespaces secrets", err)
 			if err != nil {
 				return managed.ExternalObservation{}, err
 			}
-			if !cmp.Equal(crDependabotSecretsToConfig, ghDependabotSecretsToConfig) {
+			if !skip && !upToDate {
+				return notUpToDate, nil
+			}
+		}
+	}
+
+	if len(cr.Spec.ForProvider.CustomProperties) > 0 {
+		upToDate, err := observeCustomProperties(ctx, c.github, name, cr.Spec.ForProvider.CustomProperties)
+		skip, err := skipped.Skip("custom properties", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && !upToDate {
+			return notUpToDate, nil
+		}
+	}
+
+	if cr.Spec.ForProvider.SecurityManagerTeams != nil {
+		add, remove, err := getSecurityManagerChanges(ctx, c.github, name, cr.Spec.ForProvider.SecurityManagerTeams)
+		skip, err := skipped.Skip("security manager teams", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && len(add)+len(remove) > 0 {
+			return notUpToDate, nil
+		}
+	}
+
+	if len(cr.Spec.ForProvider.DependabotAlertDismissalRules) > 0 {
+		dismissible, err := getDismissibleAlerts(ctx, c.github, name, cr.Spec.ForProvider.DependabotAlertDismissalRules)
+		skip, err := skipped.Skip("dependabot alerts", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && len(dismissible) > 0 {
+			return notUpToDate, nil
+		}
+	}
+
+	if len(cr.Spec.ForProvider.BlockedUsers) == 0 {
+		cr.Status.AtProvider.BlockedUsers = nil
+	} else {
+		blocked, err := getBlockedUsers(ctx, c.github, name)
+		skip, err := skipped.Skip("blocked users", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			cr.Status.AtProvider.BlockedUsers = blocked
+			if len(getMissingBlocks(cr.Spec.ForProvider.BlockedUsers, blocked)) > 0 {
 				return notUpToDate, nil
 			}
 		}
@@ -204,11 +352,18 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return notUpToDate, nil
 	}
 
+	for _, f := range profileFields(&cr.Spec.ForProvider, org) {
+		if *f.desired != nil && **f.desired != pointer.StringDeref(f.observed, "") {
+			return notUpToDate, nil
+		}
+	}
+
 	cr.SetConditions(xpv1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
 	}, nil
 }
 
@@ -230,22 +385,39 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 
 	name := meta.GetExternalName(cr)
 	gh := c.github
-	req := &github.Organization{
-		Description: &cr.Spec.ForProvider.Description,
-	}
-
-	_, _, err := gh.Organizations.Edit(ctx, name, req)
+	_, _, err := gh.Organizations.Edit(ctx, name, editRequest(cr.Spec.ForProvider))
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	missingReposIds, toDeleteReposIds, err := getMissingAndToDeleteRepos(ctx, gh, name, cr)
-	if err != nil {
-		return managed.ExternalUpdate{}, err
+	skipped := &permissions.Skipped{}
+	defer func() { cr.SetConditions(skipped.Condition()) }()
+
+	// The policy is set first, so that the repositories can be enabled once
+	// it changed to selected.
+	if policy := cr.Spec.ForProvider.Actions.EnabledRepositories; policy != nil {
+		observed, err := observeEnabledRepositories(ctx, gh, name)
+		if err == nil && observed != *policy {
+			err = updateEnabledRepositories(ctx, gh, name, *policy)
+		}
+		if _, err := skipped.Skip("actions enabled repositories policy", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 	}
+
 	if cr.Spec.ForProvider.Actions.EnabledRepos != nil {
-		err = updateRepos(ctx, gh, name, missingReposIds, toDeleteReposIds)
-		if err != nil {
+		missingReposIds, toDeleteReposIds, err := getMissingAndToDeleteRepos(ctx, gh, name, cr)
+		if err == nil {
+			err = updateRepos(ctx, gh, name, missingReposIds, toDeleteReposIds)
+		}
+		if _, err := skipped.Skip("actions enabled repositories", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.Actions.OIDCSubjectClaim != nil {
+		err = updateOIDCSubjectClaim(ctx, gh, name, cr.Spec.ForProvider.Actions.OIDCSubjectClaim)
+		if _, err := skipped.Skip("OIDC subject claim", err); err != nil {
 			return managed.ExternalUpdate{}, err
 		}
 	}
@@ -254,18 +426,52 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if secrets != nil {
 		if secrets.ActionsSecrets != nil {
 			err = updateOrgSecrets(ctx, gh, name, cr.Spec.ForProvider.Secrets.ActionsSecrets, &ActionsSecretSetter{client: gh})
-			if err != nil {
+			if _, err := skipped.Skip("actions secrets", err); err != nil {
 				return managed.ExternalUpdate{}, err
 			}
 		}
 		if secrets.DependabotSecrets != nil {
 			err = updateOrgSecrets(ctx, gh, name, cr.Spec.ForProvider.Secrets.DependabotSecrets, &DependabotSecretSetter{client: gh})
-			if err != nil {
+			if _, err := skipped.Skip("dependabot secrets", err); err != nil {
+				return managed.ExternalUpdate{}, err
+			}
+		}
+		if secrets.CodespacesSecrets != nil {
+			err = updateOrgSecrets(ctx, gh, name, cr.Spec.ForProvider.Secrets.CodespacesSecrets, &CodespacesSecretSetter{client: gh})
+			if _, err := skipped.Skip("codespaces secrets", err); err != nil {
 				return managed.ExternalUpdate{}, err
 			}
 		}
 	}
 
+	if len(cr.Spec.ForProvider.CustomProperties) > 0 {
+		err = updateCustomProperties(ctx, gh, name, cr.Spec.ForProvider.CustomProperties)
+		if _, err := skipped.Skip("custom properties", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.SecurityManagerTeams != nil {
+		err = updateSecurityManagerTeams(ctx, gh, name, cr.Spec.ForProvider.SecurityManagerTeams)
+		if _, err := skipped.Skip("security manager teams", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if len(cr.Spec.ForProvider.DependabotAlertDismissalRules) > 0 {
+		err = dismissAlerts(ctx, gh, name, cr.Spec.ForProvider.DependabotAlertDismissalRules)
+		if _, err := skipped.Skip("dependabot alerts", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if len(cr.Spec.ForProvider.BlockedUsers) > 0 {
+		err = updateBlockedUsers(ctx, gh, name, cr.Spec.ForProvider.BlockedUsers)
+		if _, err := skipped.Skip("blocked users", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	return managed.ExternalUpdate{}, nil
 }
 
@@ -279,6 +485,88 @@ func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	return nil
 }
 
+// profileField is a field of the profile of an organization.
+type profileField struct {
+	desired  **string
+	observed *string
+}
+
+// profileFields returns the profile fields of the parameters of an
+// organization with their observed values, nil if GitHub reports none.
+func profileFields(p *v1alpha1.OrganizationParameters, org *github.Organization) []profileField {
+	return []profileField{
+		{desired: &p.Name, observed: org.Name},
+		{desired: &p.Company, observed: org.Company},
+		{desired: &p.BillingEmail, observed: org.BillingEmail},
+		{desired: &p.Blog, observed: org.Blog},
+		{desired: &p.Location, observed: org.Location},
+		{desired: &p.Email, observed: org.Email},
+		{desired: &p.TwitterUsername, observed: org.TwitterUsername},
+	}
+}
+
+// editRequest returns the edit of an organization to p. Only the profile
+// fields set in p are sent, and the billing email only if it isn't empty,
+// since GitHub requires one.
+func editRequest(p v1alpha1.OrganizationParameters) *github.Organization {
+	req := &github.Organization{
+		Description:     &p.Description,
+		Name:            p.Name,
+		Company:         p.Company,
+		Blog:            p.Blog,
+		Location:        p.Location,
+		Email:           p.Email,
+		TwitterUsername: p.TwitterUsername,
+	}
+	if pointer.StringDeref(p.BillingEmail, "") != "" {
+		req.BillingEmail = p.BillingEmail
+	}
+	return req
+}
+
+// lateInitialize fills the unset profile fields with the current profile of
+// the organization, so that they aren't cleared. Fields GitHub reports no
+// value for are left unset. It returns whether any field was set.
+func lateInitialize(p *v1alpha1.OrganizationParameters, org *github.Organization) bool {
+	li := false
+	for _, f := range profileFields(p, org) {
+		if *f.desired == nil && pointer.StringDeref(f.observed, "") != "" {
+			*f.desired = pointer.String(*f.observed)
+			li = true
+		}
+	}
+	return li
+}
+
+// setObservation records the observed values of an organization in its status.
+func setObservation(cr *v1alpha1.Organization, org *github.Organization) {
+	o := &cr.Status.AtProvider
+	o.Description = org.GetDescription()
+	o.NodeID = org.GetNodeID()
+	o.Plan = org.GetPlan().GetName()
+	o.Seats, o.FilledSeats = nil, nil
+	if plan := org.GetPlan(); plan != nil {
+		o.Seats = plan.Seats
+		o.FilledSeats = plan.FilledSeats
+	}
+	o.OutsideCollaborators = org.Collaborators
+	o.TwoFactorRequirementEnabled = org.TwoFactorRequirementEnabled
+	o.DefaultRepositoryPermission = org.GetDefaultRepoPermission()
+}
+
+// countMembers returns the number of members of an organization. It lists a
+// single member per page, so that the number of pages is the number of members.
+func countMembers(ctx context.Context, gh *ghclient.Client, org string) (int, error) {
+	users, resp, err := gh.Organizations.ListMembers(ctx, org, &github.ListMembersOptions{ListOptions: github.ListOptions{PerPage: 1}})
+	if err != nil {
+		return 0, err
+	}
+	if resp != nil && resp.LastPage > 0 {
+		return resp.LastPage, nil
+	}
+	return len(users), nil
+}
+
 func getSortedEnabledReposFromCr(repos []v1alpha1.ActionEnabledRepo) []string {
 	crAEnabledRepos := make([]string, 0, len(repos))
 	for _, repo := range repos {
@@ -317,17 +605,29 @@ func getUpdateRepoIds(ctx context.Context, gh *ghclient.Client, org string, crRe
 	return reposIds, nil
 }
 
+// listEnabledRepos lists the repositories of the organization that are enabled
+// for GitHub Actions.
+func listEnabledRepos(ctx context.Context, gh *ghclient.Client, name string) ([]*github.Repository, error) {
+	return ghclient.ListAll(func(page int) ([]*github.Repository, *github.Response, error) {
+		enabled, resp, err := gh.Actions.ListEnabledReposInOrg(ctx, name, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+		if err != nil {
+			return nil, resp, err
+		}
+		return enabled.Repositories, resp, nil
+	})
+}
+
 func getMissingAndToDeleteRepos(ctx context.Context, gh *ghclient.Client, name string, cr *v1alpha1.Organization) ([]int64, []int64, error) {
 	crARepos := getSortedEnabledReposFromCr(cr.Spec.ForProvider.Actions.EnabledRepos)
 
 	// To use this function, the organization permission policy for enabled_repositories must be configured to selected, otherwise you get error 409 Conflict
-	aResp, _, err := gh.Actions.ListEnabledReposInOrg(ctx, name, &github.ListOptions{PerPage: 100})
+	enabled, err := listEnabledRepos(ctx, gh, name)
 	if err != nil {
 		return nil, nil, err
 	}
 
 	// Extract repository names from the list
-	aRepos := getSortedRepoNames(aResp.Repositories)
+	aRepos := getSortedRepoNames(enabled)
 
 	missingReposIds, err := getUpdateRepoIds(ctx, gh, name, crARepos, aRepos)
 	if err != nil {
@@ -364,6 +664,20 @@ func updateRepos(ctx context.Context, gh *ghclient.Client, name string, missingR
 	return nil
 }
 
+// observeOrgSecrets returns whether the repository access lists of the
+// organization secrets read through c are up to date.
+func observeOrgSecrets(ctx context.Context, gh *ghclient.Client, c OrgSecretGetter, org string, secrets []v1alpha1.OrgSecret) (bool, error) {
+	crOrgSecretsToConfig, err := getOrgSecretsMapFromCr(ctx, gh, org, secrets)
+	if err != nil {
+		return false, err
+	}
+	ghOrgSecretsToConfig, err := getOrgSecretsWithConfig(ctx, c, org, secrets)
+	if err != nil {
+		return false, err
+	}
+	return cmp.Equal(crOrgSecretsToConfig, ghOrgSecretsToConfig), nil
+}
+
 func getOrgSecretsMapFromCr(ctx context.Context, gh *ghclient.Client, org string, secrets []v1alpha1.OrgSecret) (map[string][]int64, error) {
 	crOrgSecretsToConfig := make(map[string][]int64, len(secrets))
 	for _, secret := range secrets {
@@ -397,19 +711,18 @@ func getOrgSecretsWithConfig(ctx context.Context, c OrgSecretGetter, owner strin
 		}
 		repoIds := make([]int64, 0)
 		if ghSecret != nil && ghSecret.Visibility == "selected" {
-			opts := &github.ListOptions{PerPage: 100}
-			for {
-				ghRepo, resp, err := c.ListSelectedReposForOrgSecret(ctx, owner, secret.Name, opts)
+			selected, err := ghclient.ListAll(func(page int) ([]*github.Repository, *github.Response, error) {
+				list, resp, err := c.ListSelectedReposForOrgSecret(ctx, owner, secret.Name, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
 				if err != nil {
-					return nil, err
+					return nil, resp, err
 				}
-				for _, selectedRepo := range ghRepo.Repositories {
-					repoIds = append(repoIds, selectedRepo.GetID())
-				}
-				if resp.NextPage == 0 {
-					break
-				}
-				opts.Page = resp.NextPage
+				return list.Repositories, resp, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+			for _, selectedRepo := range selected {
+				repoIds = append(repoIds, selectedRepo.GetID())
 			}
 			sort.Slice(repoIds, func(i, j int) bool {
 				return repoIds[i] < repoIds[j]
@@ -432,6 +745,10 @@ type DependabotSecretSetter struct {
 	client *ghclient.Client
 }
 
+type CodespacesSecretSetter struct {
+	client *ghclient.Client
+}
+
 func (a *ActionsSecretSetter) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids []int64) error {
 	_, err := a.client.Actions.SetSelectedReposForOrgSecret(ctx, org, name, ids)
 	if err != nil {
@@ -448,6 +765,14 @@ func (d *DependabotSecretSetter) SetSelectedReposForOrgSecret(ctx context.Contex
 	return nil
 }
 
+func (c *CodespacesSecretSetter) SetSelectedReposForOrgSecret(ctx context.Context, org string, name string, ids []int64) error {
+	_, err := c.client.Codespaces.SetSelectedReposForOrgSecret(ctx, org, name, ids)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func updateOrgSecrets(ctx context.Context, gh *ghclient.Client, owner string, secrets []v1alpha1.OrgSecret, setter OrgSecretSetter) error {
 	for _, secret := range secrets {
 		repoIds := make([]int64, 0, len(secret.RepositoryAccessList))
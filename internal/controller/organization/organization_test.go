@@ -18,13 +18,18 @@ package organization
 
 import (
 	"context"
+	"net/http"
 	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
 	"github.com/crossplane/provider-github/internal/clients/fake"
 	"github.com/google/go-cmp/cmp"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -44,6 +49,7 @@ var (
 	org              = "test-org"
 	description      = "test description"
 	otherDescription = "other description"
+	billingEmail     = "billing@example.org"
 	repo             = "test-repo"
 	repo2            = "test-repo2"
 	orgSecret1       = "org-secret1"
@@ -59,10 +65,48 @@ func withDescription() organizationModifier {
 	}
 }
 
+func withBillingEmail(email string) organizationModifier {
+	return func(r *v1alpha1.Organization) {
+		r.Spec.ForProvider.BillingEmail = &email
+	}
+}
+
+func withEnabledRepositories(policy string) organizationModifier {
+	return func(r *v1alpha1.Organization) {
+		r.Spec.ForProvider.Actions.EnabledRepositories = &policy
+	}
+}
+
+func withBilling() organizationModifier {
+	return func(r *v1alpha1.Organization) {
+		r.Spec.ForProvider.Billing = &v1alpha1.BillingConfiguration{}
+	}
+}
+
+func withCodespacesSecret() organizationModifier {
+	return func(r *v1alpha1.Organization) {
+		r.Spec.ForProvider.Secrets.CodespacesSecrets = []v1alpha1.OrgSecret{
+			{
+				Name: orgSecret1,
+				RepositoryAccessList: []v1alpha1.SecretSelectedRepo{
+					{
+						Repo: orgSecretRepo1,
+					},
+				},
+			},
+		}
+	}
+}
+
 func organization(repos []string, m ...organizationModifier) *v1alpha1.Organization {
 	cr := &v1alpha1.Organization{}
 
 	cr.Spec.ForProvider.Description = description
+	cr.Spec.ForProvider.Name = &org
+	cr.Spec.ForProvider.BillingEmail = &billingEmail
+	for _, f := range []**string{&cr.Spec.ForProvider.Company, &cr.Spec.ForProvider.Blog, &cr.Spec.ForProvider.Location, &cr.Spec.ForProvider.Email, &cr.Spec.ForProvider.TwitterUsername} {
+		*f = github.String("")
+	}
 	cr.Spec.ForProvider.Actions = v1alpha1.ActionsConfiguration{
 		EnabledRepos: make([]v1alpha1.ActionEnabledRepo, len(repos)),
 	}
@@ -105,11 +149,16 @@ func organization(repos []string, m ...organizationModifier) *v1alpha1.Organizat
 
 func githubOrganization() *github.Organization {
 	return &github.Organization{
-		Description: &description,
-		Name:        &org,
+		Description:  &description,
+		Name:         &org,
+		BillingEmail: &billingEmail,
 	}
 }
 
+func githubMembers(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+	return []*github.User{{Login: github.String("test-user")}}, &github.Response{LastPage: 12}, nil
+}
+
 func githubOrgRepoActions() *github.ActionsEnabledOnOrgRepos {
 	repos := []*github.Repository{
 		{Name: &repo},
@@ -145,6 +194,17 @@ func githubOrgSecretRepo() *github.Repository {
 	}
 }
 
+func githubBilling() *fake.MockBillingClient {
+	return &fake.MockBillingClient{
+		MockGetActionsBillingOrg: func(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error) {
+			return &github.ActionBilling{TotalMinutesUsed: 1200, IncludedMinutes: 3000}, nil, nil
+		},
+		MockGetStorageBillingOrg: func(ctx context.Context, org string) (*github.StorageBilling, *github.Response, error) {
+			return &github.StorageBilling{EstimatedStorageForMonth: 1.5, DaysLeftInBillingCycle: 12}, nil, nil
+		},
+	}
+}
+
 func TestObserve(t *testing.T) {
 	type fields struct {
 		github *ghclient.Client
@@ -156,10 +216,17 @@ func TestObserve(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalObservation
-		err error
+		o           managed.ExternalObservation
+		permissions *xpv1.Condition
+		billing     *v1alpha1.BillingObservation
+		err         error
 	}
 
+	missingDependabotPermissions := v1alpha1.PermissionsMissing("skipped dependabot secrets: missing token scopes or App permissions")
+	missingBillingPermissions := v1alpha1.PermissionsMissing("skipped billing: missing token scopes or App permissions")
+	lastRefresh := metav1.NewTime(time.Now().Add(-48 * time.Hour))
+	lastBilling := &v1alpha1.BillingObservation{RefreshTime: &lastRefresh, ActionsMinutesUsed: 600}
+
 	cases := map[string]struct {
 		reason string
 		fields fields
@@ -173,6 +240,7 @@ func TestObserve(t *testing.T) {
 						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
 							return githubOrganization(), nil, nil
 						},
+						MockListMembers: githubMembers,
 					},
 					Actions: &fake.MockActionsClient{
 						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
@@ -218,6 +286,7 @@ func TestObserve(t *testing.T) {
 						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
 							return githubOrganization(), nil, nil
 						},
+						MockListMembers: githubMembers,
 					},
 					Actions: &fake.MockActionsClient{
 						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
@@ -256,6 +325,285 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
+		"BillingEmailDiffers": {
+			reason: "An organization whose billing email differs from the spec should not be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+							return githubOrgRepoActions(), nil, nil
+						},
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Dependabot: &fake.MockDependabotClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubOrgSecretRepo(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}, withBillingEmail("finance@example.org")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				err: nil,
+			},
+		},
+		"EnabledRepositoriesPolicyDiffers": {
+			reason: "An organization whose Actions are enabled for another policy of repositories should not be up to date, without listing the enabled repositories.",
+			fields: fields{
+				github: &ghclient.Client{
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockGetActionsPermissions: func(ctx context.Context, org string) (*github.ActionsPermissions, *github.Response, error) {
+							return &github.ActionsPermissions{EnabledRepositories: github.String("all")}, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}, withEnabledRepositories("selected")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UpToDateMissingDependabotPermissions": {
+			reason: "Dependabot secrets the credentials aren't allowed to read should be skipped instead of failing the observation.",
+			fields: fields{
+				github: &ghclient.Client{
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+							return githubOrgRepoActions(), nil, nil
+						},
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Dependabot: &fake.MockDependabotClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return nil, nil, &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}, Message: "Resource not accessible by integration"}
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubOrgSecretRepo(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				permissions: &missingDependabotPermissions,
+				err:         nil,
+			},
+		},
+		"NotUpToDateCodespacesSecrets": {
+			reason: "A Codespaces secret that isn't accessible by all listed repositories should not be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+							return githubOrgRepoActions(), nil, nil
+						},
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Dependabot: &fake.MockDependabotClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Codespaces: &fake.MockCodespacesClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return &github.SelectedReposList{}, fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubOrgSecretRepo(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}, withCodespacesSecret()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
+		"UpToDateWithBilling": {
+			reason: "Observing the billing of an organization should not affect whether it is up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					Billing: githubBilling(),
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+							return githubOrgRepoActions(), nil, nil
+						},
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Dependabot: &fake.MockDependabotClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubOrgSecretRepo(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}, withBilling()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDateMissingBillingPermissions": {
+			reason: "Billing the credentials aren't allowed to read should be skipped, keeping the billing observed last.",
+			fields: fields{
+				github: &ghclient.Client{
+					Billing: &fake.MockBillingClient{
+						MockGetActionsBillingOrg: func(ctx context.Context, org string) (*github.ActionBilling, *github.Response, error) {
+							return nil, nil, &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}, Message: "Resource not accessible by integration"}
+						},
+					},
+					Organizations: &fake.MockOrganizationsClient{
+						MockGet: func(ctx context.Context, org string) (*github.Organization, *github.Response, error) {
+							return githubOrganization(), nil, nil
+						},
+						MockListMembers: githubMembers,
+					},
+					Actions: &fake.MockActionsClient{
+						MockListEnabledReposInOrg: func(ctx context.Context, owner string, opts *github.ListOptions) (*github.ActionsEnabledOnOrgRepos, *github.Response, error) {
+							return githubOrgRepoActions(), nil, nil
+						},
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Dependabot: &fake.MockDependabotClient{
+						MockGetOrgSecret: func(ctx context.Context, org, name string) (*github.Secret, *github.Response, error) {
+							return githubOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListSelectedReposForOrgSecret: func(ctx context.Context, org, name string, opts *github.ListOptions) (*github.SelectedReposList, *github.Response, error) {
+							return githubSelectedReposForOrgSecret(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubOrgSecretRepo(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organization([]string{repo, repo2}, withBilling(), func(r *v1alpha1.Organization) {
+					r.Status.AtProvider.Billing = lastBilling
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				permissions: &missingBillingPermissions,
+				billing:     lastBilling,
+			},
+		},
 		"DoesNotExists": {
 			fields: fields{
 				github: &ghclient.Client{
@@ -313,6 +661,569 @@ func TestObserve(t *testing.T) {
 			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			if tc.want.permissions != nil {
+				if got := tc.args.mg.GetCondition(v1alpha1.TypePermissionsSufficient); !got.Equal(*tc.want.permissions) {
+					t.Errorf("\n%s\ne.Observe(...): want permissions condition %v, got %v\n", tc.reason, *tc.want.permissions, got)
+				}
+			}
+			if tc.want.billing != nil {
+				if diff := cmp.Diff(tc.want.billing, tc.args.mg.(*v1alpha1.Organization).Status.AtProvider.Billing); diff != "" {
+					t.Errorf("\n%s\ne.Observe(...): -want billing, +got billing:\n%s\n", tc.reason, diff)
+				}
+			}
 		})
 	}
 }
+
+func TestAuditRepositories(t *testing.T) {
+	now := time.Now()
+	refreshTime := metav1.NewTime(now)
+	enabled := &github.SecurityAndAnalysis{SecretScanning: &github.SecretScanning{Status: github.String("enabled")}}
+	repos := map[string]*github.Repository{
+		"compliant": {Name: github.String("compliant"), Topics: []string{"managed"}, DeleteBranchOnMerge: github.Bool(true), SecurityAndAnalysis: enabled},
+		"untopical": {Name: github.String("untopical"), DeleteBranchOnMerge: github.Bool(true), SecurityAndAnalysis: enabled},
+		"insecure":  {Name: github.String("insecure"), Topics: []string{"managed", "other"}},
+		"archived":  {Name: github.String("archived"), Archived: github.Bool(true)},
+	}
+
+	cases := map[string]struct {
+		reason   string
+		baseline v1alpha1.RepositoryBaseline
+		want     *v1alpha1.RepositoryBaselineObservation
+	}{
+		"Topics": {
+			reason:   "Repositories without a required topic should be reported, archived repositories should not be audited.",
+			baseline: v1alpha1.RepositoryBaseline{Topics: []string{"managed"}},
+			want: &v1alpha1.RepositoryBaselineObservation{
+				RefreshTime:  &refreshTime,
+				Repositories: 3,
+				NonCompliant: 1,
+				NonCompliantRepositories: []v1alpha1.NonCompliantRepository{
+					{Name: "untopical", Violations: []string{"topics[managed]"}},
+				},
+			},
+		},
+		"Settings": {
+			reason:   "Repositories whose settings differ from the baseline should be reported with all violations, sorted by name.",
+			baseline: v1alpha1.RepositoryBaseline{Topics: []string{"managed"}, DeleteBranchOnMerge: github.Bool(true), SecretScanning: github.Bool(true)},
+			want: &v1alpha1.RepositoryBaselineObservation{
+				RefreshTime:  &refreshTime,
+				Repositories: 3,
+				NonCompliant: 2,
+				NonCompliantRepositories: []v1alpha1.NonCompliantRepository{
+					{Name: "insecure", Violations: []string{"deleteBranchOnMerge", "secretScanning"}},
+					{Name: "untopical", Violations: []string{"topics[managed]"}},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockListByOrg: func(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+						// The list of repositories lacks their settings.
+						return []*github.Repository{
+							{Name: github.String("compliant"), Topics: []string{"managed"}},
+							{Name: github.String("untopical")},
+							{Name: github.String("insecure"), Topics: []string{"managed", "other"}},
+							{Name: github.String("archived"), Archived: github.Bool(true)},
+						}, fake.GenerateEmptyResponse(), nil
+					},
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return repos[repo], fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}
+			got, err := auditRepositories(context.Background(), gh, org, &tc.baseline, now)
+			if err != nil {
+				t.Fatalf("\n%s\nauditRepositories(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nauditRepositories(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateBlockedUsers(t *testing.T) {
+	cases := map[string]struct {
+		reason  string
+		blocked []string
+		users   []string
+		want    []string
+	}{
+		"BlocksMissing": {
+			reason:  "Users of the spec that aren't blocked should be blocked, logins are case-insensitive.",
+			blocked: []string{"spammer"},
+			users:   []string{"Spammer", "troll"},
+			want:    []string{"troll"},
+		},
+		"KeepsUnlisted": {
+			reason:  "Users blocked on GitHub but not listed in the spec should not be unblocked.",
+			blocked: []string{"spammer", "troll"},
+			users:   []string{"troll"},
+			want:    nil,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			gh := &ghclient.Client{
+				Organizations: &fake.MockOrganizationsClient{
+					MockListBlockedUsers: func(ctx context.Context, org string, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+						users := make([]*github.User, 0, len(tc.blocked))
+						for _, u := range tc.blocked {
+							users = append(users, &github.User{Login: github.String(u)})
+						}
+						return users, fake.GenerateEmptyResponse(), nil
+					},
+					MockBlockUser: func(ctx context.Context, org, user string) (*github.Response, error) {
+						got = append(got, user)
+						return fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}
+			if err := updateBlockedUsers(context.Background(), gh, org, tc.users); err != nil {
+				t.Fatalf("\n%s\nupdateBlockedUsers(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nupdateBlockedUsers(...): -want blocked, +got blocked:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveOIDCSubjectClaim(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		claim  *v1alpha1.OIDCSubjectClaim
+		keys   []string
+		want   bool
+	}{
+		"Default": {
+			reason: "The default template should be the subject claim of GitHub.",
+			claim:  &v1alpha1.OIDCSubjectClaim{UseDefault: true},
+			keys:   []string{"repo", "context"},
+			want:   true,
+		},
+		"Customized": {
+			reason: "A customized template should differ from the default one.",
+			claim:  &v1alpha1.OIDCSubjectClaim{UseDefault: true},
+			keys:   []string{"repo", "job_workflow_ref"},
+			want:   false,
+		},
+		"UpToDate": {
+			reason: "A template with the claims of the spec should be up to date.",
+			claim:  &v1alpha1.OIDCSubjectClaim{IncludeClaimKeys: []string{"repo", "job_workflow_ref"}},
+			keys:   []string{"repo", "job_workflow_ref"},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockGetOrgOIDCSubjectClaimCustomTemplate: func(ctx context.Context, org string) (*github.OIDCSubjectClaimCustomTemplate, *github.Response, error) {
+						return &github.OIDCSubjectClaimCustomTemplate{IncludeClaimKeys: tc.keys}, fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}
+			got, err := observeOIDCSubjectClaim(context.Background(), gh, org, tc.claim)
+			if err != nil {
+				t.Fatalf("\n%s\nobserveOIDCSubjectClaim(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\nobserveOIDCSubjectClaim(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateSecurityManagerTeams(t *testing.T) {
+	cases := map[string]struct {
+		reason   string
+		managers []string
+		teams    []v1alpha1.SecurityManagerTeam
+		added    []string
+		removed  []string
+	}{
+		"AddsAndRemoves": {
+			reason:   "Teams of the spec should be added by their slug, and unlisted teams removed.",
+			managers: []string{"security", "former-auditors"},
+			teams:    []v1alpha1.SecurityManagerTeam{{Team: "security"}, {Team: "AppSec Champions"}},
+			added:    []string{"appsec-champions"},
+			removed:  []string{"former-auditors"},
+		},
+		"UpToDate": {
+			reason:   "Nothing should change if the teams with the role are the ones of the spec.",
+			managers: []string{"security"},
+			teams:    []v1alpha1.SecurityManagerTeam{{Team: "security"}, {Team: "Security"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added, removed []string
+			gh := &ghclient.Client{
+				Organizations: &fake.MockOrganizationsClient{
+					MockListSecurityManagerTeams: func(ctx context.Context, org string) ([]*github.Team, *github.Response, error) {
+						teams := make([]*github.Team, 0, len(tc.managers))
+						for _, s := range tc.managers {
+							teams = append(teams, &github.Team{Slug: github.String(s)})
+						}
+						return teams, fake.GenerateEmptyResponse(), nil
+					},
+					MockAddSecurityManagerTeam: func(ctx context.Context, org, team string) (*github.Response, error) {
+						added = append(added, team)
+						return fake.GenerateEmptyResponse(), nil
+					},
+					MockRemoveSecurityManagerTeam: func(ctx context.Context, org, team string) (*github.Response, error) {
+						removed = append(removed, team)
+						return fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}
+			if err := updateSecurityManagerTeams(context.Background(), gh, org, tc.teams); err != nil {
+				t.Fatalf("\n%s\nupdateSecurityManagerTeams(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.added, added); diff != "" {
+				t.Errorf("\n%s\nupdateSecurityManagerTeams(...): -want added, +got added:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.removed, removed); diff != "" {
+				t.Errorf("\n%s\nupdateSecurityManagerTeams(...): -want removed, +got removed:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDismissAlerts(t *testing.T) {
+	alert := func(number int, scope, severity, ecosystem, pkg string) *github.DependabotAlert {
+		return &github.DependabotAlert{
+			Number:                github.Int(number),
+			Dependency:            &github.Dependency{Scope: github.String(scope), Package: &github.VulnerabilityPackage{Ecosystem: github.String(ecosystem), Name: github.String(pkg)}},
+			SecurityVulnerability: &github.AdvisoryVulnerability{Severity: github.String(severity)},
+			Repository:            &github.Repository{Name: github.String("web")},
+		}
+	}
+	devLow := v1alpha1.DependabotAlertDismissalRule{Name: "dev-low", Scope: github.String("development"), Severities: []string{"low", "medium"}, DismissedReason: "tolerable_risk"}
+	eslint := v1alpha1.DependabotAlertDismissalRule{Name: "eslint", Ecosystems: []string{"NPM"}, Packages: []string{"eslint"}, DismissedReason: "not_used"}
+
+	cases := map[string]struct {
+		reason string
+		rules  []v1alpha1.DependabotAlertDismissalRule
+		alerts []*github.DependabotAlert
+		want   map[int]string
+	}{
+		"DismissesMatching": {
+			reason: "Alerts should be dismissed with the reason of the first rule they match.",
+			rules:  []v1alpha1.DependabotAlertDismissalRule{devLow, eslint},
+			alerts: []*github.DependabotAlert{
+				alert(1, "development", "low", "npm", "eslint"),
+				alert(2, "runtime", "low", "npm", "eslint"),
+				alert(3, "development", "critical", "pip", "django"),
+			},
+			want: map[int]string{1: "tolerable_risk", 2: "not_used"},
+		},
+		"KeepsUnmatched": {
+			reason: "Alerts that match no rule should be left open.",
+			rules:  []v1alpha1.DependabotAlertDismissalRule{devLow},
+			alerts: []*github.DependabotAlert{alert(1, "runtime", "low", "npm", "eslint")},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got map[int]string
+			gh := &ghclient.Client{
+				Dependabot: &fake.MockDependabotClient{
+					MockListOrgAlerts: func(ctx context.Context, org string, opts *github.ListAlertsOptions) ([]*github.DependabotAlert, *github.Response, error) {
+						return tc.alerts, fake.GenerateEmptyResponse(), nil
+					},
+					MockUpdateAlert: func(ctx context.Context, owner, repo string, number int, stateInfo *github.DependabotAlertState) (*github.DependabotAlert, *github.Response, error) {
+						if got == nil {
+							got = map[int]string{}
+						}
+						got[number] = stateInfo.GetDismissedReason()
+						return nil, fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}
+			if err := dismissAlerts(context.Background(), gh, org, tc.rules); err != nil {
+				t.Fatalf("\n%s\ndismissAlerts(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ndismissAlerts(...): -want dismissed, +got dismissed:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestBillingRefreshDue(t *testing.T) {
+	now := time.Now()
+	recent := metav1.NewTime(now.Add(-10 * time.Minute))
+	old := metav1.NewTime(now.Add(-2 * time.Hour))
+
+	cases := map[string]struct {
+		reason   string
+		interval *metav1.Duration
+		refresh  *metav1.Time
+		want     bool
+	}{
+		"NeverRefreshed": {
+			reason: "Billing that was never observed should be refreshed.",
+			want:   true,
+		},
+		"RecentlyRefreshed": {
+			reason:  "Billing refreshed within the default interval should not be refreshed.",
+			refresh: &recent,
+			want:    false,
+		},
+		"Expired": {
+			reason:  "Billing refreshed longer ago than the default interval should be refreshed.",
+			refresh: &old,
+			want:    true,
+		},
+		"ShortInterval": {
+			reason:   "Billing refreshed longer ago than a configured interval should be refreshed.",
+			interval: &metav1.Duration{Duration: 5 * time.Minute},
+			refresh:  &recent,
+			want:     true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := organization(nil, func(o *v1alpha1.Organization) {
+				o.Spec.ForProvider.Billing = &v1alpha1.BillingConfiguration{RefreshInterval: tc.interval}
+				if tc.refresh != nil {
+					o.Status.AtProvider.Billing = &v1alpha1.BillingObservation{RefreshTime: tc.refresh}
+				}
+			})
+			if got := billingRefreshDue(cr, now); got != tc.want {
+				t.Errorf("\n%s\nbillingRefreshDue(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestObserveCustomProperties(t *testing.T) {
+	tier := v1alpha1.CustomPropertyDefinition{
+		Name:          "tier",
+		ValueType:     "single_select",
+		Required:      github.Bool(true),
+		DefaultValue:  github.String("bronze"),
+		AllowedValues: []string{"gold", "silver", "bronze"},
+	}
+	ghTier := &github.CustomProperty{
+		PropertyName:  github.String("tier"),
+		ValueType:     "single_select",
+		Required:      github.Bool(true),
+		DefaultValue:  github.String("bronze"),
+		Description:   github.String("Support tier"),
+		AllowedValues: []string{"gold", "silver", "bronze"},
+	}
+
+	cases := map[string]struct {
+		reason     string
+		properties []v1alpha1.CustomPropertyDefinition
+		ghProps    []*github.CustomProperty
+		want       bool
+	}{
+		"UpToDate": {
+			reason:     "A property with the same settings should be up to date, an unset description is not managed.",
+			properties: []v1alpha1.CustomPropertyDefinition{tier},
+			ghProps:    []*github.CustomProperty{ghTier, {PropertyName: github.String("team"), ValueType: "string"}},
+			want:       true,
+		},
+		"Missing": {
+			reason:     "A property that isn't defined on GitHub should not be up to date.",
+			properties: []v1alpha1.CustomPropertyDefinition{tier},
+			want:       false,
+		},
+		"AllowedValuesReordered": {
+			reason:     "Allowed values in a different order should not be up to date.",
+			properties: []v1alpha1.CustomPropertyDefinition{tier},
+			ghProps: []*github.CustomProperty{{
+				PropertyName:  github.String("tier"),
+				ValueType:     "single_select",
+				Required:      github.Bool(true),
+				DefaultValue:  github.String("bronze"),
+				AllowedValues: []string{"bronze", "silver", "gold"},
+			}},
+			want: false,
+		},
+		"NotRequired": {
+			reason:     "A property that is no longer required should not be up to date.",
+			properties: []v1alpha1.CustomPropertyDefinition{tier},
+			ghProps: []*github.CustomProperty{{
+				PropertyName:  github.String("tier"),
+				ValueType:     "single_select",
+				DefaultValue:  github.String("bronze"),
+				AllowedValues: []string{"gold", "silver", "bronze"},
+			}},
+			want: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Organizations: &fake.MockOrganizationsClient{
+					MockGetAllCustomProperties: func(ctx context.Context, org string) ([]*github.CustomProperty, *github.Response, error) {
+						return tc.ghProps, nil, nil
+					},
+				},
+			}
+			got, err := observeCustomProperties(context.Background(), gh, org, tc.properties)
+			if err != nil {
+				t.Fatalf("\n%s\nobserveCustomProperties(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\nobserveCustomProperties(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	ghOrg := &github.Organization{
+		Name:         &org,
+		Company:      github.String("Example Inc."),
+		BillingEmail: &billingEmail,
+		Blog:         github.String("https://example.org"),
+		Location:     github.String(""),
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.OrganizationParameters
+		want   v1alpha1.OrganizationParameters
+		li     bool
+	}{
+		"Unset": {
+			reason: "Unset profile fields should be initialized from the profile of the organization.",
+			params: v1alpha1.OrganizationParameters{Description: description},
+			want: v1alpha1.OrganizationParameters{
+				Description:  description,
+				Name:         &org,
+				Company:      github.String("Example Inc."),
+				BillingEmail: &billingEmail,
+				Blog:         github.String("https://example.org"),
+			},
+			li: true,
+		},
+		"Unobserved": {
+			reason: "Profile fields GitHub reports no value for should be left unset.",
+			params: v1alpha1.OrganizationParameters{Name: &org, Company: github.String("Example Inc."), BillingEmail: &billingEmail, Blog: github.String("https://example.org")},
+			want:   v1alpha1.OrganizationParameters{Name: &org, Company: github.String("Example Inc."), BillingEmail: &billingEmail, Blog: github.String("https://example.org")},
+			li:     false,
+		},
+		"Set": {
+			reason: "Profile fields that are set should be kept, even if they differ from the profile of the organization.",
+			params: v1alpha1.OrganizationParameters{
+				Name:            github.String("Test Org"),
+				Company:         github.String(""),
+				BillingEmail:    github.String("finance@example.org"),
+				Blog:            github.String(""),
+				Location:        github.String("Berlin"),
+				Email:           github.String(""),
+				TwitterUsername: github.String(""),
+			},
+			want: v1alpha1.OrganizationParameters{
+				Name:            github.String("Test Org"),
+				Company:         github.String(""),
+				BillingEmail:    github.String("finance@example.org"),
+				Blog:            github.String(""),
+				Location:        github.String("Berlin"),
+				Email:           github.String(""),
+				TwitterUsername: github.String(""),
+			},
+			li: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			li := lateInitialize(&tc.params, ghOrg)
+			if li != tc.li {
+				t.Errorf("\n%s\nlateInitialize(...): want %t, got %t\n", tc.reason, tc.li, li)
+			}
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEditRequest(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.OrganizationParameters
+		want   *github.Organization
+	}{
+		"Unset": {
+			reason: "Profile fields that aren't set should not be sent.",
+			params: v1alpha1.OrganizationParameters{Description: description},
+			want:   &github.Organization{Description: &description},
+		},
+		"Set": {
+			reason: "Profile fields that are set should be sent, empty ones to clear them.",
+			params: v1alpha1.OrganizationParameters{
+				Description:  description,
+				Company:      github.String(""),
+				BillingEmail: github.String("finance@example.org"),
+				Location:     github.String("Berlin"),
+			},
+			want: &github.Organization{
+				Description:  &description,
+				Company:      github.String(""),
+				BillingEmail: github.String("finance@example.org"),
+				Location:     github.String("Berlin"),
+			},
+		},
+		"EmptyBillingEmail": {
+			reason: "An empty billing email should not be sent, GitHub requires one.",
+			params: v1alpha1.OrganizationParameters{Description: description, BillingEmail: github.String("")},
+			want:   &github.Organization{Description: &description},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, editRequest(tc.params)); diff != "" {
+				t.Errorf("\n%s\neditRequest(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetObservation(t *testing.T) {
+	ghOrg := &github.Organization{
+		Description:                 &description,
+		NodeID:                      github.String("O_kgDOAbCdEf"),
+		Plan:                        &github.Plan{Name: github.String("team"), Seats: github.Int(50), FilledSeats: github.Int(12)},
+		Collaborators:               github.Int(3),
+		TwoFactorRequirementEnabled: github.Bool(true),
+		DefaultRepoPermission:       github.String("read"),
+	}
+	want := v1alpha1.OrganizationObservation{
+		Description:                 description,
+		NodeID:                      "O_kgDOAbCdEf",
+		Plan:                        "team",
+		Seats:                       github.Int(50),
+		FilledSeats:                 github.Int(12),
+		OutsideCollaborators:        github.Int(3),
+		TwoFactorRequirementEnabled: github.Bool(true),
+		DefaultRepositoryPermission: "read",
+	}
+
+	cr := organization(nil)
+	setObservation(cr, ghOrg)
+	if diff := cmp.Diff(want, cr.Status.AtProvider); diff != "" {
+		t.Errorf("setObservation(...): -want, +got:\n%s", diff)
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organization
+
+import (
+	"context"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListSecurityManagerTeams  = "cannot list security manager teams"
+	errAddSecurityManagerTeam    = "cannot add security manager team %s"
+	errRemoveSecurityManagerTeam = "cannot remove security manager team %s"
+)
+
+// getSecurityManagerChanges returns the slugs of the teams of the spec that
+// lack the security manager role, and of the teams that have it but aren't
+// listed in the spec.
+func getSecurityManagerChanges(ctx context.Context, gh *ghclient.Client, org string, teams []v1alpha1.SecurityManagerTeam) (add, remove []string, err error) {
+	managers, _, err := gh.Organizations.ListSecurityManagerTeams(ctx, org)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, errListSecurityManagerTeams)
+	}
+
+	desired := make(map[string]bool, len(teams))
+	for _, t := range teams {
+		desired[slug.Make(t.Team)] = true
+	}
+	observed := make(map[string]bool, len(managers))
+	for _, t := range managers {
+		observed[t.GetSlug()] = true
+		if !desired[t.GetSlug()] {
+			remove = append(remove, t.GetSlug())
+		}
+	}
+	for _, t := range teams {
+		s := slug.Make(t.Team)
+		if !observed[s] {
+			// A team listed twice is only added once.
+			observed[s] = true
+			add = append(add, s)
+		}
+	}
+	return add, remove, nil
+}
+
+// updateSecurityManagerTeams gives the teams of the spec the security manager
+// role, and takes it from the teams that aren't listed.
+func updateSecurityManagerTeams(ctx context.Context, gh *ghclient.Client, org string, teams []v1alpha1.SecurityManagerTeam) error {
+	add, remove, err := getSecurityManagerChanges(ctx, gh, org, teams)
+	if err != nil {
+		return err
+	}
+	for _, t := range add {
+		if _, err := gh.Organizations.AddSecurityManagerTeam(ctx, org, t); err != nil {
+			return errors.Wrapf(err, errAddSecurityManagerTeam, t)
+		}
+	}
+	for _, t := range remove {
+		if _, err := gh.Organizations.RemoveSecurityManagerTeam(ctx, org, t); err != nil {
+			return errors.Wrapf(err, errRemoveSecurityManagerTeam, t)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,344 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationrole
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org                 = "test-org"
+	roleName            = "security-manager"
+	otherRoleName       = "all_repo_read"
+	roleID        int64 = 8030
+	description         = "Manages the security settings of the organization"
+	baseRole            = "read"
+	permission1         = "read_organization_custom_repo_role"
+	permission2         = "write_organization_custom_org_role"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type organizationRoleModifier func(*v1alpha1.OrganizationRole)
+
+func organizationRole(m ...organizationRoleModifier) *v1alpha1.OrganizationRole {
+	cr := &v1alpha1.OrganizationRole{}
+	cr.Spec.ForProvider.Org = org
+	cr.Spec.ForProvider.Description = &description
+	cr.Spec.ForProvider.BaseRole = &baseRole
+	cr.Spec.ForProvider.Permissions = []string{permission2, permission1}
+
+	meta.SetExternalName(cr, roleName)
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withBaseRole(role string) organizationRoleModifier {
+	return func(r *v1alpha1.OrganizationRole) {
+		r.Spec.ForProvider.BaseRole = &role
+	}
+}
+
+func githubOrgRoles() *ghclient.OrganizationRoles {
+	return &ghclient.OrganizationRoles{
+		Roles: []*ghclient.OrganizationRole{
+			{
+				ID:   github.Int64(roleID + 1),
+				Name: &otherRoleName,
+			},
+			{
+				ID:          &roleID,
+				Name:        &roleName,
+				Description: &description,
+				BaseRole:    &baseRole,
+				Permissions: []string{permission1, permission2},
+			},
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		id  *int64
+		err error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"UpToDate": {
+			reason: "A custom role with the same settings should be up to date regardless of the order of its permissions.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return githubOrgRoles(), nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				id: &roleID,
+			},
+		},
+		"NotUpToDate": {
+			reason: "A role with another base role should not be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return githubOrgRoles(), nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(withBaseRole("maintain")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+				id: &roleID,
+			},
+		},
+		"DoesNotExist": {
+			reason: "A role the organization doesn't define should not exist.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return &ghclient.OrganizationRoles{}, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"ListError": {
+			reason: "Errors listing the roles should be returned.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return nil, nil, errBoom
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(),
+			},
+			want: want{
+				err: errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			cr := tc.args.mg.(*v1alpha1.OrganizationRole)
+			if diff := cmp.Diff(tc.want.id, cr.Status.AtProvider.ID); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want id, +got id:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalUpdate
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"OK": {
+			reason: "The role should be updated by its ID.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return githubOrgRoles(), nil, nil
+						},
+						MockUpdateCustomOrgRole: func(ctx context.Context, org string, id int64, opts *ghclient.OrganizationRoleOptions) (*ghclient.OrganizationRole, *github.Response, error) {
+							if id != roleID || *opts.Name != roleName || *opts.BaseRole != "maintain" {
+								return nil, nil, errors.New("unexpected update")
+							}
+							return nil, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(withBaseRole("maintain")),
+			},
+			want: want{
+				o: managed.ExternalUpdate{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			got, err := e.Update(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   error
+	}{
+		"OK": {
+			reason: "The role should be deleted by its ID.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return githubOrgRoles(), nil, nil
+						},
+						MockDeleteCustomOrgRole: func(ctx context.Context, org string, id int64) (*github.Response, error) {
+							if id != roleID {
+								return nil, errors.New("unexpected role")
+							}
+							return nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(),
+			},
+		},
+		"AlreadyDeleted": {
+			reason: "Deleting a role that no longer exists should succeed.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: func(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+							return &ghclient.OrganizationRoles{}, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: organizationRole(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
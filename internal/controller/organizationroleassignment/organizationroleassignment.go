@@ -0,0 +1,310 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationroleassignment
+
+import (
+	"context"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotOrganizationRoleAssignment = "managed resource is not a OrganizationRoleAssignment custom resource"
+	errTrackPCUsage                  = "cannot track ProviderConfig usage"
+	errGetPC                         = "cannot get ProviderConfig"
+	errGetCreds                      = "cannot get credentials"
+
+	errNewClient = "cannot create new Service"
+
+	errAssignee = "exactly one of user and team must be set"
+)
+
+// Setup adds a controller that reconciles OrganizationRoleAssignment managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationRoleAssignmentGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationRoleAssignmentGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.OrganizationRoleAssignment{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.OrganizationRoleAssignmentList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.OrganizationRoleAssignmentGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRoleAssignment)
+	if !ok {
+		return nil, errors.New(errNotOrganizationRoleAssignment)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.OrganizationRoleAssignmentKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRoleAssignment)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationRoleAssignment)
+	}
+
+	if err := validateAssignee(cr.Spec.ForProvider); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	roleID, err := getExistingRoleID(ctx, c.github, cr.Spec.ForProvider)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	cr.Status.AtProvider.RoleID = &roleID
+
+	assigned, err := isAssigned(ctx, c.github, cr.Spec.ForProvider, roleID)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if !assigned {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// All parameters are immutable, so an existing assignment is always up to date.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRoleAssignment)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationRoleAssignment)
+	}
+
+	p := cr.Spec.ForProvider
+	roleID, err := getExistingRoleID(ctx, c.github, p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if p.User != "" {
+		_, err = c.github.OrganizationRoles.AssignOrgRoleToUser(ctx, p.Org, p.User, roleID)
+	} else {
+		_, err = c.github.OrganizationRoles.AssignOrgRoleToTeam(ctx, p.Org, slug.Make(p.Team), roleID)
+	}
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	_, ok := mg.(*v1alpha1.OrganizationRoleAssignment)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationRoleAssignment)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationRoleAssignment)
+	if !ok {
+		return errors.New(errNotOrganizationRoleAssignment)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	p := cr.Spec.ForProvider
+	// Deleting a role removes its assignments as well.
+	roleID, err := getRoleID(ctx, c.github, p)
+	if err != nil || roleID == nil {
+		return err
+	}
+
+	if p.User != "" {
+		_, err = c.github.OrganizationRoles.RemoveOrgRoleFromUser(ctx, p.Org, p.User, *roleID)
+	} else {
+		_, err = c.github.OrganizationRoles.RemoveOrgRoleFromTeam(ctx, p.Org, slug.Make(p.Team), *roleID)
+	}
+	return err
+}
+
+// validateAssignee returns an error unless exactly one of the user and the
+// team of the assignment is set.
+func validateAssignee(p v1alpha1.OrganizationRoleAssignmentParameters) error {
+	if (p.User == "") == (p.Team == "") {
+		return errors.New(errAssignee)
+	}
+	return nil
+}
+
+// getRoleID returns the ID of the organization role of the assignment, or nil
+// if the organization has no such role.
+func getRoleID(ctx context.Context, gh *ghclient.Client, p v1alpha1.OrganizationRoleAssignmentParameters) (*int64, error) {
+	roles, _, err := gh.OrganizationRoles.ListRoles(ctx, p.Org)
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range roles.Roles {
+		if role.GetName() == p.Role {
+			return role.ID, nil
+		}
+	}
+	return nil, nil
+}
+
+// getExistingRoleID returns the ID of the organization role of the
+// assignment, or an error if the organization has no such role.
+func getExistingRoleID(ctx context.Context, gh *ghclient.Client, p v1alpha1.OrganizationRoleAssignmentParameters) (int64, error) {
+	roleID, err := getRoleID(ctx, gh, p)
+	if err != nil {
+		return 0, err
+	}
+	if roleID == nil {
+		return 0, errors.Errorf("organization %s has no role %s", p.Org, p.Role)
+	}
+	return *roleID, nil
+}
+
+// isAssigned returns whether the role is assigned to the user or the team of
+// the assignment.
+func isAssigned(ctx context.Context, gh *ghclient.Client, p v1alpha1.OrganizationRoleAssignmentParameters, roleID int64) (bool, error) {
+	opt := &github.ListOptions{PerPage: 100}
+
+	for {
+		var names []string
+		var resp *github.Response
+		var err error
+		if p.User != "" {
+			var users []*github.User
+			users, resp, err = gh.OrganizationRoles.ListUsersAssignedToOrgRole(ctx, p.Org, roleID, opt)
+			for _, u := range users {
+				names = append(names, util.NormalizeName(u.GetLogin()))
+			}
+		} else {
+			var teams []*github.Team
+			teams, resp, err = gh.OrganizationRoles.ListTeamsAssignedToOrgRole(ctx, p.Org, roleID, opt)
+			for _, t := range teams {
+				names = append(names, util.NormalizeName(t.GetSlug()))
+			}
+		}
+		if err != nil {
+			return false, err
+		}
+
+		if util.Contains(names, assigneeName(p)) {
+			return true, nil
+		}
+
+		if resp.NextPage == 0 {
+			return false, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+// assigneeName returns the normalized login or team slug of the assignee.
+func assigneeName(p v1alpha1.OrganizationRoleAssignmentParameters) string {
+	if p.User != "" {
+		return util.NormalizeName(p.User)
+	}
+	return util.NormalizeName(slug.Make(p.Team))
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationroleassignment
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org            = "test-org"
+	roleName       = "security-manager"
+	roleID   int64 = 8030
+	user           = "test-user"
+	team           = "Test Team"
+	teamSlug       = "test-team"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type assignmentModifier func(*v1alpha1.OrganizationRoleAssignment)
+
+func assignment(m ...assignmentModifier) *v1alpha1.OrganizationRoleAssignment {
+	cr := &v1alpha1.OrganizationRoleAssignment{}
+	cr.Spec.ForProvider.Org = org
+	cr.Spec.ForProvider.Role = roleName
+	cr.Spec.ForProvider.User = user
+
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withTeam() assignmentModifier {
+	return func(r *v1alpha1.OrganizationRoleAssignment) {
+		r.Spec.ForProvider.User = ""
+		r.Spec.ForProvider.Team = team
+	}
+}
+
+func withRole(role string) assignmentModifier {
+	return func(r *v1alpha1.OrganizationRoleAssignment) {
+		r.Spec.ForProvider.Role = role
+	}
+}
+
+func githubOrgRoles(ctx context.Context, org string) (*ghclient.OrganizationRoles, *github.Response, error) {
+	return &ghclient.OrganizationRoles{
+		Roles: []*ghclient.OrganizationRole{
+			{ID: &roleID, Name: &roleName},
+		},
+	}, nil, nil
+}
+
+func TestObserve(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalObservation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"UserAssigned": {
+			reason: "A role assigned to the user should exist and be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+						MockListUsersAssignedToOrgRole: func(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*github.User, *github.Response, error) {
+							return []*github.User{{Login: github.String("Test-User")}}, fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: assignment(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"TeamNotAssigned": {
+			reason: "A role that isn't assigned to the team should not exist.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+						MockListTeamsAssignedToOrgRole: func(ctx context.Context, org string, id int64, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return []*github.Team{{Slug: github.String("other-team")}}, fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: assignment(withTeam()),
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"NoAssignee": {
+			reason: "An assignment without user or team should be rejected.",
+			fields: fields{
+				github: &ghclient.Client{},
+			},
+			args: args{
+				mg: assignment(func(r *v1alpha1.OrganizationRoleAssignment) {
+					r.Spec.ForProvider.User = ""
+				}),
+			},
+			want: want{
+				err: errors.New(errAssignee),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	type want struct {
+		o   managed.ExternalCreation
+		err error
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   want
+	}{
+		"Team": {
+			reason: "The role should be assigned to the slug of the team.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+						MockAssignOrgRoleToTeam: func(ctx context.Context, org, slug string, id int64) (*github.Response, error) {
+							if slug != teamSlug || id != roleID {
+								return nil, errors.New("unexpected assignment")
+							}
+							return nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: assignment(withTeam()),
+			},
+			want: want{
+				o: managed.ExternalCreation{},
+			},
+		},
+		"UnknownRole": {
+			reason: "Assigning a role the organization doesn't define should fail.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+					},
+				},
+			},
+			args: args{
+				mg: assignment(withRole("unknown")),
+			},
+			want: want{
+				err: errors.New("organization test-org has no role unknown"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			got, err := e.Create(tc.args.ctx, tc.args.mg)
+			if tc.want.err != nil && err == nil {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got no error.\n", tc.reason)
+			}
+			if tc.want.err == nil && err != nil {
+				t.Errorf("\n%s\ne.Create(...): -want no error, +got error:\n%s\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type fields struct {
+		github *ghclient.Client
+	}
+
+	type args struct {
+		ctx context.Context
+		mg  resource.Managed
+	}
+
+	cases := map[string]struct {
+		reason string
+		fields fields
+		args   args
+		want   error
+	}{
+		"User": {
+			reason: "The role should be removed from the user.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+						MockRemoveOrgRoleFromUser: func(ctx context.Context, org, login string, id int64) (*github.Response, error) {
+							if login != user || id != roleID {
+								return nil, errors.New("unexpected removal")
+							}
+							return nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: assignment(),
+			},
+		},
+		"RoleDeleted": {
+			reason: "Deleting the assignment of a role that no longer exists should succeed.",
+			fields: fields{
+				github: &ghclient.Client{
+					OrganizationRoles: &fake.MockOrganizationRolesClient{
+						MockListRoles: githubOrgRoles,
+					},
+				},
+			},
+			args: args{
+				mg: assignment(withRole("deleted")),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.fields.github}
+			err := e.Delete(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
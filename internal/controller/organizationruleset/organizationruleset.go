@@ -0,0 +1,421 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationruleset
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotOrganizationRuleset = "managed resource is not an OrganizationRuleset custom resource"
+	errTrackPCUsage           = "cannot track ProviderConfig usage"
+	errGetPC                  = "cannot get ProviderConfig"
+	errGetCreds               = "cannot get credentials"
+	errNewClient              = "cannot create new Service"
+	errListRulesets           = "cannot list rulesets"
+	errGetRuleset             = "cannot get ruleset"
+	errCreateRuleset          = "cannot create ruleset"
+	errUpdateRuleset          = "cannot update ruleset"
+	errDeleteRuleset          = "cannot delete ruleset"
+	errInvalidID              = "external name %q is not a ruleset ID"
+	errWorkflowRepository     = "workflow %q must set repo, repoRef or repositoryId, as organization rulesets don't apply to a single repository"
+)
+
+// Setup adds a controller that reconciles OrganizationRuleset managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.OrganizationRulesetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.OrganizationRulesetGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		// The external name is the ID GitHub gives the ruleset, which is
+		// looked up by name rather than set from the name of the
+		// OrganizationRuleset.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.OrganizationRuleset{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.OrganizationRulesetList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.OrganizationRulesetGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return nil, errors.New(errNotOrganizationRuleset)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.OrganizationRulesetKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+// rulesetID returns the ID of the ruleset of cr, and false if it was not
+// created or imported yet.
+func rulesetID(cr *v1alpha1.OrganizationRuleset) (int64, bool, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return 0, false, errors.Errorf(errInvalidID, name)
+	}
+	return id, true, nil
+}
+
+// findRuleset returns the ruleset with the ID id, or the one called name if
+// there is none, e.g. because the ruleset is yet to be imported.
+func findRuleset(rulesets []*ghclient.OrganizationRuleset, id int64, name string) *ghclient.OrganizationRuleset {
+	var byName *ghclient.OrganizationRuleset
+	for _, rs := range rulesets {
+		if id != 0 && rs.GetID() == id {
+			return rs
+		}
+		if rs.Name == name && byName == nil {
+			byName = rs
+		}
+	}
+	return byName
+}
+
+// desired returns the normalized ruleset of p, with the teams, apps and
+// repositories it references by name resolved to their IDs. Its required
+// workflows must name their repository, as there is no repository for them
+// to default to.
+func (c *external) desired(ctx context.Context, p v1alpha1.OrganizationRulesetParameters) (v1alpha1.Ruleset, error) {
+	if p.Rules != nil && p.Rules.Workflows != nil {
+		for _, w := range p.Rules.Workflows.Workflows {
+			if w.Repo == "" && w.RepositoryId == nil {
+				return v1alpha1.Ruleset{}, errors.Errorf(errWorkflowRepository, w.Path)
+			}
+		}
+	}
+	rulesets := util.NormalizeRulesets([]v1alpha1.Ruleset{p.Ruleset})
+	if err := util.ResolveRulesetReferences(ctx, c.github, p.Org, "", rulesets); err != nil {
+		return v1alpha1.Ruleset{}, err
+	}
+	return rulesets[p.Name], nil
+}
+
+// validate returns an error listing the problems of a ruleset.
+func validate(rs v1alpha1.Ruleset) error {
+	if problems := util.ValidateRuleset(rs); len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+// normalizeRepositories returns r with its optional fields defaulted and its
+// lists sorted, so that it compares equal to the conditions fetched from
+// GitHub.
+func normalizeRepositories(r v1alpha1.OrganizationRulesetRepositories) v1alpha1.OrganizationRulesetRepositories {
+	r = *r.DeepCopy()
+	if r.Names != nil {
+		r.Names.Include = util.SortAndReturn(nonNil(r.Names.Include))
+		r.Names.Exclude = util.SortAndReturn(nonNil(r.Names.Exclude))
+		r.Names.Protected = util.BoolDerefToPointer(r.Names.Protected, false)
+	}
+	if r.Properties != nil {
+		r.Properties.Include = normalizeProperties(r.Properties.Include)
+		r.Properties.Exclude = normalizeProperties(r.Properties.Exclude)
+	}
+	return r
+}
+
+func normalizeProperties(props []v1alpha1.RulesetRepositoryProperty) []v1alpha1.RulesetRepositoryProperty {
+	normalized := make([]v1alpha1.RulesetRepositoryProperty, 0, len(props))
+	for _, p := range props {
+		normalized = append(normalized, v1alpha1.RulesetRepositoryProperty{Name: p.Name, Values: util.SortAndReturn(nonNil(p.Values))})
+	}
+	sort.Slice(normalized, func(i, j int) bool { return normalized[i].Name < normalized[j].Name })
+	return normalized
+}
+
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
+
+// repositoriesFromGitHub returns the repositories the conditions of a ruleset
+// fetched from GitHub select.
+func repositoriesFromGitHub(c *ghclient.OrganizationRulesetConditions) v1alpha1.OrganizationRulesetRepositories {
+	var r v1alpha1.OrganizationRulesetRepositories
+	if c == nil {
+		return r
+	}
+	if n := c.RepositoryName; n != nil {
+		r.Names = &v1alpha1.RulesetRepositoryNames{Include: n.Include, Exclude: n.Exclude, Protected: n.Protected}
+	}
+	if p := c.RepositoryProperty; p != nil {
+		r.Properties = &v1alpha1.RulesetRepositoryProperties{
+			Include: propertiesFromGitHub(p.Include),
+			Exclude: propertiesFromGitHub(p.Exclude),
+		}
+	}
+	return normalizeRepositories(r)
+}
+
+func propertiesFromGitHub(targets []*ghclient.RulesetRepositoryPropertyTarget) []v1alpha1.RulesetRepositoryProperty {
+	props := make([]v1alpha1.RulesetRepositoryProperty, 0, len(targets))
+	for _, t := range targets {
+		props = append(props, v1alpha1.RulesetRepositoryProperty{Name: t.Name, Values: t.PropertyValues})
+	}
+	return props
+}
+
+func propertiesToGitHub(props []v1alpha1.RulesetRepositoryProperty) []*ghclient.RulesetRepositoryPropertyTarget {
+	targets := make([]*ghclient.RulesetRepositoryPropertyTarget, 0, len(props))
+	for _, p := range props {
+		targets = append(targets, &ghclient.RulesetRepositoryPropertyTarget{Name: p.Name, PropertyValues: p.Values})
+	}
+	return targets
+}
+
+// rulesetToGitHub returns the organization ruleset of the ruleset rs applying
+// to the repositories r.
+func rulesetToGitHub(rs v1alpha1.Ruleset, r v1alpha1.OrganizationRulesetRepositories) *ghclient.OrganizationRuleset {
+	ghRuleset := util.RulesetToGitHub(rs)
+	conditions := &ghclient.OrganizationRulesetConditions{}
+	if ghRuleset.Conditions != nil {
+		conditions.RefName = ghRuleset.Conditions.RefName
+		ghRuleset.Conditions = nil
+	}
+	r = normalizeRepositories(r)
+	if n := r.Names; n != nil {
+		conditions.RepositoryName = &github.RulesetRepositoryNamesConditionParameters{Include: n.Include, Exclude: n.Exclude, Protected: n.Protected}
+	}
+	if p := r.Properties; p != nil {
+		conditions.RepositoryProperty = &ghclient.RulesetRepositoryPropertyConditionParameters{
+			Include: propertiesToGitHub(p.Include),
+			Exclude: propertiesToGitHub(p.Exclude),
+		}
+	}
+	return &ghclient.OrganizationRuleset{Ruleset: *ghRuleset, Conditions: conditions}
+}
+
+// rulesetFromGitHub returns the ruleset of an organization ruleset fetched from
+// GitHub, whose ref name conditions are those of a repository ruleset.
+func rulesetFromGitHub(rs *ghclient.OrganizationRuleset) (v1alpha1.Ruleset, error) {
+	ghRuleset := rs.Ruleset
+	if rs.Conditions != nil && rs.Conditions.RefName != nil {
+		ghRuleset.Conditions = &github.RulesetConditions{RefName: rs.Conditions.RefName}
+	}
+	return util.RulesetFromGitHub(&ghRuleset)
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotOrganizationRuleset)
+	}
+
+	id, _, err := rulesetID(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	p := cr.Spec.ForProvider
+	rulesets, err := ghclient.ListAll(func(page int) ([]*ghclient.OrganizationRuleset, *github.Response, error) {
+		return c.github.OrganizationRulesets.GetAllOrganizationRulesets(ctx, p.Org, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListRulesets)
+	}
+	found := findRuleset(rulesets, id, p.Name)
+	if found == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The ruleset was imported by name.
+	lateInitialized := found.GetID() != id
+	meta.SetExternalName(cr, strconv.FormatInt(found.GetID(), 10))
+	cr.Status.AtProvider.ID = found.GetID()
+	cr.SetConditions(xpv1.Available())
+
+	// Listing the rulesets omits their conditions and rules.
+	rs, _, err := c.github.OrganizationRulesets.GetOrganizationRuleset(ctx, p.Org, found.GetID())
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRuleset)
+	}
+	observed, err := rulesetFromGitHub(rs)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRuleset)
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cmp.Equal(desired, observed) && cmp.Equal(normalizeRepositories(p.Repositories), repositoriesFromGitHub(rs.Conditions)),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotOrganizationRuleset)
+	}
+
+	p := cr.Spec.ForProvider
+	if err := validate(p.Ruleset); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	rs, _, err := c.github.OrganizationRulesets.CreateOrganizationRuleset(ctx, p.Org, rulesetToGitHub(desired, p.Repositories))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRuleset)
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(rs.GetID(), 10))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotOrganizationRuleset)
+	}
+
+	id, _, err := rulesetID(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+	if err := validate(p.Ruleset); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = c.github.OrganizationRulesets.UpdateOrganizationRuleset(ctx, p.Org, id, rulesetToGitHub(desired, p.Repositories))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRuleset)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.OrganizationRuleset)
+	if !ok {
+		return errors.New(errNotOrganizationRuleset)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	id, ok, err := rulesetID(cr)
+	if err != nil || !ok {
+		return err
+	}
+	_, err = c.github.OrganizationRulesets.DeleteOrganizationRuleset(ctx, cr.Spec.ForProvider.Org, id)
+	if ghclient.Is404(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteRuleset)
+}
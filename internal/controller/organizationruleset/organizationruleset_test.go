@@ -0,0 +1,376 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package organizationruleset
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org          = "test-org"
+	rulesetName  = "require-ci"
+	ruleID       = int64(42)
+	otherRuleID  = int64(43)
+	workflowPath = ".github/workflows/ci.yaml"
+	errNotFound  = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type rulesetModifier func(*v1alpha1.OrganizationRuleset)
+
+func rulesetResource(m ...rulesetModifier) *v1alpha1.OrganizationRuleset {
+	cr := &v1alpha1.OrganizationRuleset{}
+	cr.Spec.ForProvider = v1alpha1.OrganizationRulesetParameters{
+		Org: org,
+		Repositories: v1alpha1.OrganizationRulesetRepositories{
+			Properties: &v1alpha1.RulesetRepositoryProperties{
+				Include: []v1alpha1.RulesetRepositoryProperty{{Name: "tier", Values: []string{"production"}}},
+			},
+		},
+		Ruleset: v1alpha1.Ruleset{
+			Name: rulesetName,
+			Conditions: &v1alpha1.RulesetConditions{
+				RefName: &v1alpha1.RulesetRefName{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+			},
+			Rules: &v1alpha1.Rules{Deletion: pointer.Bool(true)},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExternalName(name string) rulesetModifier {
+	return func(r *v1alpha1.OrganizationRuleset) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func withWorkflow(repo string) rulesetModifier {
+	return func(r *v1alpha1.OrganizationRuleset) {
+		r.Spec.ForProvider.Rules.Workflows = &v1alpha1.RulesWorkflows{
+			Workflows: []*v1alpha1.RulesWorkflow{{Path: workflowPath, Repo: repo}},
+		}
+	}
+}
+
+func githubRuleset(id int64, name string, values ...string) *ghclient.OrganizationRuleset {
+	rs := &ghclient.OrganizationRuleset{
+		Ruleset: github.Ruleset{
+			ID:          pointer.Int64(id),
+			Name:        name,
+			Target:      pointer.String("branch"),
+			Enforcement: "active",
+			Rules:       []*github.RepositoryRule{{Type: "deletion"}},
+		},
+		Conditions: &ghclient.OrganizationRulesetConditions{
+			RefName: &github.RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+			RepositoryProperty: &ghclient.RulesetRepositoryPropertyConditionParameters{
+				Include: []*ghclient.RulesetRepositoryPropertyTarget{{Name: "tier", PropertyValues: values, Source: pointer.String("custom")}},
+				Exclude: []*ghclient.RulesetRepositoryPropertyTarget{},
+			},
+		},
+	}
+	return rs
+}
+
+func mockRulesets(rulesets ...*ghclient.OrganizationRuleset) *fake.MockOrganizationRulesetsClient {
+	return &fake.MockOrganizationRulesetsClient{
+		MockGetAllOrganizationRulesets: func(ctx context.Context, org string, opts *github.ListOptions) ([]*ghclient.OrganizationRuleset, *github.Response, error) {
+			return rulesets, fake.GenerateEmptyResponse(), nil
+		},
+		MockGetOrganizationRuleset: func(ctx context.Context, org string, id int64) (*ghclient.OrganizationRuleset, *github.Response, error) {
+			for _, rs := range rulesets {
+				if rs.GetID() == id {
+					return rs, fake.GenerateEmptyResponse(), nil
+				}
+			}
+			return nil, nil, errNotFound
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason   string
+		rulesets *fake.MockOrganizationRulesetsClient
+		cr       *v1alpha1.OrganizationRuleset
+		want     want
+	}{
+		"NotFound": {
+			reason:   "An OrganizationRuleset whose ruleset doesn't exist should be created.",
+			rulesets: mockRulesets(githubRuleset(otherRuleID, "other", "production")),
+			cr:       rulesetResource(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FoundByName": {
+			reason:   "An OrganizationRuleset without an external name should take the ID of the ruleset with its name.",
+			rulesets: mockRulesets(githubRuleset(ruleID, rulesetName, "production")),
+			cr:       rulesetResource(),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				externalName: "42",
+			},
+		},
+		"RepositoriesDiffer": {
+			reason:   "An OrganizationRuleset whose ruleset applies to other repositories should be updated.",
+			rulesets: mockRulesets(githubRuleset(ruleID, rulesetName, "production", "staging")),
+			cr:       rulesetResource(withExternalName("42")),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: "42",
+			},
+		},
+		"WorkflowWithoutRepository": {
+			reason:   "A required workflow of an OrganizationRuleset that doesn't name its repository should be reported.",
+			rulesets: mockRulesets(githubRuleset(ruleID, rulesetName, "production")),
+			cr:       rulesetResource(withExternalName("42"), withWorkflow("")),
+			want: want{
+				externalName: "42",
+				err:          errors.Errorf(errWorkflowRepository, workflowPath),
+			},
+		},
+		"InvalidExternalName": {
+			reason:   "An external name that isn't a ruleset ID should be reported.",
+			rulesets: mockRulesets(),
+			cr:       rulesetResource(withExternalName(rulesetName)),
+			want: want{
+				externalName: rulesetName,
+				err:          errors.Errorf(errInvalidID, rulesetName),
+			},
+		},
+		"ListError": {
+			reason: "Errors listing the rulesets should be returned.",
+			rulesets: &fake.MockOrganizationRulesetsClient{
+				MockGetAllOrganizationRulesets: func(ctx context.Context, org string, opts *github.ListOptions) ([]*ghclient.OrganizationRuleset, *github.Response, error) {
+					return nil, nil, errBoom
+				},
+			},
+			cr: rulesetResource(),
+			want: want{
+				err: errors.Wrap(errBoom, errListRulesets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{OrganizationRulesets: tc.rulesets}}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		conditions   *ghclient.OrganizationRulesetConditions
+		workflows    []*github.RuleRequiredWorkflow
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.OrganizationRuleset
+		want   want
+	}{
+		"Created": {
+			reason: "An OrganizationRuleset should create its ruleset for the repositories it selects by property and take its ID.",
+			cr:     rulesetResource(),
+			want: want{
+				conditions: &ghclient.OrganizationRulesetConditions{
+					RefName: &github.RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+					RepositoryProperty: &ghclient.RulesetRepositoryPropertyConditionParameters{
+						Include: []*ghclient.RulesetRepositoryPropertyTarget{{Name: "tier", PropertyValues: []string{"production"}}},
+						Exclude: []*ghclient.RulesetRepositoryPropertyTarget{},
+					},
+				},
+				externalName: "42",
+			},
+		},
+		"RequiredWorkflow": {
+			reason: "The required workflows of an OrganizationRuleset should reference the ID of the repository they name.",
+			cr:     rulesetResource(withWorkflow("ci")),
+			want: want{
+				conditions: &ghclient.OrganizationRulesetConditions{
+					RefName: &github.RulesetRefConditionParameters{Include: []string{"~DEFAULT_BRANCH"}, Exclude: []string{}},
+					RepositoryProperty: &ghclient.RulesetRepositoryPropertyConditionParameters{
+						Include: []*ghclient.RulesetRepositoryPropertyTarget{{Name: "tier", PropertyValues: []string{"production"}}},
+						Exclude: []*ghclient.RulesetRepositoryPropertyTarget{},
+					},
+				},
+				workflows:    []*github.RuleRequiredWorkflow{{Path: workflowPath, RepositoryID: pointer.Int64(7)}},
+				externalName: "42",
+			},
+		},
+		"Invalid": {
+			reason: "An OrganizationRuleset that fails validation should not be created.",
+			cr: rulesetResource(func(r *v1alpha1.OrganizationRuleset) {
+				r.Spec.ForProvider.Target = pointer.String("commit")
+			}),
+			want: want{
+				err: errors.New(`target must be one of [branch tag push], got "commit"`),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var conditions *ghclient.OrganizationRulesetConditions
+			var workflows []*github.RuleRequiredWorkflow
+			e := external{github: &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return &github.Repository{ID: pointer.Int64(7), Name: &repo}, fake.GenerateEmptyResponse(), nil
+					},
+				},
+				OrganizationRulesets: &fake.MockOrganizationRulesetsClient{
+					MockCreateOrganizationRuleset: func(ctx context.Context, org string, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error) {
+						conditions = rs.Conditions
+						for _, rule := range rs.Rules {
+							if rule.Type != "workflows" {
+								continue
+							}
+							params := &github.RequiredWorkflowsRuleParameters{}
+							if err := json.Unmarshal(*rule.Parameters, params); err != nil {
+								t.Fatal(err)
+							}
+							workflows = params.RequiredWorkflows
+						}
+						rs.ID = pointer.Int64(ruleID)
+						return rs, fake.GenerateEmptyResponse(), nil
+					},
+				},
+			}}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.conditions, conditions); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want conditions, +got conditions:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.workflows, workflows); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want workflows, +got workflows:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var got int64
+	e := external{github: &ghclient.Client{OrganizationRulesets: &fake.MockOrganizationRulesetsClient{
+		MockUpdateOrganizationRuleset: func(ctx context.Context, org string, id int64, rs *ghclient.OrganizationRuleset) (*ghclient.OrganizationRuleset, *github.Response, error) {
+			got = id
+			return rs, fake.GenerateEmptyResponse(), nil
+		},
+	}}}
+	if _, err := e.Update(context.Background(), rulesetResource(withExternalName("42"))); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff(ruleID, got); diff != "" {
+		t.Errorf("\nAn OrganizationRuleset should update its ruleset by its ID.\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.OrganizationRuleset
+		err    error
+		want   []int64
+	}{
+		"Deleted": {
+			reason: "An OrganizationRuleset should delete its ruleset by its ID.",
+			cr:     rulesetResource(withExternalName("42")),
+			want:   []int64{ruleID},
+		},
+		"AlreadyDeleted": {
+			reason: "An OrganizationRuleset whose ruleset is gone is deleted.",
+			cr:     rulesetResource(withExternalName("42")),
+			err:    errNotFound,
+			want:   []int64{ruleID},
+		},
+		"NeverCreated": {
+			reason: "An OrganizationRuleset without an external name has no ruleset to delete.",
+			cr:     rulesetResource(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []int64
+			e := external{github: &ghclient.Client{OrganizationRulesets: &fake.MockOrganizationRulesetsClient{
+				MockDeleteOrganizationRuleset: func(ctx context.Context, org string, id int64) (*github.Response, error) {
+					got = append(got, id)
+					return nil, tc.err
+				},
+			}}}
+			if err := e.Delete(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Delete(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
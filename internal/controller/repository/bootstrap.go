@@ -0,0 +1,324 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errBootstrapAction      = "cannot run bootstrap action %s"
+	errGetConfigMap         = "cannot get ConfigMap %s/%s"
+	errConfigMapKeyNotFound = "ConfigMap %s/%s has no key %s"
+	errGetFile              = "cannot get %s"
+	errCommitFile           = "cannot commit %s"
+	errListIssues           = "cannot list issues"
+
+	issueTemplatesDir   = ".github/ISSUE_TEMPLATE"
+	pullRequestTemplate = ".github/PULL_REQUEST_TEMPLATE.md"
+
+	commitStatusSuccess = "success"
+)
+
+// A bootstrapAction is a post-create action that is run exactly once for a
+// newly created repository. Its name identifies it in the Repository status.
+type bootstrapAction interface {
+	name() string
+	run(ctx context.Context, gh *ghclient.Client, kube client.Reader, owner, repo string) error
+}
+
+// getBootstrapActions returns the bootstrap actions configured for a Repository,
+// in the order they have to be run.
+func getBootstrapActions(b *v1alpha1.RepositoryBootstrap) []bootstrapAction {
+	if b == nil {
+		return nil
+	}
+
+	actions := make([]bootstrapAction, 0, len(b.Files)+len(b.Environments)+len(b.CommitStatuses)+len(b.WorkflowDispatches)+3)
+	for _, f := range b.Files {
+		actions = append(actions, seedFileAction{file: f})
+	}
+	if t := b.Templates; t != nil {
+		if t.IssueTemplatesRef != nil {
+			actions = append(actions, issueTemplatesAction{ref: *t.IssueTemplatesRef})
+		}
+		if t.PullRequestTemplateRef != nil {
+			actions = append(actions, pullRequestTemplateAction{ref: *t.PullRequestTemplateRef})
+		}
+	}
+	for _, e := range b.Environments {
+		actions = append(actions, environmentAction{environment: e})
+	}
+	if b.Issue != nil {
+		actions = append(actions, issueAction{issue: *b.Issue})
+	}
+	// Commit statuses are set after the seed files were committed, on the
+	// commit that is the head of the default branch then.
+	for _, st := range b.CommitStatuses {
+		actions = append(actions, commitStatusAction{status: st})
+	}
+	for _, w := range b.WorkflowDispatches {
+		actions = append(actions, workflowDispatchAction{dispatch: w})
+	}
+	return actions
+}
+
+// getPendingBootstrapActions returns the bootstrap actions that have not yet run.
+// Bootstrapping only applies to repositories created by the controller, never to
+// adopted ones.
+func getPendingBootstrapActions(cr *v1alpha1.Repository) []bootstrapAction {
+	if meta.GetExternalCreateSucceeded(cr).IsZero() {
+		return nil
+	}
+
+	var pending []bootstrapAction
+	for _, a := range getBootstrapActions(cr.Spec.ForProvider.Bootstrap) {
+		if !util.Contains(cr.Status.AtProvider.CompletedBootstrapActions, a.name()) {
+			pending = append(pending, a)
+		}
+	}
+	return pending
+}
+
+// runBootstrapActions runs the pending bootstrap actions of a Repository and records
+// each completed action in its status, so that it is never run again.
+func runBootstrapActions(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, kube client.Reader, repoName string) error {
+	for _, a := range getPendingBootstrapActions(cr) {
+		if err := a.run(ctx, gh, kube, cr.Spec.ForProvider.Org, repoName); err != nil {
+			return errors.Wrapf(err, errBootstrapAction, a.name())
+		}
+		cr.Status.AtProvider.CompletedBootstrapActions = append(cr.Status.AtProvider.CompletedBootstrapActions, a.name())
+	}
+	return nil
+}
+
+type seedFileAction struct {
+	file v1alpha1.BootstrapFile
+}
+
+func (a seedFileAction) name() string {
+	return "file:" + a.file.Path
+}
+
+func (a seedFileAction) run(ctx context.Context, gh *ghclient.Client, _ client.Reader, owner, repo string) error {
+	message := fmt.Sprintf("Add %s", a.file.Path)
+	if a.file.Message != nil {
+		message = *a.file.Message
+	}
+	return commitFile(ctx, gh, owner, repo, a.file.Path, a.file.Content, message)
+}
+
+// getConfigMap returns the ConfigMap a reference refers to.
+func getConfigMap(ctx context.Context, kube client.Reader, ref v1alpha1.ConfigMapReference) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, cm); err != nil {
+		return nil, errors.Wrapf(err, errGetConfigMap, ref.Namespace, ref.Name)
+	}
+	return cm, nil
+}
+
+// commitFile commits a file, unless it already exists, e.g. as a previous
+// attempt committed it but its action couldn't be recorded as completed.
+func commitFile(ctx context.Context, gh *ghclient.Client, owner, repo, path, content, message string) error {
+	_, _, _, err := gh.Repositories.GetContents(ctx, owner, repo, path, nil)
+	if err == nil {
+		return nil
+	}
+	if !ghclient.Is404(err) {
+		return errors.Wrapf(err, errGetFile, path)
+	}
+	_, _, err = gh.Repositories.CreateFile(ctx, owner, repo, path, &github.RepositoryContentFileOptions{
+		Message: &message,
+		Content: []byte(content),
+	})
+	return errors.Wrapf(err, errCommitFile, path)
+}
+
+// commitTemplate commits a template file, unless it already exists.
+func commitTemplate(ctx context.Context, gh *ghclient.Client, owner, repo, path, content string) error {
+	return commitFile(ctx, gh, owner, repo, path, content, fmt.Sprintf("Add %s", path))
+}
+
+type issueTemplatesAction struct {
+	ref v1alpha1.ConfigMapReference
+}
+
+func (a issueTemplatesAction) name() string {
+	return "templates:issue"
+}
+
+func (a issueTemplatesAction) run(ctx context.Context, gh *ghclient.Client, kube client.Reader, owner, repo string) error {
+	cm, err := getConfigMap(ctx, kube, a.ref)
+	if err != nil {
+		return err
+	}
+	keys := make([]string, 0, len(cm.Data))
+	for k := range cm.Data {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if err := commitTemplate(ctx, gh, owner, repo, path.Join(issueTemplatesDir, k), cm.Data[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type pullRequestTemplateAction struct {
+	ref v1alpha1.ConfigMapKeySelector
+}
+
+func (a pullRequestTemplateAction) name() string {
+	return "templates:pull-request"
+}
+
+func (a pullRequestTemplateAction) run(ctx context.Context, gh *ghclient.Client, kube client.Reader, owner, repo string) error {
+	cm, err := getConfigMap(ctx, kube, a.ref.ConfigMapReference)
+	if err != nil {
+		return err
+	}
+	content, ok := cm.Data[a.ref.Key]
+	if !ok {
+		return errors.Errorf(errConfigMapKeyNotFound, a.ref.Namespace, a.ref.Name, a.ref.Key)
+	}
+	return commitTemplate(ctx, gh, owner, repo, pullRequestTemplate, content)
+}
+
+type environmentAction struct {
+	environment string
+}
+
+func (a environmentAction) name() string {
+	return "environment:" + a.environment
+}
+
+func (a environmentAction) run(ctx context.Context, gh *ghclient.Client, _ client.Reader, owner, repo string) error {
+	_, _, err := gh.Repositories.CreateUpdateEnvironment(ctx, owner, repo, a.environment, &github.CreateUpdateEnvironment{})
+	return err
+}
+
+type issueAction struct {
+	issue v1alpha1.BootstrapIssue
+}
+
+func (a issueAction) name() string {
+	return "issue"
+}
+
+func (a issueAction) run(ctx context.Context, gh *ghclient.Client, _ client.Reader, owner, repo string) error {
+	opened, err := a.opened(ctx, gh, owner, repo)
+	if err != nil || opened {
+		return err
+	}
+	req := &github.IssueRequest{
+		Title: &a.issue.Title,
+		Body:  a.issue.Body,
+	}
+	if a.issue.Labels != nil {
+		req.Labels = &a.issue.Labels
+	}
+	_, _, err = gh.Issues.Create(ctx, owner, repo, req)
+	return err
+}
+
+// opened returns whether an open issue with the title of the tracking issue
+// exists, e.g. as a previous attempt opened it but its action couldn't be
+// recorded as completed.
+func (a issueAction) opened(ctx context.Context, gh *ghclient.Client, owner, repo string) (bool, error) {
+	opt := &github.IssueListByRepoOptions{State: "open", ListOptions: github.ListOptions{PerPage: 100}}
+
+	for {
+		issues, resp, err := gh.Issues.ListByRepo(ctx, owner, repo, opt)
+		if err != nil {
+			return false, errors.Wrap(err, errListIssues)
+		}
+		for _, i := range issues {
+			if !i.IsPullRequest() && i.GetTitle() == a.issue.Title {
+				return true, nil
+			}
+		}
+		if resp.NextPage == 0 {
+			return false, nil
+		}
+		opt.Page = resp.NextPage
+	}
+}
+
+type commitStatusAction struct {
+	status v1alpha1.BootstrapCommitStatus
+}
+
+func (a commitStatusAction) name() string {
+	return "status:" + a.status.Context
+}
+
+func (a commitStatusAction) run(ctx context.Context, gh *ghclient.Client, _ client.Reader, owner, repo string) error {
+	r, _, err := gh.Repositories.Get(ctx, owner, repo)
+	if err != nil {
+		return err
+	}
+	branch, _, err := gh.Repositories.GetBranch(ctx, owner, repo, r.GetDefaultBranch(), 0)
+	if err != nil {
+		return err
+	}
+	state := commitStatusSuccess
+	if a.status.State != nil {
+		state = *a.status.State
+	}
+	_, _, err = gh.Repositories.CreateStatus(ctx, owner, repo, branch.GetCommit().GetSHA(), &github.RepoStatus{
+		Context:     &a.status.Context,
+		State:       &state,
+		Description: a.status.Description,
+	})
+	return err
+}
+
+type workflowDispatchAction struct {
+	dispatch v1alpha1.BootstrapWorkflowDispatch
+}
+
+func (a workflowDispatchAction) name() string {
+	return fmt.Sprintf("workflow:%s@%s", a.dispatch.Workflow, a.dispatch.Ref)
+}
+
+func (a workflowDispatchAction) run(ctx context.Context, gh *ghclient.Client, _ client.Reader, owner, repo string) error {
+	event := github.CreateWorkflowDispatchEventRequest{Ref: a.dispatch.Ref}
+	if a.dispatch.Inputs != nil {
+		event.Inputs = make(map[string]interface{}, len(a.dispatch.Inputs))
+		for k, v := range a.dispatch.Inputs {
+			event.Inputs[k] = v
+		}
+	}
+	_, err := gh.Actions.CreateWorkflowDispatchEventByFileName(ctx, owner, repo, a.dispatch.Workflow, event)
+	return err
+}
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errListBranchProtectionRules  = "cannot list branch protection rules"
+	errCreateBranchProtectionRule = "cannot create branch protection rule %s"
+	errUpdateBranchProtectionRule = "cannot update branch protection rule %s"
+	errDeleteBranchProtectionRule = "cannot delete branch protection rule %s"
+	errListStandaloneRules        = "cannot list BranchProtectionRule managed resources"
+)
+
+// isBranchPattern reports whether the branch of a BranchProtectionRule is a
+// pattern like "release/*" rather than the name of a single branch. Rules for
+// patterns are managed through the GraphQL API, as the REST API requires the
+// protected branch to exist.
+func isBranchPattern(branch string) bool {
+	return strings.ContainsAny(branch, "*?[")
+}
+
+// splitBranchPatterns splits branch protection rules keyed by branch into the
+// rules for single branches and the rules for branch patterns.
+func splitBranchPatterns(rules map[string]v1alpha1.RepositoryBranchProtectionRule) (map[string]v1alpha1.RepositoryBranchProtectionRule, map[string]v1alpha1.RepositoryBranchProtectionRule) {
+	branches := make(map[string]v1alpha1.RepositoryBranchProtectionRule, len(rules))
+	patterns := make(map[string]v1alpha1.RepositoryBranchProtectionRule)
+	for branch, rule := range rules {
+		if isBranchPattern(branch) {
+			patterns[branch] = rule
+		} else {
+			branches[branch] = rule
+		}
+	}
+	return branches, patterns
+}
+
+// standaloneBranchProtectionRules returns the branches and branch patterns of
+// the BranchProtectionRule managed resources of a repository. Their rules are
+// left alone by the Repository, whatever its management policy.
+func standaloneBranchProtectionRules(ctx context.Context, kube client.Reader, org, repo string) ([]string, error) {
+	l := &v1alpha1.BranchProtectionRuleList{}
+	if err := kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListStandaloneRules)
+	}
+	var branches []string
+	for _, r := range l.Items {
+		p := r.Spec.ForProvider
+		// The names of organizations and repositories are case-insensitive.
+		if strings.EqualFold(p.Org, org) && strings.EqualFold(p.Repo, repo) {
+			branches = append(branches, p.Branch)
+		}
+	}
+	return branches, nil
+}
+
+// branchPatternRules are the branch protection rules for branch patterns of a
+// GitHub repository, keyed by pattern.
+type branchPatternRules struct {
+	repositoryID string
+	ids          map[string]string
+	config       map[string]v1alpha1.RepositoryBranchProtectionRule
+}
+
+// getBranchPatternRules retrieves the branch protection rules for branch patterns
+// of a GitHub repository. Rules for single branches are left to the REST API.
+func getBranchPatternRules(ctx context.Context, gh *ghclient.Client, owner, repo string) (*branchPatternRules, error) {
+	res, err := gh.BranchProtectionRules.ListBranchProtectionRules(ctx, owner, repo)
+	if err != nil {
+		return nil, errors.Wrap(err, errListBranchProtectionRules)
+	}
+
+	rules := &branchPatternRules{
+		repositoryID: res.RepositoryID,
+		ids:          make(map[string]string),
+		config:       make(map[string]v1alpha1.RepositoryBranchProtectionRule),
+	}
+	for _, rule := range res.Rules {
+		if !isBranchPattern(rule.Pattern) {
+			continue
+		}
+		rules.ids[rule.Pattern] = rule.ID
+		rules.config[rule.Pattern] = util.BranchProtectionRuleFromGraphQL(rule)
+	}
+	return rules, nil
+}
+
+// withoutPatternProtected removes the branches that are only protected because they
+// match one of the branch patterns, so that their protection isn't mistaken for an
+// unmanaged rule and removed. Branches with a rule of their own in the CR are kept.
+func withoutPatternProtected(branches []*github.Branch, patterns *branchPatternRules, crRules map[string]v1alpha1.RepositoryBranchProtectionRule) []*github.Branch {
+	var res []*github.Branch
+	for _, branch := range branches {
+		if _, ok := crRules[branch.GetName()]; ok || !matchesAnyPattern(branch.GetName(), patterns.config) {
+			res = append(res, branch)
+		}
+	}
+	return res
+}
+
+func matchesAnyPattern(branch string, patterns map[string]v1alpha1.RepositoryBranchProtectionRule) bool {
+	for pattern := range patterns {
+		if ok, _ := path.Match(pattern, branch); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// updateBranchPatternRules synchronizes the branch protection rules for branch
+// patterns of a GitHub repository with the rules declared in crRules. Rules to
+// delete are reused for the patterns to add by updating them in place, and the
+// remaining ones are only deleted once all others are applied, so that a
+// branch covered by both an old and a new pattern is never left unprotected.
+func updateBranchPatternRules(ctx context.Context, gh *ghclient.Client, ghRules *branchPatternRules, crRules map[string]v1alpha1.RepositoryBranchProtectionRule) error {
+	toDelete, toAdd, toUpdate := util.DiffProtectedBranches(ghRules.config, crRules)
+	reused := reusePatternRules(ghRules.ids, toDelete, toAdd)
+
+	for pattern, rule := range toAdd {
+		input := util.BranchProtectionRuleInput(rule)
+		if id, ok := reused[pattern]; ok {
+			input.BranchProtectionRuleID = id
+			if err := gh.BranchProtectionRules.UpdateBranchProtectionRule(ctx, input); err != nil {
+				return errors.Wrapf(err, errUpdateBranchProtectionRule, pattern)
+			}
+			continue
+		}
+		input.RepositoryID = ghRules.repositoryID
+		if err := gh.BranchProtectionRules.CreateBranchProtectionRule(ctx, input); err != nil {
+			return errors.Wrapf(err, errCreateBranchProtectionRule, pattern)
+		}
+	}
+
+	for pattern, rule := range toUpdate {
+		input := util.BranchProtectionRuleInput(rule)
+		input.BranchProtectionRuleID = ghRules.ids[pattern]
+		if err := gh.BranchProtectionRules.UpdateBranchProtectionRule(ctx, input); err != nil {
+			return errors.Wrapf(err, errUpdateBranchProtectionRule, pattern)
+		}
+	}
+
+	for pattern := range toDelete {
+		if err := gh.BranchProtectionRules.DeleteBranchProtectionRule(ctx, ghRules.ids[pattern]); err != nil {
+			return errors.Wrapf(err, errDeleteBranchProtectionRule, pattern)
+		}
+	}
+
+	return nil
+}
+
+// reusePatternRules pairs the patterns to add with the node IDs of rules to
+// delete, in the order of their patterns, and removes the reused rules from
+// toDelete. Updating a rule to a new pattern and settings leaves the same
+// rules as deleting it and creating a new one, without the gap in between.
+func reusePatternRules(ids map[string]string, toDelete, toAdd map[string]v1alpha1.RepositoryBranchProtectionRule) map[string]string {
+	deleted := make([]string, 0, len(toDelete))
+	for pattern := range toDelete {
+		deleted = append(deleted, pattern)
+	}
+	added := make([]string, 0, len(toAdd))
+	for pattern := range toAdd {
+		added = append(added, pattern)
+	}
+	sort.Strings(deleted)
+	sort.Strings(added)
+
+	reused := make(map[string]string)
+	for i := 0; i < len(deleted) && i < len(added); i++ {
+		reused[added[i]] = ids[deleted[i]]
+		delete(toDelete, deleted[i])
+	}
+	return reused
+}
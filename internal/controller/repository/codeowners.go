@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetCodeownersErrors = "cannot get CODEOWNERS errors"
+
+	// maxCodeownersErrors is the number of CODEOWNERS errors listed in the
+	// CodeownersValid condition, to keep its message readable.
+	maxCodeownersErrors = 10
+)
+
+// codeownersCondition returns the CodeownersValid condition of a repository,
+// with the errors GitHub found in its CODEOWNERS file.
+func codeownersCondition(ctx context.Context, gh *ghclient.Client, org, repoName string) (xpv1.Condition, error) {
+	ce, _, err := gh.Repositories.GetCodeownersErrors(ctx, org, repoName, nil)
+	// GitHub answers 404 for repositories without a CODEOWNERS file.
+	if ghclient.Is404(err) {
+		return v1alpha1.CodeownersValid("no CODEOWNERS file"), nil
+	}
+	if err != nil {
+		return xpv1.Condition{}, errors.Wrap(err, errGetCodeownersErrors)
+	}
+	if len(ce.Errors) == 0 {
+		return v1alpha1.CodeownersValid(""), nil
+	}
+
+	msgs := make([]string, 0, maxCodeownersErrors)
+	for _, e := range ce.Errors {
+		if len(msgs) == maxCodeownersErrors {
+			msgs = append(msgs, fmt.Sprintf("and %d more", len(ce.Errors)-maxCodeownersErrors))
+			break
+		}
+		msgs = append(msgs, fmt.Sprintf("%s:%d: %s", e.Path, e.Line, e.Kind))
+	}
+	return v1alpha1.CodeownersInvalid(strings.Join(msgs, ", ")), nil
+}
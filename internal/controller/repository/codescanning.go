@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errGetCodeScanningDefaultSetup    = "cannot get code scanning default setup"
+	errUpdateCodeScanningDefaultSetup = "cannot update code scanning default setup"
+
+	codeScanningConfigured = "configured"
+)
+
+// codeScanningUpToDate returns whether the code scanning default setup of a
+// repository matches cs. The query suite and languages are only compared
+// while the default setup is configured, and only if cs sets them.
+func codeScanningUpToDate(cs *v1alpha1.RepositoryCodeScanning, cfg *github.DefaultSetupConfiguration) bool {
+	if cs.State != cfg.GetState() {
+		return false
+	}
+	if cs.State != codeScanningConfigured {
+		return true
+	}
+	if cs.QuerySuite != nil && *cs.QuerySuite != cfg.GetQuerySuite() {
+		return false
+	}
+	if cs.Languages != nil {
+		return slices.Equal(util.NormalizeNames(cs.Languages), util.NormalizeNames(cfg.Languages))
+	}
+	return true
+}
+
+// getCodeScanningUpToDate returns whether the code scanning default setup of
+// a repository matches its spec.
+func getCodeScanningUpToDate(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) (bool, error) {
+	cfg, _, err := gh.CodeScanning.GetDefaultSetupConfiguration(ctx, cr.Spec.ForProvider.Org, repoName)
+	if err != nil {
+		return false, errors.Wrap(err, errGetCodeScanningDefaultSetup)
+	}
+	return codeScanningUpToDate(cr.Spec.ForProvider.CodeScanning, cfg), nil
+}
+
+// updateCodeScanning configures the code scanning default setup of a
+// repository as in its spec, if it differs.
+func updateCodeScanning(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	upToDate, err := getCodeScanningUpToDate(ctx, gh, cr, repoName)
+	if err != nil || upToDate {
+		return err
+	}
+
+	cs := cr.Spec.ForProvider.CodeScanning
+	opts := &github.UpdateDefaultSetupConfigurationOptions{State: cs.State}
+	if cs.State == codeScanningConfigured {
+		opts.QuerySuite = cs.QuerySuite
+		opts.Languages = cs.Languages
+	}
+	_, _, err = gh.CodeScanning.UpdateDefaultSetupConfiguration(ctx, cr.Spec.ForProvider.Org, repoName, opts)
+	// GitHub configures the default setup in the background and answers
+	// 202 Accepted, which go-github reports as an error.
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		return nil
+	}
+	return errors.Wrap(err, errUpdateCodeScanningDefaultSetup)
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetCustomPropertyValues    = "cannot get repository custom property values"
+	errUpdateCustomPropertyValues = "cannot update repository custom property values"
+)
+
+// getOutdatedCustomProperties returns the custom property values of the spec
+// that differ from the values of the repository, sorted by property name.
+// Properties that are not in the spec are left untouched.
+func getOutdatedCustomProperties(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) ([]*github.CustomPropertyValue, error) {
+	ghValues, _, err := gh.Repositories.GetAllCustomPropertyValues(ctx, cr.Spec.ForProvider.Org, repoName)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCustomPropertyValues)
+	}
+
+	current := make(map[string]string, len(ghValues))
+	for _, v := range ghValues {
+		if v.Value != nil {
+			current[v.PropertyName] = *v.Value
+		}
+	}
+
+	var outdated []*github.CustomPropertyValue
+	for name, value := range cr.Spec.ForProvider.CustomProperties {
+		if ghValue, ok := current[name]; ok && ghValue == value {
+			continue
+		}
+		outdated = append(outdated, &github.CustomPropertyValue{PropertyName: name, Value: github.String(value)})
+	}
+	sort.Slice(outdated, func(i, j int) bool {
+		return outdated[i].PropertyName < outdated[j].PropertyName
+	})
+	return outdated, nil
+}
+
+// updateCustomProperties sets the custom property values of the spec that
+// differ from the values of the repository.
+func updateCustomProperties(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	outdated, err := getOutdatedCustomProperties(ctx, gh, cr, repoName)
+	if err != nil || len(outdated) == 0 {
+		return err
+	}
+
+	_, err = gh.Repositories.CreateOrUpdateCustomProperties(ctx, cr.Spec.ForProvider.Org, repoName, outdated)
+	return errors.Wrap(err, errUpdateCustomPropertyValues)
+}
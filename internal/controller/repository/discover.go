@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListRepositories = "cannot list repositories of organization %s"
+	errExportRepository = "cannot export parameters of repository %s"
+)
+
+// DiscoveredRepository is a Repository that adopts an existing GitHub
+// repository, with the settings of the repository that it can't reproduce.
+type DiscoveredRepository struct {
+	Repository        v1alpha1.Repository
+	UnmodeledSettings []string
+}
+
+// DiscoverRepositories returns a Repository for each repository of org, with
+// the external name set to adopt it and the parameters that reproduce its
+// current settings.
+func DiscoverRepositories(ctx context.Context, gh *ghclient.Client, org string) ([]DiscoveredRepository, error) {
+	repos, err := ghclient.ListAll(func(page int) ([]*github.Repository, *github.Response, error) {
+		return gh.Repositories.ListByOrg(ctx, org, &github.RepositoryListByOrgOptions{ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page}})
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, errListRepositories, org)
+	}
+
+	discovered := make([]DiscoveredRepository, 0, len(repos))
+	for _, repo := range repos {
+		params, unmodeled, err := ExportParameters(ctx, gh, org, repo)
+		if err != nil {
+			return nil, errors.Wrapf(err, errExportRepository, repo.GetName())
+		}
+		cr := v1alpha1.Repository{}
+		cr.SetGroupVersionKind(v1alpha1.RepositoryGroupVersionKind)
+		cr.SetName(discoveredName(repo.GetName()))
+		meta.SetExternalName(&cr, org+"/"+repo.GetName())
+		cr.Spec.ForProvider = *params
+		discovered = append(discovered, DiscoveredRepository{Repository: cr, UnmodeledSettings: unmodeled})
+	}
+	return discovered, nil
+}
+
+// discoveredName returns a Kubernetes object name for a GitHub repository
+// name, which may contain upper case letters, dots and underscores.
+func discoveredName(repoName string) string {
+	return strings.ReplaceAll(slug.Make(repoName), "_", "-")
+}
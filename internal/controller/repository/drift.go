@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-github/internal/permissions"
+)
+
+// maxDriftChanges and maxDriftValueLength cap the drift report in the status,
+// so that a Repository that drifted a lot stays well within the size of an
+// object the API server accepts.
+const (
+	maxDriftChanges     = 50
+	maxDriftValueLength = 256
+)
+
+// settingsKeys are the keys of the drifted settings that are edited on the
+// repository itself.
+var settingsKeys = []string{"description", "private", "isTemplate", "hasIssues", "hasWiki", "hasProjects", "hasDiscussions", "hasDownloads"}
+
+// drift records what Observe found to differ between a Repository and GitHub,
+// so that Update only writes the sub-resources that drifted rather than all of
+// them.
+type drift struct {
+	// keys are the fields of the spec that differ, without the keys of the
+	// entries of lists and maps, e.g. webhooks for webhooks[https://...].
+	keys map[string]bool
+
+	// skipped are the sub-resources Observe skipped for missing permissions,
+	// which Update leaves alone and keeps reporting.
+	skipped *permissions.Skipped
+}
+
+// newDrift returns the drift of the supplied differing fields.
+func newDrift(differs []string, skipped *permissions.Skipped) *drift {
+	d := &drift{keys: make(map[string]bool, len(differs)), skipped: skipped}
+	for _, key := range differs {
+		if i := strings.Index(key, "["); i >= 0 {
+			key = key[:i]
+		}
+		d.keys[key] = true
+	}
+	return d
+}
+
+// has reports whether any of the fields drifted. Everything drifted if it's
+// unknown what did, e.g. because Update wasn't preceded by an Observe that
+// compared the sub-resources.
+func (d *drift) has(keys ...string) bool {
+	if d == nil {
+		return true
+	}
+	for _, key := range keys {
+		if d.keys[key] {
+			return true
+		}
+	}
+	return false
+}
+
+// skippedOr returns the sub-resources Observe skipped, or s if it's unknown
+// what it skipped.
+func (d *drift) skippedOr(s *permissions.Skipped) *permissions.Skipped {
+	if d == nil {
+		return s
+	}
+	return d.skipped
+}
+
+// driftValues are the desired and observed values of differing fields, by
+// their key in the differing fields. A value is nil if the field or entry is
+// missing on that side.
+type driftValues map[string]driftValue
+
+type driftValue struct {
+	desired, observed any
+}
+
+// set records the desired and observed value of the differing field key.
+func (v driftValues) set(key string, desired, observed any) {
+	v[key] = driftValue{desired: desired, observed: observed}
+}
+
+// newDriftReport returns the changes an update makes for the supplied
+// differing fields, with the values recorded for them.
+func newDriftReport(differs []string, values driftValues) *v1alpha1.DriftReport {
+	r := &v1alpha1.DriftReport{}
+	for _, key := range differs {
+		if len(r.Changes) == maxDriftChanges {
+			r.Truncated = true
+			break
+		}
+		c := v1alpha1.DriftChange{Op: "replace", Path: driftPath(key)}
+		if v, ok := values[key]; ok {
+			switch {
+			case v.desired == nil:
+				c.Op = "remove"
+			case v.observed == nil:
+				c.Op = "add"
+			}
+			c.Value = driftValueJSON(v.desired)
+			c.Observed = driftValueJSON(v.observed)
+		}
+		r.Changes = append(r.Changes, c)
+	}
+	return r
+}
+
+// driftPath returns the JSON pointer of a differing field, e.g.
+// /permissions/users/octocat for permissions.users[octocat].
+func driftPath(key string) string {
+	var entry string
+	if i := strings.Index(key, "["); i >= 0 && strings.HasSuffix(key, "]") {
+		key, entry = key[:i], key[i+1:len(key)-1]
+	}
+	p := "/" + strings.ReplaceAll(key, ".", "/")
+	if entry != "" {
+		p += "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(entry)
+	}
+	return p
+}
+
+// driftValueJSON returns v as JSON cut to maxDriftValueLength, or nothing if
+// v is missing.
+func driftValueJSON(v any) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+	if len(b) > maxDriftValueLength {
+		b = b[:maxDriftValueLength]
+	}
+	return string(b)
+}
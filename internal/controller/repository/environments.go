@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetEnvironment               = "cannot get environment %s"
+	errUpdateEnvironment            = "cannot update deployment branch policy of environment %s"
+	errListDeploymentBranchPolicies = "cannot list deployment branch policies of environment %s"
+	errCreateDeploymentBranchPolicy = "cannot create deployment branch policy %s of environment %s"
+	errDeleteDeploymentBranchPolicy = "cannot delete deployment branch policy %s of environment %s"
+
+	deploymentBranchPolicyBranch = "branch"
+
+	protectionRuleWaitTimer         = "wait_timer"
+	protectionRuleRequiredReviewers = "required_reviewers"
+
+	reviewerTypeUser = "User"
+	reviewerTypeTeam = "Team"
+)
+
+// deploymentBranchPolicyRef is a name pattern of the branches or tags that
+// may deploy to an environment.
+type deploymentBranchPolicyRef struct {
+	name string
+	typ  string
+}
+
+// environmentState is the deployment branch policy of an environment on
+// GitHub, with the IDs of its custom deployment branch policies and what else
+// gates its deployments.
+type environmentState struct {
+	exists   bool
+	policy   *github.BranchPolicy
+	policies map[deploymentBranchPolicyRef]int64
+	gates    v1alpha1.RepositoryEnvironmentObservation
+}
+
+// desiredBranchPolicy returns the deployment branch policy of env, or nil if
+// any branch or tag may deploy to it.
+func desiredBranchPolicy(env v1alpha1.RepositoryEnvironment) *github.BranchPolicy {
+	switch {
+	case pointer.BoolDeref(env.ProtectedBranches, false):
+		return &github.BranchPolicy{ProtectedBranches: github.Bool(true), CustomBranchPolicies: github.Bool(false)}
+	case len(env.DeploymentBranchPolicies) > 0:
+		return &github.BranchPolicy{ProtectedBranches: github.Bool(false), CustomBranchPolicies: github.Bool(true)}
+	default:
+		return nil
+	}
+}
+
+func sameBranchPolicy(a, b *github.BranchPolicy) bool {
+	return a.GetProtectedBranches() == b.GetProtectedBranches() && a.GetCustomBranchPolicies() == b.GetCustomBranchPolicies()
+}
+
+func desiredDeploymentBranchPolicies(env v1alpha1.RepositoryEnvironment) map[deploymentBranchPolicyRef]bool {
+	refs := make(map[deploymentBranchPolicyRef]bool, len(env.DeploymentBranchPolicies))
+	for _, p := range env.DeploymentBranchPolicies {
+		refs[deploymentBranchPolicyRef{name: p.Name, typ: pointer.StringDeref(p.Type, deploymentBranchPolicyBranch)}] = true
+	}
+	return refs
+}
+
+// getEnvironmentState returns the deployment branch policy of an environment.
+// Its custom deployment branch policies are only read if it uses them.
+func getEnvironmentState(ctx context.Context, gh *ghclient.Client, org, repoName, name string) (environmentState, error) {
+	env, _, err := gh.Repositories.GetEnvironment(ctx, org, repoName, name)
+	if ghclient.Is404(err) {
+		return environmentState{}, nil
+	}
+	if err != nil {
+		return environmentState{}, errors.Wrapf(err, errGetEnvironment, name)
+	}
+
+	state := environmentState{exists: true, policy: env.DeploymentBranchPolicy, gates: environmentGates(name, env.ProtectionRules)}
+	if !state.policy.GetCustomBranchPolicies() {
+		return state, nil
+	}
+	policies, err := ghclient.ListAll(func(page int) ([]*github.DeploymentBranchPolicy, *github.Response, error) {
+		return gh.Repositories.ListDeploymentBranchPolicies(ctx, org, repoName, name, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
+	if err != nil {
+		return environmentState{}, errors.Wrapf(err, errListDeploymentBranchPolicies, name)
+	}
+	state.policies = make(map[deploymentBranchPolicyRef]int64, len(policies))
+	for _, p := range policies {
+		state.policies[deploymentBranchPolicyRef{name: p.GetName(), typ: p.GetType()}] = p.GetID()
+	}
+	return state, nil
+}
+
+// environmentGates returns the wait timer and the reviewers the protection
+// rules of an environment gate its deployments with. GitHub reports the users
+// and teams that review deployments, so their logins and slugs are known
+// without looking up their IDs.
+func environmentGates(name string, rules []*github.ProtectionRule) v1alpha1.RepositoryEnvironmentObservation {
+	gates := v1alpha1.RepositoryEnvironmentObservation{Name: name}
+	for _, rule := range rules {
+		switch rule.GetType() {
+		case protectionRuleWaitTimer:
+			gates.WaitTimer = rule.WaitTimer
+		case protectionRuleRequiredReviewers:
+			gates.PreventSelfReview = rule.PreventSelfReview
+			for _, r := range rule.Reviewers {
+				switch reviewer := r.Reviewer.(type) {
+				case *github.User:
+					gates.Reviewers = append(gates.Reviewers, v1alpha1.EnvironmentReviewer{Type: reviewerTypeUser, ID: reviewer.GetID(), Name: reviewer.GetLogin()})
+				case *github.Team:
+					gates.Reviewers = append(gates.Reviewers, v1alpha1.EnvironmentReviewer{Type: reviewerTypeTeam, ID: reviewer.GetID(), Name: reviewer.GetSlug()})
+				}
+			}
+		}
+	}
+	sort.Slice(gates.Reviewers, func(i, j int) bool {
+		a, b := gates.Reviewers[i], gates.Reviewers[j]
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Name < b.Name
+	})
+	return gates
+}
+
+// environmentUpToDate returns whether an environment on GitHub has the
+// deployment branch policy of env.
+func environmentUpToDate(env v1alpha1.RepositoryEnvironment, state environmentState) bool {
+	if !state.exists || !sameBranchPolicy(state.policy, desiredBranchPolicy(env)) {
+		return false
+	}
+	if !state.policy.GetCustomBranchPolicies() {
+		return true
+	}
+	desired := desiredDeploymentBranchPolicies(env)
+	if len(desired) != len(state.policies) {
+		return false
+	}
+	for ref := range state.policies {
+		if !desired[ref] {
+			return false
+		}
+	}
+	return true
+}
+
+// getOutdatedEnvironments returns the names of the environments of the spec
+// whose deployment branch policies differ from the ones on GitHub, and what
+// gates the deployments to the ones that exist.
+func getOutdatedEnvironments(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) ([]string, []v1alpha1.RepositoryEnvironmentObservation, error) {
+	var outdated []string
+	var gates []v1alpha1.RepositoryEnvironmentObservation
+	for _, env := range cr.Spec.ForProvider.Environments {
+		state, err := getEnvironmentState(ctx, gh, cr.Spec.ForProvider.Org, repoName, env.Name)
+		if err != nil {
+			return nil, nil, err
+		}
+		if state.exists {
+			gates = append(gates, state.gates)
+		}
+		if !environmentUpToDate(env, state) {
+			outdated = append(outdated, env.Name)
+		}
+	}
+	return outdated, gates, nil
+}
+
+// updateEnvironments creates the environments of the spec that don't exist,
+// and sets the deployment branch policies of the ones that differ.
+func updateEnvironments(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	org := cr.Spec.ForProvider.Org
+	for _, env := range cr.Spec.ForProvider.Environments {
+		state, err := getEnvironmentState(ctx, gh, org, repoName, env.Name)
+		if err != nil {
+			return err
+		}
+		if environmentUpToDate(env, state) {
+			continue
+		}
+
+		policy := desiredBranchPolicy(env)
+		if !state.exists || !sameBranchPolicy(state.policy, policy) {
+			if _, _, err := gh.Repositories.UpdateEnvironmentBranchPolicy(ctx, org, repoName, env.Name, policy); err != nil {
+				return errors.Wrapf(err, errUpdateEnvironment, env.Name)
+			}
+			// The custom deployment branch policies of the environment
+			// are only kept while it uses them.
+			state.policies = nil
+			if policy.GetCustomBranchPolicies() {
+				if state, err = getEnvironmentState(ctx, gh, org, repoName, env.Name); err != nil {
+					return err
+				}
+			}
+		}
+		if !policy.GetCustomBranchPolicies() {
+			continue
+		}
+
+		desired := desiredDeploymentBranchPolicies(env)
+		for ref, id := range state.policies {
+			if desired[ref] {
+				continue
+			}
+			if _, err := gh.Repositories.DeleteDeploymentBranchPolicy(ctx, org, repoName, env.Name, id); err != nil {
+				return errors.Wrapf(err, errDeleteDeploymentBranchPolicy, ref.name, env.Name)
+			}
+		}
+		for _, p := range env.DeploymentBranchPolicies {
+			ref := deploymentBranchPolicyRef{name: p.Name, typ: pointer.StringDeref(p.Type, deploymentBranchPolicyBranch)}
+			if _, ok := state.policies[ref]; ok || !desired[ref] {
+				continue
+			}
+			// A pattern listed twice is only created once.
+			delete(desired, ref)
+			if _, _, err := gh.Repositories.CreateDeploymentBranchPolicy(ctx, org, repoName, env.Name, &github.DeploymentBranchPolicyRequest{Name: github.String(ref.name), Type: github.String(ref.typ)}); err != nil {
+				return errors.Wrapf(err, errCreateDeploymentBranchPolicy, ref.name, env.Name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	// AnnotationKeyExportParameters requests the Repository controller to render
+	// the parameters that reproduce the live settings of the repository into
+	// the status of the Repository, when set to "true". Exporting needs several
+	// extra API calls per reconcile and should be removed once the spec is written.
+	AnnotationKeyExportParameters = "github.crossplane.io/export-parameters"
+
+	errExportParameters = "cannot export repository parameters"
+)
+
+// exportRequested reports whether the parameters of a Repository are to be exported.
+func exportRequested(cr *v1alpha1.Repository) bool {
+	return cr.GetAnnotations()[AnnotationKeyExportParameters] == "true"
+}
+
+// ExportParameters returns the RepositoryParameters that reproduce the current
+// settings of a GitHub repository, and the names of the settings that differ
+// from the GitHub defaults for new repositories but are not modeled by
+// RepositoryParameters.
+//
+//nolint:gocyclo
+func ExportParameters(ctx context.Context, gh *ghclient.Client, org string, repo *github.Repository) (*v1alpha1.RepositoryParameters, []string, error) {
+	name := repo.GetName()
+	params := &v1alpha1.RepositoryParameters{
+		Org:         org,
+		Description: repo.GetDescription(),
+		Archived:    github.Bool(repo.GetArchived()),
+		Private:     github.Bool(repo.GetPrivate()),
+		IsTemplate:  github.Bool(repo.GetIsTemplate()),
+
+		HasIssues:      repo.HasIssues,
+		HasWiki:        repo.HasWiki,
+		HasProjects:    repo.HasProjects,
+		HasDiscussions: repo.HasDiscussions,
+		HasDownloads:   repo.HasDownloads,
+	}
+
+	users, err := getRepoUsersWithPermissions(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, user := range sortedKeysOf(users) {
+		params.Permissions.Users = append(params.Permissions.Users, v1alpha1.RepositoryUser{User: user, Role: users[user]})
+	}
+
+	teams, err := getRepoTeamsWithPermissions(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, team := range sortedKeysOf(teams) {
+		params.Permissions.Teams = append(params.Permissions.Teams, v1alpha1.RepositoryTeam{Team: team, Role: teams[team]})
+	}
+
+	hooks, err := getRepoWebhooks(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	webhooks := getRepoWebhooksWithConfig(hooks)
+	for _, url := range sortedKeysOf(webhooks) {
+		params.Webhooks = append(params.Webhooks, webhooks[url])
+	}
+
+	patterns, err := getBranchPatternRules(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	protectedBranches, err := listProtectedBranches(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	branches, err := getBPRWithConfig(ctx, gh, org, name, withoutPatternProtected(protectedBranches, patterns, nil))
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, branch := range sortedKeysOf(branches) {
+		params.BranchProtectionRules = append(params.BranchProtectionRules, branches[branch])
+	}
+	for _, pattern := range sortedKeysOf(patterns.config) {
+		params.BranchProtectionRules = append(params.BranchProtectionRules, patterns.config[pattern])
+	}
+
+	ghRulesets, err := util.ListRulesets(ctx, gh, org, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	rulesets, err := util.RulesetsFromGitHub(ctx, gh, org, name, ghRulesets)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, ruleset := range sortedKeysOf(rulesets) {
+		params.RepositoryRules = append(params.RepositoryRules, rulesets[ruleset])
+	}
+
+	return params, unmodeledSettings(repo), nil
+}
+
+// unmodeledSettings returns the names of the settings of a GitHub repository
+// that differ from the defaults for new repositories, and that can't be
+// expressed in RepositoryParameters yet.
+func unmodeledSettings(repo *github.Repository) []string {
+	settings := []struct {
+		name  string
+		value *bool
+		def   bool
+	}{
+		{name: "allowMergeCommit", value: repo.AllowMergeCommit, def: true},
+		{name: "allowSquashMerge", value: repo.AllowSquashMerge, def: true},
+		{name: "allowRebaseMerge", value: repo.AllowRebaseMerge, def: true},
+		{name: "allowAutoMerge", value: repo.AllowAutoMerge, def: false},
+		{name: "allowUpdateBranch", value: repo.AllowUpdateBranch, def: false},
+		{name: "deleteBranchOnMerge", value: repo.DeleteBranchOnMerge, def: false},
+		{name: "webCommitSignoffRequired", value: repo.WebCommitSignoffRequired, def: false},
+	}
+
+	var res []string
+	for _, setting := range settings {
+		if setting.value != nil && *setting.value != setting.def {
+			res = append(res, setting.name)
+		}
+	}
+	if repo.GetHomepage() != "" {
+		res = append(res, "homepage")
+	}
+	if len(repo.Topics) > 0 {
+		res = append(res, "topics")
+	}
+	sort.Strings(res)
+	return res
+}
+
+// exportParameters renders the parameters of the repository into the status of
+// the Repository if requested, and clears them otherwise.
+func exportParameters(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repo *github.Repository) error {
+	if !exportRequested(cr) {
+		cr.Status.AtProvider.ExportedParameters = ""
+		cr.Status.AtProvider.UnmodeledSettings = nil
+		return nil
+	}
+
+	params, unmodeled, err := ExportParameters(ctx, gh, cr.Spec.ForProvider.Org, repo)
+	if err != nil {
+		return errors.Wrap(err, errExportParameters)
+	}
+	out, err := yaml.Marshal(params)
+	if err != nil {
+		return errors.Wrap(err, errExportParameters)
+	}
+	cr.Status.AtProvider.ExportedParameters = string(out)
+	cr.Status.AtProvider.UnmodeledSettings = unmodeled
+	return nil
+}
+
+func sortedKeysOf[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetOIDCSubjectClaim = "cannot get OIDC subject claim template"
+	errSetOIDCSubjectClaim = "cannot set OIDC subject claim template"
+)
+
+// oidcSubjectClaimUpToDate returns whether the subject claim template of a
+// repository is the one of the spec. The claims of a repository that uses the
+// template of its organization aren't compared, their order is.
+func oidcSubjectClaimUpToDate(claim *v1alpha1.OIDCSubjectClaim, tmpl *github.OIDCSubjectClaimCustomTemplate) bool {
+	if claim.UseDefault != tmpl.GetUseDefault() {
+		return false
+	}
+	return claim.UseDefault || slices.Equal(claim.IncludeClaimKeys, tmpl.IncludeClaimKeys)
+}
+
+// getOIDCSubjectClaimUpToDate returns whether the subject claim template of a
+// repository is the one of its spec.
+func getOIDCSubjectClaimUpToDate(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) (bool, error) {
+	tmpl, _, err := gh.Actions.GetRepoOIDCSubjectClaimCustomTemplate(ctx, cr.Spec.ForProvider.Org, repoName)
+	if err != nil {
+		return false, errors.Wrap(err, errGetOIDCSubjectClaim)
+	}
+	return oidcSubjectClaimUpToDate(cr.Spec.ForProvider.OIDCSubjectClaim, tmpl), nil
+}
+
+// updateOIDCSubjectClaim sets the subject claim template of a repository as
+// in its spec.
+func updateOIDCSubjectClaim(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	claim := cr.Spec.ForProvider.OIDCSubjectClaim
+	tmpl := &github.OIDCSubjectClaimCustomTemplate{UseDefault: github.Bool(claim.UseDefault)}
+	if !claim.UseDefault {
+		tmpl.IncludeClaimKeys = claim.IncludeClaimKeys
+	}
+	_, err := gh.Actions.SetRepoOIDCSubjectClaimCustomTemplate(ctx, cr.Spec.ForProvider.Org, repoName, tmpl)
+	return errors.Wrap(err, errSetOIDCSubjectClaim)
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const errListOrganizationOwners = "cannot list the owners of the organization"
+
+// withoutOrganizationOwners returns the collaborators of the repository
+// without the owners of the organization that aren't declared in the spec,
+// unless the Repository compares them too. Owners are admins of every
+// repository, so the organization is only asked for them if an undeclared
+// collaborator is an admin.
+func withoutOrganizationOwners(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, ghUsers map[string]string) (map[string]string, error) {
+	if !pointer.BoolDeref(cr.Spec.ForProvider.IgnoreOrganizationOwners, true) {
+		return ghUsers, nil
+	}
+	declared := declaredUsers(cr.Spec.ForProvider.Permissions.Users)
+	undeclaredAdmin := false
+	for user, role := range ghUsers {
+		if _, ok := declared[user]; !ok && role == "admin" {
+			undeclaredAdmin = true
+			break
+		}
+	}
+	if !undeclaredAdmin {
+		return ghUsers, nil
+	}
+
+	owners, err := ghclient.ListAll(func(page int) ([]*github.User, *github.Response, error) {
+		return gh.Organizations.ListMembers(ctx, cr.Spec.ForProvider.Org, &github.ListMembersOptions{
+			Role:        "admin",
+			ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page},
+		})
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListOrganizationOwners)
+	}
+
+	users := make(map[string]string, len(ghUsers))
+	for user, role := range ghUsers {
+		users[user] = role
+	}
+	for _, owner := range owners {
+		login := util.NormalizeName(owner.GetLogin())
+		if _, ok := declared[login]; !ok {
+			delete(users, login)
+		}
+	}
+	return users, nil
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+// preflightResult holds the problems found while validating the branch protection
+// rules and repository rulesets of a Repository, keyed by branch or ruleset name.
+type preflightResult struct {
+	branchProtectionRules map[string][]string
+	repositoryRules       map[string][]string
+}
+
+// valid reports whether pre-flight validation found no problems.
+func (p preflightResult) valid() bool {
+	return len(p.branchProtectionRules) == 0 && len(p.repositoryRules) == 0
+}
+
+// message renders the problems found into a single, stable condition message.
+func (p preflightResult) message() string {
+	var msgs []string
+	for _, k := range sortedKeys(p.branchProtectionRules) {
+		msgs = append(msgs, fmt.Sprintf("branch protection rule %q: %s", k, strings.Join(p.branchProtectionRules[k], ", ")))
+	}
+	for _, k := range sortedKeys(p.repositoryRules) {
+		msgs = append(msgs, fmt.Sprintf("repository ruleset %q: %s", k, strings.Join(p.repositoryRules[k], ", ")))
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func sortedKeys(m map[string][]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// preflightRules validates the branch protection rules and repository rulesets of
+// the supplied Repository before they are pushed to GitHub. Invalid entries are
+// reported rather than failing the whole Update, so that other pending changes
+// can still be applied.
+func preflightRules(cr *v1alpha1.Repository) preflightResult {
+	res := preflightResult{
+		branchProtectionRules: make(map[string][]string),
+		repositoryRules:       make(map[string][]string),
+	}
+
+	for i := range cr.Spec.ForProvider.BranchProtectionRules {
+		rule := cr.Spec.ForProvider.BranchProtectionRules[i]
+		if problems := validateBranchProtectionRule(rule); len(problems) > 0 {
+			res.branchProtectionRules[rule.Branch] = problems
+		}
+	}
+
+	for i := range cr.Spec.ForProvider.RepositoryRules {
+		rule := cr.Spec.ForProvider.RepositoryRules[i]
+		if problems := util.ValidateRuleset(rule); len(problems) > 0 {
+			res.repositoryRules[rule.Name] = problems
+		}
+	}
+
+	return res
+}
+
+// validateBranchProtectionRule returns the list of problems found in a BranchProtectionRule.
+func validateBranchProtectionRule(rule v1alpha1.RepositoryBranchProtectionRule) []string {
+	var problems []string
+
+	if rule.Branch == "" {
+		problems = append(problems, "branch must not be empty")
+	}
+
+	if rule.RequiredStatusChecks != nil {
+		for _, check := range rule.RequiredStatusChecks.Checks {
+			if check == nil || check.Context == "" {
+				problems = append(problems, "required status check context must not be empty")
+			}
+		}
+	}
+
+	if rule.RequiredPullRequestReviews != nil {
+		count := rule.RequiredPullRequestReviews.RequiredApprovingReviewCount
+		if count < 0 || count > 6 {
+			problems = append(problems, fmt.Sprintf("requiredApprovingReviewCount must be between 0 and 6, got %d", count))
+		}
+	}
+
+	if isBranchPattern(rule.Branch) {
+		problems = append(problems, validateBranchPattern(rule)...)
+	}
+
+	return problems
+}
+
+// validateBranchPattern returns the problems found in a BranchProtectionRule for a
+// branch pattern, including the settings the GraphQL API can't manage by name.
+func validateBranchPattern(rule v1alpha1.RepositoryBranchProtectionRule) []string {
+	var problems []string
+
+	if _, err := path.Match(rule.Branch, ""); err != nil {
+		problems = append(problems, fmt.Sprintf("branch pattern is malformed: %s", err))
+	}
+
+	return append(problems, util.UnsupportedGraphQLSettings(rule, "branch patterns")...)
+}
+
+// withoutInvalid removes the entries reported by pre-flight validation from the
+// supplied map, leaving the corresponding GitHub state untouched.
+func withoutInvalid[T any](m map[string]T, invalid map[string][]string) map[string]T {
+	for name := range invalid {
+		delete(m, name)
+	}
+	return m
+}
+
+// setPreflightCondition records the outcome of pre-flight validation on the Repository.
+func setPreflightCondition(cr *v1alpha1.Repository, res preflightResult) {
+	if cr.Spec.ForProvider.BranchProtectionRules == nil && cr.Spec.ForProvider.RepositoryRules == nil {
+		return
+	}
+	if res.valid() {
+		cr.SetConditions(v1alpha1.RulesValid())
+		return
+	}
+	cr.SetConditions(v1alpha1.RulesInvalid(res.message()))
+}
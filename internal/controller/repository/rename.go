@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetRepositoryByID = "cannot get repository by its ID"
+	errRenameRepository  = "cannot rename repository %s"
+)
+
+// renamedFrom returns the current name of the repository that the Repository
+// observed before, if the repository named by its external name doesn't exist.
+// The external name was then changed to rename the repository, which is looked
+// up by the ID recorded in the status. It returns an empty name if there is no
+// such repository, e.g. because it was deleted or never created.
+func renamedFrom(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, name string) (string, error) {
+	id := cr.Status.AtProvider.ID
+	if id == nil {
+		return "", nil
+	}
+	repo, _, err := gh.Repositories.GetByID(ctx, *id)
+	if ghclient.Is404(err) {
+		return "", nil
+	}
+	if err != nil {
+		return "", errors.Wrap(err, errGetRepositoryByID)
+	}
+	// The names of organizations and repositories are case-insensitive; a
+	// repository moved to another organization is not the one to rename.
+	if !strings.EqualFold(repo.GetOwner().GetLogin(), cr.Spec.ForProvider.Org) || strings.EqualFold(repo.GetName(), name) {
+		return "", nil
+	}
+	return repo.GetName(), nil
+}
+
+// renameRepository renames the repository from to the name of the external
+// name of the Repository, and records its previous name.
+func renameRepository(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, from, to string) error {
+	if _, _, err := gh.Repositories.Edit(ctx, cr.Spec.ForProvider.Org, from, &github.Repository{Name: &to}); err != nil {
+		return errors.Wrapf(err, errRenameRepository, from)
+	}
+	cr.Status.AtProvider.PreviousNames = append(cr.Status.AtProvider.PreviousNames, from)
+	return nil
+}
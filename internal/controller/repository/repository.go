@@ -18,19 +18,27 @@ package repository
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	"net/url"
 	"reflect"
+	"slices"
 	"sort"
+	"strings"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
 	"k8s.io/utils/pointer"
 
 	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/google/go-github/v62/github"
 	"github.com/gosimple/slug"
@@ -46,11 +54,40 @@ import (
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
 	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
 	"github.com/crossplane/provider-github/internal/util"
+	"github.com/crossplane/provider-github/internal/webhook"
 )
 
+// Management policies of the sub-resources of a repository that are not
+// declared in its spec, see e.g. RepositoryParameters.WebhookManagementPolicy.
+const (
+	policyFull   = "Full"
+	policyPatch  = "Patch"
+	policyReport = "Report"
+)
+
+// anyAppID is the app ID GitHub uses for a required status check that any
+// app is allowed to set.
+const anyAppID int64 = -1
+
+// creationGrace is how long after a repository was created changes of its
+// sub-resources that GitHub can't find yet are retried.
+const creationGrace = 15 * time.Second
+
+// maxConcurrentRequests bounds the requests made concurrently to observe the
+// sub-resources of a repository.
+const maxConcurrentRequests = 4
+
 const (
 	errNotRepository = "managed resource is not a Repository custom resource"
 	errTrackPCUsage  = "cannot track ProviderConfig usage"
@@ -58,6 +95,22 @@ const (
 	errGetCreds      = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errArchiveRepository   = "cannot archive repository"
+	errUnarchiveRepository = "cannot unarchive repository"
+	errDeleteUnconfirmed   = "cannot delete repository: set forceDelete to true to delete it on GitHub"
+
+	errFmtOwnerMismatch              = "owner %s of the external name does not match org %s"
+	reasonAccessExpired event.Reason = "AccessExpired"
+	reasonDrifted       event.Reason = "DriftDetected"
+)
+
+// Keys of the connection details published for a Repository.
+const (
+	ConnectionDetailCloneURL = "cloneUrl"
+	ConnectionDetailSSHURL   = "sshUrl"
+	ConnectionDetailHTMLURL  = "htmlUrl"
+	ConnectionDetailNodeID   = "nodeId"
 )
 
 // Setup adds a controller that reconciles Repository managed resources.
@@ -69,29 +122,39 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
-	r := managed.NewReconciler(mgr,
-		resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind),
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	opts := []managed.ReconcilerOption{
 		managed.WithExternalConnecter(&connector{
 			kube:        mgr.GetClient(),
 			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
 			newClientFn: ghclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
-		managed.WithConnectionPublishers(cps...))
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...),
+	}
+	if o.Features.Enabled(features.EnableAlphaManagementPolicies) {
+		opts = append(opts, managed.WithManagementPolicies())
+	}
+
+	r := managed.NewReconciler(mgr, resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind), opts...)
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.Repository{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.Repository{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.RepositoryList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		WatchesRawSource(webhook.Source(v1alpha1.RepositoryGroupVersionKind.GroupKind()), &handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.RepositoryGroupVersionKind), r), o.GlobalRateLimiter))
 }
 
 type connector struct {
 	kube        client.Client
 	usage       resource.Tracker
-	newClientFn func(string) (*ghclient.Client, error)
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -115,16 +178,31 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	gh, err := c.newClientFn(string(data))
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{github: gh}, nil
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.RepositoryKind, &external{github: gh, kube: c.kube, recorder: c.recorder}))))), nil
 }
 
 type external struct {
-	github *ghclient.Client
+	github   *ghclient.Client
+	kube     client.Reader
+	recorder event.Recorder
+
+	// drift is what the last Observe found to differ, nil if it didn't
+	// compare the sub-resources.
+	drift *drift
+
+	// renameFrom is the current name of the repository if the last Observe
+	// found its external name changed to rename it.
+	renameFrom string
 }
 
 //nolint:gocyclo
@@ -134,131 +212,648 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, errors.New(errNotRepository)
 	}
 
-	name := meta.GetExternalName(cr)
+	c.drift = nil
+	c.renameFrom = ""
+	org := cr.Spec.ForProvider.Org
+	name, err := repositoryName(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
 
 	repo, _, err := c.github.Repositories.Get(ctx, cr.Spec.ForProvider.Org, name)
 	if ghclient.Is404(err) {
-		return managed.ExternalObservation{ResourceExists: false}, nil
+		// A changed external name renames the repository rather than
+		// creating a new one.
+		from, err := renamedFrom(ctx, c.github, cr, name)
+		if err != nil || from == "" {
+			return managed.ExternalObservation{ResourceExists: false}, err
+		}
+		c.renameFrom = from
+		return managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "name"}, nil
 	}
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
+	setObservation(cr, repo)
+	lateInitialized := lateInitialize(&cr.Spec.ForProvider, repo) || org != cr.Spec.ForProvider.Org
+
+	if err := exportParameters(ctx, c.github, cr, repo); err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	details := connectionDetails(repo)
 	notUpToDate := managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: false,
+		ResourceExists:          true,
+		ResourceUpToDate:        false,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       details,
+	}
+
+	cr.Status.AtProvider.Drift = nil
+
+	// An archived repository is read-only, so it is up to date unless the
+	// spec explicitly asks to unarchive it.
+	if repo.GetArchived() && pointer.BoolDeref(cr.Spec.ForProvider.Archived, true) {
+		cr.SetConditions(v1alpha1.Archived(), xpv1.Available())
+		return managed.ExternalObservation{
+			ResourceExists:          true,
+			ResourceUpToDate:        true,
+			ResourceLateInitialized: lateInitialized,
+			ConnectionDetails:       details,
+		}, nil
+	}
+
+	cr.SetConditions(v1alpha1.NotArchived())
+
+	if len(getPendingBootstrapActions(cr)) > 0 {
+		cr.SetConditions(waitingFor("bootstrap actions"))
+		return notUpToDate, nil
 	}
 
+	skipped := &permissions.Skipped{}
+	defer func() { cr.SetConditions(skipped.Condition()) }()
+
+	undeclared := &undeclaredRules{}
+	defer func() {
+		if c, ok := undeclared.Condition(); ok {
+			cr.SetConditions(c)
+		}
+	}()
+
+	// Rules that fail pre-flight validation are never applied, so they are
+	// left out of the comparison rather than keeping the repository from
+	// being up to date.
+	preflight := preflightRules(cr)
+	setPreflightCondition(cr, preflight)
+
+	// The sub-resources are fetched concurrently and compared in order
+	// afterwards, their errors are kept apart to tell skipped ones apart.
+	// All of them are compared to report every difference at once.
 	crMToPermission := getUserPermissionMapFromCr(cr.Spec.ForProvider.Permissions.Users)
-	ghMToPermission, err := getRepoUsersWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, name)
+	crTToPermission := getTeamPermissionMapFromCr(cr.Spec.ForProvider.Permissions.Teams)
+	var (
+		ghMToPermission, ghTToPermission map[string]string
+		ghRepoWebhooks                   []*github.Hook
+		webhookObs                       []v1alpha1.RepositoryWebhookObservation
+		bprUpToDate, rulesUpToDate       bool
+
+		errUsers, errTeams, errWebhooks, errBPR, errRules error
+	)
+	g := &errgroup.Group{}
+	g.SetLimit(maxConcurrentRequests)
+	g.Go(func() error {
+		ghMToPermission, errUsers = getRepoUsersWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, name)
+		if errUsers == nil {
+			ghMToPermission, errUsers = withoutOrganizationOwners(ctx, c.github, cr, ghMToPermission)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		ghTToPermission, errTeams = getRepoTeamsWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, name)
+		if errTeams == nil {
+			ghTToPermission, errTeams = withCustomTeamRoles(ctx, c.github, cr.Spec.ForProvider.Org, name, crTToPermission, ghTToPermission)
+		}
+		return nil
+	})
+	if cr.Spec.ForProvider.Webhooks != nil {
+		g.Go(func() error {
+			ghRepoWebhooks, errWebhooks = getRepoWebhooks(ctx, c.github, cr.Spec.ForProvider.Org, name)
+			if errWebhooks == nil {
+				webhookObs, errWebhooks = observeWebhookDeliveries(ctx, c.github, cr.Spec.ForProvider.Org, name, ghRepoWebhooks, cr.Spec.ForProvider.Webhooks)
+			}
+			return nil
+		})
+	}
+	if cr.Spec.ForProvider.BranchProtectionRules != nil {
+		g.Go(func() error {
+			bprUpToDate, errBPR = observeBranchProtectionRules(ctx, c.github, c.kube, cr, name, preflight.branchProtectionRules, undeclared)
+			return nil
+		})
+	}
+	if cr.Spec.ForProvider.RepositoryRules != nil {
+		g.Go(func() error {
+			rulesUpToDate, errRules = observeRepositoryRules(ctx, c.github, c.kube, cr, name, preflight.repositoryRules, undeclared)
+			return nil
+		})
+	}
+	_ = g.Wait()
 
+	skip, err := skipped.Skip("collaborators", errUsers)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
-	if !reflect.DeepEqual(util.SortByKey(ghMToPermission), util.SortByKey(crMToPermission)) {
-		return notUpToDate, nil
+	var differs []string
+	values := driftValues{}
+	ghMToPermission = withoutUnmanagedGrants(cr, ghMToPermission, declaredUsers(cr.Spec.ForProvider.Permissions.Users))
+	if !skip {
+		differs = append(differs, differingKeys("permissions.users", ghMToPermission, crMToPermission, values)...)
 	}
 
-	crTToPermission := getTeamPermissionMapFromCr(cr.Spec.ForProvider.Permissions.Teams)
-	ghTToPermission, err := getRepoTeamsWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, name)
+	skip, err = skipped.Skip("teams", errTeams)
 	if err != nil {
 		return managed.ExternalObservation{}, err
 	}
 
-	if !reflect.DeepEqual(util.SortByKey(ghTToPermission), util.SortByKey(crTToPermission)) {
-		return notUpToDate, nil
+	ghTToPermission = withoutUnmanagedGrants(cr, ghTToPermission, crTToPermission)
+	if !skip {
+		differs = append(differs, differingKeys("permissions.teams", ghTToPermission, crTToPermission, values)...)
 	}
+	setObservedCondition(cr, v1alpha1.TypePermissionsSynced, len(differs) == 0, errUsers, errTeams)
 
 	if cr.Spec.ForProvider.Webhooks != nil {
-		ghRepoWebhooks, err := getRepoWebhooks(ctx, c.github, cr.Spec.ForProvider.Org, name)
+		// GitHub accepts events it never delivers, so they are reported
+		// without making the repository differ from its spec.
+		cr.SetConditions(webhookEventsCondition(cr.Spec.ForProvider.Webhooks))
+		skip, err := skipped.Skip("webhooks", errWebhooks)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
 		crWToConfig := getRepoWebhooksMapFromCr(cr.Spec.ForProvider.Webhooks)
-		ghWToConfig := getRepoWebhooksWithConfig(ghRepoWebhooks)
+		ghWToConfig := withoutUnmanagedWebhooks(cr, getRepoWebhooksWithConfig(ghRepoWebhooks), crWToConfig)
 
-		if !reflect.DeepEqual(ghWToConfig, crWToConfig) {
-			return notUpToDate, nil
+		webhooksDiffer := differingKeys("webhooks", ghWToConfig, crWToConfig, values)
+		if !skip {
+			differs = append(differs, webhooksDiffer...)
+			cr.Status.AtProvider.Webhooks = webhookObs
 		}
+		setObservedCondition(cr, v1alpha1.TypeWebhooksSynced, len(webhooksDiffer) == 0, errWebhooks)
 	}
 
 	if cr.Spec.ForProvider.BranchProtectionRules != nil {
-		protectedBranches, err := listProtectedBranches(ctx, c.github, cr.Spec.ForProvider.Org, name)
+		skip, err := skipped.Skip("branch protection rules", errBPR)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
-		crBPRToConfig := getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules)
-		ghBPRToConfig, err := getBPRWithConfig(ctx, c.github, cr.Spec.ForProvider.Org, name, protectedBranches)
+		if !skip && !bprUpToDate {
+			cr.SetConditions(waitingFor("branch protection rules"))
+			differs = append(differs, "branchProtectionRules")
+		}
+		setObservedCondition(cr, v1alpha1.TypeProtectionsSynced, bprUpToDate, errBPR)
+	}
+
+	if cr.Spec.ForProvider.RepositoryRules != nil {
+		skip, err := skipped.Skip("repository rulesets", errRules)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
+		if !skip && !rulesUpToDate {
+			cr.SetConditions(waitingFor("repository rulesets"))
+			differs = append(differs, "repositoryRules")
+		}
+		setObservedCondition(cr, v1alpha1.TypeRulesetsSynced, rulesUpToDate, errRules)
+	}
 
-		if !cmp.Equal(crBPRToConfig, ghBPRToConfig) {
-			return notUpToDate, nil
+	if cr.Spec.ForProvider.CustomProperties != nil {
+		outdated, err := getOutdatedCustomProperties(ctx, c.github, cr, name)
+		skip, err := skipped.Skip("custom properties", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			for _, p := range outdated {
+				differs = append(differs, fmt.Sprintf("customProperties[%s]", p.PropertyName))
+			}
 		}
 	}
 
-	if cr.Spec.ForProvider.RepositoryRules != nil {
-		ghRepositoryRules, _ := getRepositoryRules(ctx, c.github, cr.Spec.ForProvider.Org, name)
+	if cr.Spec.ForProvider.DependabotSecrets != nil {
+		store := dependabotSecretStore{c.github.Dependabot}
+		upToDate, err := observeRepoSecrets(ctx, c.kube, store, cr.Spec.ForProvider.Org, name, cr.Spec.ForProvider.DependabotSecrets, cr.Status.AtProvider.DependabotSecretVersions)
+		skip, err := skipped.Skip("dependabot secrets", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && !upToDate {
+			differs = append(differs, "dependabotSecrets")
+		}
+	}
 
-		crRepositoryRulesToConfig := getRepositoryRulesMapFromCr(cr.Spec.ForProvider.RepositoryRules)
-		ghRepositoryRulesToConfig, err := getRepositoryRulesWithConfig(ctx, c.github, cr.Spec.ForProvider.Org, name, ghRepositoryRules)
+	if cr.Spec.ForProvider.CodespacesSecrets != nil {
+		store := codespacesSecretStore{c.github.Codespaces}
+		upToDate, err := observeRepoSecrets(ctx, c.kube, store, cr.Spec.ForProvider.Org, name, cr.Spec.ForProvider.CodespacesSecrets, cr.Status.AtProvider.CodespacesSecretVersions)
+		skip, err := skipped.Skip("codespaces secrets", err)
 		if err != nil {
 			return managed.ExternalObservation{}, err
 		}
+		if !skip && !upToDate {
+			differs = append(differs, "codespacesSecrets")
+		}
+	}
 
-		if !cmp.Equal(crRepositoryRulesToConfig, ghRepositoryRulesToConfig) {
-			return notUpToDate, nil
+	if cr.Spec.ForProvider.Environments != nil {
+		outdated, gates, err := getOutdatedEnvironments(ctx, c.github, cr, name)
+		skip, err := skipped.Skip("environments", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			cr.Status.AtProvider.Environments = gates
+			for _, env := range outdated {
+				differs = append(differs, fmt.Sprintf("environments[%s]", env))
+			}
+		}
+	}
+
+	if cr.Spec.ForProvider.OIDCSubjectClaim != nil {
+		upToDate, err := getOIDCSubjectClaimUpToDate(ctx, c.github, cr, name)
+		skip, err := skipped.Skip("OIDC subject claim", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && !upToDate {
+			differs = append(differs, "oidcSubjectClaim")
+		}
+	}
+
+	if cr.Spec.ForProvider.CodeScanning != nil {
+		upToDate, err := getCodeScanningUpToDate(ctx, c.github, cr, name)
+		skip, err := skipped.Skip("code scanning", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && !upToDate {
+			differs = append(differs, "codeScanning")
+		}
+	}
+
+	if cr.Spec.ForProvider.SecretScanningDelegatedBypass != nil {
+		_, upToDate, err := getDelegatedBypassState(ctx, c.github, cr, name)
+		skip, err := skipped.Skip("secret scanning delegated bypass", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip && !upToDate {
+			differs = append(differs, "secretScanningDelegatedBypass")
+		}
+	}
+
+	// Errors in the CODEOWNERS file are reported, but don't make the
+	// repository differ from its spec.
+	if pointer.BoolDeref(cr.Spec.ForProvider.ValidateCodeowners, false) {
+		cond, err := codeownersCondition(ctx, c.github, cr.Spec.ForProvider.Org, name)
+		skip, err := skipped.Skip("CODEOWNERS errors", err)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if !skip {
+			cr.SetConditions(cond)
 		}
 	}
 
 	archivedCr := pointer.BoolDeref(cr.Spec.ForProvider.Archived, false)
 	if archivedCr != *repo.Archived {
-		return notUpToDate, nil
+		differs = append(differs, "archived")
+		values.set("archived", archivedCr, *repo.Archived)
 	}
 
 	// repo visibility makes sense only when a repo is not a fork
 	if !*repo.Fork {
 		privateCr := pointer.BoolDeref(cr.Spec.ForProvider.Private, true)
 		if privateCr != *repo.Private {
-			return notUpToDate, nil
+			differs = append(differs, "private")
+			values.set("private", privateCr, *repo.Private)
 		}
 	}
 
 	isTemplate := pointer.BoolDeref(cr.Spec.ForProvider.IsTemplate, false)
 	if isTemplate != *repo.IsTemplate {
+		differs = append(differs, "isTemplate")
+		values.set("isTemplate", isTemplate, *repo.IsTemplate)
+	}
+
+	if cr.Spec.ForProvider.Description != repo.GetDescription() {
+		differs = append(differs, "description")
+		values.set("description", cr.Spec.ForProvider.Description, repo.GetDescription())
+	}
+
+	for _, t := range featureToggles(&cr.Spec.ForProvider, repo) {
+		if *t.desired != nil && t.observed != nil && **t.desired != *t.observed {
+			differs = append(differs, t.name)
+			values.set(t.name, **t.desired, *t.observed)
+		}
+	}
+
+	requiredTopics, err := getRequiredTopics(ctx, c.kube, cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+	if len(missingTopics(requiredTopics, repo.Topics)) > 0 {
+		differs = append(differs, "topics")
+	}
+
+	if len(differs) > 0 {
+		msg := "differs from GitHub: " + strings.Join(differs, ", ")
+		cr.SetConditions(v1alpha1.Drifted(msg))
+		c.recorder.Event(cr, event.Normal(reasonDrifted, "Repository "+msg))
+		// Update only writes what differs.
+		c.drift = newDrift(differs, skipped)
+		cr.Status.AtProvider.Drift = newDriftReport(differs, values)
+		notUpToDate.Diff = strings.Join(differs, ", ")
 		return notUpToDate, nil
 	}
 
-	cr.SetConditions(xpv1.Available())
+	cr.SetConditions(v1alpha1.UpToDate(), xpv1.Available())
 
 	return managed.ExternalObservation{
-		ResourceExists:   true,
-		ResourceUpToDate: true,
+		ResourceExists:          true,
+		ResourceUpToDate:        true,
+		ResourceLateInitialized: lateInitialized,
+		ConnectionDetails:       details,
 	}, nil
 }
 
+// repositoryName returns the name of the repository from its external name,
+// which is either the bare name or of the form owner/name. The owner
+// initializes the org of the repository if it is unset, and must match it
+// otherwise.
+func repositoryName(cr *v1alpha1.Repository) (string, error) {
+	owner, name := v1alpha1.ParseRepositoryExternalName(meta.GetExternalName(cr))
+	if owner == "" {
+		return name, nil
+	}
+	if cr.Spec.ForProvider.Org == "" {
+		cr.Spec.ForProvider.Org = owner
+	}
+	if cr.Spec.ForProvider.Org != owner {
+		return "", errors.Errorf(errFmtOwnerMismatch, owner, cr.Spec.ForProvider.Org)
+	}
+	return name, nil
+}
+
+// lateInitialize fills the unset parameters with the observed values of the
+// repository, so that omitted fields aren't compared against their defaults
+// and overwritten. It returns whether any parameter was set. Archived is left
+// unset, so that a repository that is archived on GitHub is only unarchived if
+// the spec explicitly asks for it.
+func lateInitialize(p *v1alpha1.RepositoryParameters, repo *github.Repository) bool {
+	li := false
+	if p.Description == "" && repo.GetDescription() != "" {
+		p.Description = repo.GetDescription()
+		li = true
+	}
+	if p.Private == nil && repo.Private != nil {
+		p.Private = pointer.Bool(*repo.Private)
+		li = true
+	}
+	if p.IsTemplate == nil && repo.IsTemplate != nil {
+		p.IsTemplate = pointer.Bool(*repo.IsTemplate)
+		li = true
+	}
+	for _, t := range featureToggles(p, repo) {
+		if *t.desired == nil && t.observed != nil {
+			*t.desired = pointer.Bool(*t.observed)
+			li = true
+		}
+	}
+	return li
+}
+
+// featureToggle is a has_* setting of a repository, e.g. has_wiki.
+type featureToggle struct {
+	name     string
+	desired  **bool
+	observed *bool
+}
+
+// featureToggles returns the has_* settings of the parameters of a repository
+// with their observed values.
+func featureToggles(p *v1alpha1.RepositoryParameters, repo *github.Repository) []featureToggle {
+	return []featureToggle{
+		{name: "hasIssues", desired: &p.HasIssues, observed: repo.HasIssues},
+		{name: "hasWiki", desired: &p.HasWiki, observed: repo.HasWiki},
+		{name: "hasProjects", desired: &p.HasProjects, observed: repo.HasProjects},
+		{name: "hasDiscussions", desired: &p.HasDiscussions, observed: repo.HasDiscussions},
+		{name: "hasDownloads", desired: &p.HasDownloads, observed: repo.HasDownloads},
+	}
+}
+
+// setObservation records the observed values of a repository in its status.
+func setObservation(cr *v1alpha1.Repository, repo *github.Repository) {
+	o := &cr.Status.AtProvider
+	o.ID = repo.ID
+	o.NodeID = repo.GetNodeID()
+	o.FullName = repo.GetFullName()
+	o.HTMLURL = repo.GetHTMLURL()
+	o.SSHURL = repo.GetSSHURL()
+	o.CloneURL = repo.GetCloneURL()
+	o.DefaultBranch = repo.GetDefaultBranch()
+	o.Visibility = repo.GetVisibility()
+	o.Archived = repo.Archived
+	o.CreatedAt = toTime(repo.CreatedAt)
+	o.UpdatedAt = toTime(repo.UpdatedAt)
+	o.PushedAt = toTime(repo.PushedAt)
+}
+
+func toTime(t *github.Timestamp) *metav1.Time {
+	if t == nil {
+		return nil
+	}
+	mt := metav1.NewTime(t.Time)
+	return &mt
+}
+
+// connectionDetails returns the details of a repository that are published to
+// the connection secret, so that they can be consumed by other resources.
+// Details GitHub didn't return are omitted.
+func connectionDetails(repo *github.Repository) managed.ConnectionDetails {
+	var details managed.ConnectionDetails
+	for k, v := range map[string]string{
+		ConnectionDetailCloneURL: repo.GetCloneURL(),
+		ConnectionDetailSSHURL:   repo.GetSSHURL(),
+		ConnectionDetailHTMLURL:  repo.GetHTMLURL(),
+		ConnectionDetailNodeID:   repo.GetNodeID(),
+	} {
+		if v == "" {
+			continue
+		}
+		if details == nil {
+			details = managed.ConnectionDetails{}
+		}
+		details[k] = []byte(v)
+	}
+	return details
+}
+
+// observeBranchProtectionRules returns whether the branch protection rules of
+// the repository are up to date, and records the rules that are pending
+// because their branches don't exist yet. Rules listed in invalid failed
+// pre-flight validation and are not compared.
+func observeBranchProtectionRules(ctx context.Context, gh *ghclient.Client, kube client.Reader, cr *v1alpha1.Repository, name string, invalid map[string][]string, undeclared *undeclaredRules) (bool, error) {
+	crBPRToConfig, crPatternToConfig := splitBranchPatterns(withoutInvalid(getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules), invalid))
+	ghPatternRules, err := getBranchPatternRules(ctx, gh, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return false, err
+	}
+	protectedBranches, err := listProtectedBranches(ctx, gh, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return false, err
+	}
+	protectedBranches = withoutPatternProtected(protectedBranches, ghPatternRules, crBPRToConfig)
+	ghBPRToConfig, err := getBPRWithConfig(ctx, gh, cr.Spec.ForProvider.Org, name, protectedBranches)
+	if err != nil {
+		return false, err
+	}
+	standalone, err := standaloneBranchProtectionRules(ctx, kube, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return false, err
+	}
+	ghBPRToConfig = withoutBranches(withoutInvalid(ghBPRToConfig, invalid), standalone)
+	ghPatternRules.config = withoutBranches(withoutInvalid(ghPatternRules.config, invalid), standalone)
+	policy := cr.Spec.ForProvider.BranchProtectionManagementPolicy
+	ghBPRToConfig, undeclaredBranches := withoutUndeclared(policy, ghBPRToConfig, crBPRToConfig)
+	ghPatternConfig, undeclaredPatterns := withoutUndeclared(policy, ghPatternRules.config, crPatternToConfig)
+	undeclared.Add(policy, "branch protection rule", append(undeclaredBranches, undeclaredPatterns...))
+
+	pending, err := getPendingBranches(ctx, gh, cr.Spec.ForProvider.Org, name, crBPRToConfig, ghBPRToConfig)
+	if err != nil {
+		return false, err
+	}
+	setPendingBranches(cr, pending)
+	crBPRToConfig = withoutBranches(crBPRToConfig, pending)
+
+	return cmp.Equal(crBPRToConfig, ghBPRToConfig) && cmp.Equal(crPatternToConfig, ghPatternConfig), nil
+}
+
+// observeRepositoryRules returns whether the rulesets of the repository are up
+// to date. Rulesets listed in invalid failed pre-flight validation and are not
+// compared.
+func observeRepositoryRules(ctx context.Context, gh *ghclient.Client, kube client.Reader, cr *v1alpha1.Repository, name string, invalid map[string][]string, undeclared *undeclaredRules) (bool, error) {
+	ghRepositoryRules, err := util.ListRulesets(ctx, gh, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return false, err
+	}
+
+	trackRulesets(cr, ghRepositoryRules)
+
+	crRepositoryRulesToConfig := withoutInvalid(util.NormalizeRulesets(cr.Spec.ForProvider.RepositoryRules), invalid)
+	if err := util.ResolveRulesetReferences(ctx, gh, cr.Spec.ForProvider.Org, name, crRepositoryRulesToConfig); err != nil {
+		return false, err
+	}
+	ghRepositoryRulesToConfig, err := util.RulesetsFromGitHub(ctx, gh, cr.Spec.ForProvider.Org, name, ghRepositoryRules)
+	if err != nil {
+		return false, err
+	}
+	standalone, err := standaloneRulesets(ctx, kube, cr.Spec.ForProvider.Org, name)
+	if err != nil {
+		return false, err
+	}
+	ghRepositoryRulesToConfig = withoutRulesets(withoutInvalid(ghRepositoryRulesToConfig, invalid), standalone)
+	ghRepositoryRulesToConfig, undeclaredRulesets := withoutUndeclared(cr.Spec.ForProvider.RulesetManagementPolicy, ghRepositoryRulesToConfig, crRepositoryRulesToConfig)
+	undeclared.Add(cr.Spec.ForProvider.RulesetManagementPolicy, "repository ruleset", undeclaredRulesets)
+
+	return cmp.Equal(crRepositoryRulesToConfig, ghRepositoryRulesToConfig), nil
+}
+
+// waitingFor returns a condition indicating that the repository exists, but that
+// the declared sub-resources are not yet confirmed present. Consumers of the
+// repository must not rely on it until they are.
+func waitingFor(what string) xpv1.Condition {
+	return xpv1.Unavailable().WithMessage(fmt.Sprintf("waiting for %s", what))
+}
+
 func getTeamPermissionMapFromCr(teams []v1alpha1.RepositoryTeam) map[string]string {
 	crTToPermission := make(map[string]string, len(teams))
 	for _, team := range teams {
 		teamSlug := slug.Make(team.Team)
-		crTToPermission[teamSlug] = team.Role
+		crTToPermission[teamSlug] = rolePermission(team.Role)
 	}
 
 	return crTToPermission
 }
 
+// getUserPermissionMapFromCr returns the permissions of the users whose access
+// has not expired.
 func getUserPermissionMapFromCr(users []v1alpha1.RepositoryUser) map[string]string {
 	crMToPermission := make(map[string]string, len(users))
 
 	for _, user := range users {
-		crMToPermission[user.User] = user.Role
+		if isExpired(user) {
+			continue
+		}
+		crMToPermission[util.NormalizeName(user.User)] = rolePermission(user.Role)
 	}
 
 	return crMToPermission
 }
 
+// declaredUsers returns the normalized names of all users in the spec,
+// including the ones whose access has expired.
+func declaredUsers(users []v1alpha1.RepositoryUser) map[string]string {
+	declared := make(map[string]string, len(users))
+	for _, user := range users {
+		declared[util.NormalizeName(user.User)] = user.Role
+	}
+	return declared
+}
+
+// differingKeys returns the keys whose values differ between the GitHub and
+// the desired state of a sub-resource at path, e.g. permissions.teams[devs].
+func differingKeys[T any](path string, gh, cr map[string]T, values driftValues) []string {
+	var keys []string
+	for k, v := range cr {
+		if ghV, ok := gh[k]; !ok || !reflect.DeepEqual(ghV, v) {
+			key := fmt.Sprintf("%s[%s]", path, k)
+			keys = append(keys, key)
+			var observed any
+			if ok {
+				observed = ghV
+			}
+			values.set(key, v, observed)
+		}
+	}
+	for k, ghV := range gh {
+		if _, ok := cr[k]; !ok {
+			key := fmt.Sprintf("%s[%s]", path, k)
+			keys = append(keys, key)
+			values.set(key, nil, ghV)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// withoutUnmanagedGrants returns the grants of the repository without the
+// ones for users or teams that are not declared in the spec, if the permission
+// management policy is Patch.
+func withoutUnmanagedGrants(cr *v1alpha1.Repository, ghGrants, declared map[string]string) map[string]string {
+	grants, _ := withoutUndeclared(cr.Spec.ForProvider.PermissionManagementPolicy, ghGrants, declared)
+	return grants
+}
+
+// isExpired reports whether the access of user has expired.
+func isExpired(user v1alpha1.RepositoryUser) bool {
+	return user.ExpiresAt != nil && !time.Now().Before(user.ExpiresAt.Time)
+}
+
+// webhookKey returns the identity of a webhook, its URL with the scheme and
+// host in lower case and without the default port of the scheme, so that a
+// webhook is matched whatever the order of the webhooks in the spec and of the
+// ones GitHub lists, and however GitHub spells its URL. The path is kept as it
+// is, the receiver may tell paths that differ by a trailing slash apart.
+func webhookKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return rawURL
+	}
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	if port := u.Port(); (u.Scheme == "https" && port == "443") || (u.Scheme == "http" && port == "80") {
+		u.Host = u.Hostname()
+	}
+	return u.String()
+}
+
+// normalizeWebhookEvents returns the sorted copy of events without duplicates,
+// as GitHub lists the events of a webhook in no particular order and only
+// once each.
+func normalizeWebhookEvents(events []string) []string {
+	res := slices.Clone(events)
+	sort.Strings(res)
+	return slices.Compact(res)
+}
+
 func getRepoWebhooksMapFromCr(webhooks []v1alpha1.RepositoryWebhook) map[string]v1alpha1.RepositoryWebhook {
 	crWToConfig := make(map[string]v1alpha1.RepositoryWebhook, len(webhooks))
 
@@ -267,14 +862,11 @@ func getRepoWebhooksMapFromCr(webhooks []v1alpha1.RepositoryWebhook) map[string]
 		insecureSsl := util.BoolDerefToPointer(webhook.InsecureSsl, false)
 		active := util.BoolDerefToPointer(webhook.Active, true)
 
-		// sort events to aid comparison between desired and actual state
-		sort.Strings(webhook.Events)
-
-		crWToConfig[webhook.Url] = v1alpha1.RepositoryWebhook{
+		crWToConfig[webhookKey(webhook.Url)] = v1alpha1.RepositoryWebhook{
 			Url:         webhook.Url,
 			InsecureSsl: insecureSsl,
 			ContentType: webhook.ContentType,
-			Events:      webhook.Events,
+			Events:      normalizeWebhookEvents(webhook.Events),
 			Active:      active,
 		}
 	}
@@ -282,23 +874,9 @@ func getRepoWebhooksMapFromCr(webhooks []v1alpha1.RepositoryWebhook) map[string]
 }
 
 func getRepoWebhooks(ctx context.Context, gh *ghclient.Client, org, repoName string) ([]*github.Hook, error) {
-	opt := &github.ListOptions{PerPage: 100}
-	var allHooks []*github.Hook
-
-	for {
-		hooks, resp, err := gh.Repositories.ListHooks(ctx, org, repoName, opt)
-		if err != nil {
-			return nil, err
-		}
-		allHooks = append(allHooks, hooks...)
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
-	}
-
-	return allHooks, nil
+	return ghclient.ListAll(func(page int) ([]*github.Hook, *github.Response, error) {
+		return gh.Repositories.ListHooks(ctx, org, repoName, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
 }
 
 func getRepoWebhooksWithConfig(hooks []*github.Hook) map[string]v1alpha1.RepositoryWebhook {
@@ -311,11 +889,11 @@ func getRepoWebhooksWithConfig(hooks []*github.Hook) map[string]v1alpha1.Reposit
 		if h.Config.InsecureSSL != nil && *h.Config.InsecureSSL == "1" {
 			insecureSslBool = true
 		}
-		wToConfig[url] = v1alpha1.RepositoryWebhook{
+		wToConfig[webhookKey(url)] = v1alpha1.RepositoryWebhook{
 			Url:         url,
 			InsecureSsl: &insecureSslBool,
 			ContentType: contentType,
-			Events:      h.Events,
+			Events:      normalizeWebhookEvents(h.Events),
 			Active:      h.Active,
 		}
 	}
@@ -323,10 +901,17 @@ func getRepoWebhooksWithConfig(hooks []*github.Hook) map[string]v1alpha1.Reposit
 	return wToConfig
 }
 
-func getRepoWebhookId(hooks []*github.Hook, webhookUrl string) (*int64, error) {
+// withoutUnmanagedWebhooks returns the webhooks of the repository without the
+// ones that are not in the spec, if the webhook management policy is Patch.
+func withoutUnmanagedWebhooks(cr *v1alpha1.Repository, ghHooks, crHooks map[string]v1alpha1.RepositoryWebhook) map[string]v1alpha1.RepositoryWebhook {
+	hooks, _ := withoutUndeclared(cr.Spec.ForProvider.WebhookManagementPolicy, ghHooks, crHooks)
+	return hooks
+}
 
+func getRepoWebhookId(hooks []*github.Hook, webhookUrl string) (*int64, error) {
+	key := webhookKey(webhookUrl)
 	for _, h := range hooks {
-		if h.Config.GetURL() == webhookUrl {
+		if webhookKey(h.Config.GetURL()) == key {
 			return h.ID, nil
 		}
 	}
@@ -335,60 +920,152 @@ func getRepoWebhookId(hooks []*github.Hook, webhookUrl string) (*int64, error) {
 }
 
 func getRepoTeamsWithPermissions(ctx context.Context, gh *ghclient.Client, org, name string) (map[string]string, error) {
-	tToPermission := make(map[string]string)
-
-	opt := &github.ListOptions{PerPage: 100}
+	teams, err := ghclient.ListAll(func(page int) ([]*github.Team, *github.Response, error) {
+		return gh.Repositories.ListTeams(ctx, org, name, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
+	if err != nil {
+		return nil, err
+	}
 
-	for {
-		repos, resp, err := gh.Repositories.ListTeams(ctx, org, name, opt)
-		if err != nil {
-			return nil, err
+	tToPermission := make(map[string]string, len(teams))
+	for _, m := range teams {
+		// The permission of a team is reported by name, and as a map the
+		// name may be missing from.
+		permission := rolePermission(m.GetPermission())
+		if m.Permission == nil {
+			permission, _ = highestPermission(m.Permissions)
 		}
+		tToPermission[util.NormalizeName(*m.Slug)] = permission
+	}
 
-		for _, m := range repos {
-			tToPermission[*m.Slug] = *m.Permission
-		}
+	return tToPermission, nil
+}
+
+var permissionsOrdered = [...]string{"admin", "maintain", "push", "triage", "pull"}
+
+// builtinRoleNames are the names GitHub reports for the built-in repository
+// roles. Any other role name is a custom repository role of the organization.
+var builtinRoleNames = []string{"admin", "maintain", "write", "triage", "read"}
+
+// builtinRolePermissions maps the names of the built-in repository roles to
+// the permissions they are granted and reported as.
+var builtinRolePermissions = map[string]string{"admin": "admin", "maintain": "maintain", "write": "push", "triage": "triage", "read": "pull"}
+
+// rolePermission returns the permission that role is granted as, so that the
+// names of built-in roles compare equal to the permissions GitHub reports.
+// Custom roles are returned as they are.
+func rolePermission(role string) string {
+	if p, ok := builtinRolePermissions[role]; ok {
+		return p
+	}
+	return role
+}
 
-		if resp.NextPage == 0 {
-			break
+// highestPermission returns the highest of the permissions GitHub reports as a
+// map, in which every role also has the permissions below it, e.g. maintain
+// has push, triage and pull too.
+func highestPermission(permissions map[string]bool) (string, bool) {
+	for _, p := range permissionsOrdered {
+		if permissions[p] {
+			return p, true
 		}
-		opt.Page = resp.NextPage
 	}
+	return "", false
+}
 
-	return tToPermission, nil
+// isCustomRole reports whether role is neither a built-in repository role nor
+// one of the permissions it can be granted as.
+func isCustomRole(role string) bool {
+	return !util.Contains(builtinRoleNames, role) && !util.Contains(permissionsOrdered[:], role)
 }
 
-var permissionsOrdered = [...]string{"admin", "maintain", "push", "triage", "pull"}
+// validateCustomRoles returns an error if a user or team is granted a custom
+// repository role that the organization doesn't define.
+func validateCustomRoles(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository) error {
+	var custom []string
+	for _, user := range cr.Spec.ForProvider.Permissions.Users {
+		if isCustomRole(user.Role) {
+			custom = append(custom, user.Role)
+		}
+	}
+	for _, team := range cr.Spec.ForProvider.Permissions.Teams {
+		if isCustomRole(team.Role) {
+			custom = append(custom, team.Role)
+		}
+	}
+	if len(custom) == 0 {
+		return nil
+	}
 
-func getRepoUsersWithPermissions(ctx context.Context, gh *ghclient.Client, org, name string) (map[string]string, error) {
-	uToPermission := make(map[string]string)
+	roles, _, err := gh.Organizations.ListCustomRepoRoles(ctx, cr.Spec.ForProvider.Org)
+	if err != nil {
+		return err
+	}
+	defined := make([]string, 0, len(roles.CustomRepoRoles))
+	for _, role := range roles.CustomRepoRoles {
+		defined = append(defined, role.GetName())
+	}
 
-	opt := &github.ListCollaboratorsOptions{
-		Affiliation: "direct",
-		ListOptions: github.ListOptions{PerPage: 100},
+	var unknown []string
+	for _, role := range custom {
+		if !util.Contains(defined, role) && !util.Contains(unknown, role) {
+			unknown = append(unknown, role)
+		}
+	}
+	if len(unknown) > 0 {
+		return errors.Errorf("repository roles %s are not defined by organization %s", strings.Join(unknown, ", "), cr.Spec.ForProvider.Org)
 	}
+	return nil
+}
 
-	for {
-		users, resp, err := gh.Repositories.ListCollaborators(ctx, org, name, opt)
+// withCustomTeamRoles replaces the permissions of the teams that are granted a
+// custom repository role in crTToPermission with the name of the role they
+// have, which isn't part of the list of repository teams.
+func withCustomTeamRoles(ctx context.Context, gh *ghclient.Client, org, name string, crTToPermission, ghTToPermission map[string]string) (map[string]string, error) {
+	for teamSlug, role := range crTToPermission {
+		if _, ok := ghTToPermission[teamSlug]; !ok || !isCustomRole(role) {
+			continue
+		}
+		repo, _, err := gh.Teams.IsTeamRepoBySlug(ctx, org, teamSlug, org, name)
 		if err != nil {
 			return nil, err
 		}
+		if repo.RoleName != nil {
+			ghTToPermission[teamSlug] = *repo.RoleName
+		}
+	}
+	return ghTToPermission, nil
+}
 
-		for _, m := range users {
-			uToPermission[*m.Login] = "pull"
+// affiliationDirect lists the collaborators that are granted access to a
+// repository individually. Access inherited through the teams of the
+// repository is compared to its teams instead, so that members of the teams
+// are not removed as undeclared collaborators.
+const affiliationDirect = "direct"
 
-			for _, p := range permissionsOrdered {
-				if m.Permissions[p] {
-					uToPermission[*m.Login] = p
-					break
-				}
-			}
-		}
+func getRepoUsersWithPermissions(ctx context.Context, gh *ghclient.Client, org, name string) (map[string]string, error) {
+	users, err := ghclient.ListAll(func(page int) ([]*github.User, *github.Response, error) {
+		return gh.Repositories.ListCollaborators(ctx, org, name, &github.ListCollaboratorsOptions{
+			Affiliation: affiliationDirect,
+			ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page},
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
 
-		if resp.NextPage == 0 {
-			break
+	uToPermission := make(map[string]string, len(users))
+	for _, m := range users {
+		login := util.NormalizeName(*m.Login)
+		uToPermission[login] = "pull"
+
+		if m.RoleName != nil {
+			uToPermission[login] = rolePermission(*m.RoleName)
+			continue
+		}
+		if p, ok := highestPermission(m.Permissions); ok {
+			uToPermission[login] = p
 		}
-		opt.Page = resp.NextPage
 	}
 
 	return uToPermission, nil
@@ -397,105 +1074,65 @@ func getRepoUsersWithPermissions(ctx context.Context, gh *ghclient.Client, org,
 // listProtectedBranches retrieves all protected branches for a given GitHub repository.
 // It uses pagination to handle large numbers of branches, fetching 100 branches per API call.
 func listProtectedBranches(ctx context.Context, gh *ghclient.Client, org, repoName string) ([]*github.Branch, error) {
-	opts := &github.BranchListOptions{
-		Protected:   github.Bool(true),
-		ListOptions: github.ListOptions{PerPage: 100},
-	}
-	var allBranches []*github.Branch
+	return ghclient.ListAll(func(page int) ([]*github.Branch, *github.Response, error) {
+		return gh.Repositories.ListBranches(ctx, org, repoName, &github.BranchListOptions{
+			Protected:   github.Bool(true),
+			ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page},
+		})
+	})
+}
 
-	for {
-		branches, resp, err := gh.Repositories.ListBranches(ctx, org, repoName, opts)
+// getPendingBranches returns the sorted names of the branches with a rule in crRules
+// that are neither protected on GitHub nor exist yet.
+func getPendingBranches(ctx context.Context, gh *ghclient.Client, org, repoName string, crRules, ghRules map[string]v1alpha1.RepositoryBranchProtectionRule) ([]string, error) {
+	var pending []string
+	for branch := range crRules {
+		if _, ok := ghRules[branch]; ok {
+			continue
+		}
+		_, _, err := gh.Repositories.GetBranch(ctx, org, repoName, branch, 0)
+		if ghclient.Is404(err) {
+			pending = append(pending, branch)
+			continue
+		}
 		if err != nil {
 			return nil, err
 		}
-		allBranches = append(allBranches, branches...)
+	}
+	sort.Strings(pending)
+	return pending, nil
+}
 
-		if resp.NextPage == 0 {
-			break
+// withoutBranches returns a copy of rules without the rules for the given branches.
+func withoutBranches(rules map[string]v1alpha1.RepositoryBranchProtectionRule, branches []string) map[string]v1alpha1.RepositoryBranchProtectionRule {
+	res := make(map[string]v1alpha1.RepositoryBranchProtectionRule, len(rules))
+	for branch, rule := range rules {
+		if !util.Contains(branches, branch) {
+			res[branch] = rule
 		}
-		opts.Page = resp.NextPage
 	}
+	return res
+}
 
-	return allBranches, nil
+// setPendingBranches records the branches whose protection rules are pending on the Repository.
+func setPendingBranches(cr *v1alpha1.Repository, pending []string) {
+	cr.Status.AtProvider.PendingBranchProtectionRules = pending
+	if len(pending) == 0 {
+		cr.SetConditions(v1alpha1.BranchProtectionApplied())
+		return
+	}
+	cr.SetConditions(v1alpha1.BranchProtectionPending(fmt.Sprintf("waiting for branches to be pushed: %s", strings.Join(pending, ", "))))
 }
 
-// getBPRMapFromCr generates a map from a slice of BranchProtectionRules. Each rule is first processed:
-// sorts the RequiredStatusChecks and any checks in various rule sub-structures, then the updated rule
-// is added to the map with its branch name as the key. The function returns the resulting map.
-//
-//nolint:gocyclo
-func getBPRMapFromCr(rules []v1alpha1.BranchProtectionRule) map[string]v1alpha1.BranchProtectionRule {
-	crBPRToConfig := make(map[string]v1alpha1.BranchProtectionRule, len(rules))
+// getBPRMapFromCr generates a map from a slice of BranchProtectionRules. Each rule is
+// normalized by util.NormalizeBranchProtectionRule, then added to the map with its
+// branch name as the key. The function returns the resulting map.
+func getBPRMapFromCr(rules []v1alpha1.RepositoryBranchProtectionRule) map[string]v1alpha1.RepositoryBranchProtectionRule {
+	crBPRToConfig := make(map[string]v1alpha1.RepositoryBranchProtectionRule, len(rules))
 
 	for i := range rules {
-		// Use a copy to avoid changing passed []v1alpha1.BranchProtectionRule
-		// This prevents the controller from changing the spec of the live CR
-		// It can also prevent infinite reconciliation loops when managing the resources with ArgoCD
-		orig := &rules[i]
-		rCopy := orig.DeepCopy()
-
-		// handle optional *bool fields
-		rCopy.RequireLinearHistory = util.BoolDerefToPointer(rCopy.RequireLinearHistory, false)
-		rCopy.AllowForcePushes = util.BoolDerefToPointer(rCopy.AllowForcePushes, false)
-		rCopy.AllowDeletions = util.BoolDerefToPointer(rCopy.AllowDeletions, false)
-		rCopy.RequiredConversationResolution = util.BoolDerefToPointer(rCopy.RequiredConversationResolution, false)
-		rCopy.LockBranch = util.BoolDerefToPointer(rCopy.LockBranch, false)
-		rCopy.AllowForkSyncing = util.BoolDerefToPointer(rCopy.AllowForkSyncing, false)
-		rCopy.RequireSignedCommits = util.BoolDerefToPointer(rCopy.RequireSignedCommits, false)
-
-		if rCopy.RequiredStatusChecks != nil && rCopy.RequiredStatusChecks.Checks != nil {
-			copyOfStatusChecks := make([]*v1alpha1.RequiredStatusCheck, len(rCopy.RequiredStatusChecks.Checks))
-			copy(copyOfStatusChecks, rCopy.RequiredStatusChecks.Checks)
-			util.SortRequiredStatusChecks(copyOfStatusChecks)
-			rCopy.RequiredStatusChecks.Checks = copyOfStatusChecks
-		}
-
-		restr := rCopy.BranchProtectionRestrictions
-		if restr != nil {
-			restr.BlockCreations = util.BoolDerefToPointer(restr.BlockCreations, false)
-			if restr.Users != nil {
-				restr.Users = util.SortAndReturn(restr.Users)
-			}
-			if restr.Teams != nil {
-				restr.Teams = util.SortAndReturn(restr.Teams)
-			}
-			if restr.Apps != nil {
-				restr.Apps = util.SortAndReturn(restr.Apps)
-			}
-		}
-
-		rPRs := rCopy.RequiredPullRequestReviews
-		if rPRs != nil {
-			// handle optional *bool fields
-			rPRs.RequireLastPushApproval = util.BoolDerefToPointer(rPRs.RequireLastPushApproval, false)
-
-			allowances := rPRs.BypassPullRequestAllowances
-			if allowances != nil {
-				if allowances.Users != nil {
-					allowances.Users = util.SortAndReturn(allowances.Users)
-				}
-				if allowances.Teams != nil {
-					allowances.Teams = util.SortAndReturn(allowances.Teams)
-				}
-				if allowances.Apps != nil {
-					allowances.Apps = util.SortAndReturn(allowances.Apps)
-				}
-			}
-			dismissal := rPRs.DismissalRestrictions
-			if dismissal != nil {
-				if dismissal.Users != nil {
-					dismissal.Users = util.SortAndReturnPointer(*dismissal.Users)
-				}
-				if dismissal.Teams != nil {
-					dismissal.Teams = util.SortAndReturnPointer(*dismissal.Teams)
-				}
-				if dismissal.Apps != nil {
-					dismissal.Apps = util.SortAndReturnPointer(*dismissal.Apps)
-				}
-			}
-		}
-
-		crBPRToConfig[rCopy.Branch] = *rCopy
+		rule := util.NormalizeBranchProtectionRule(rules[i])
+		crBPRToConfig[rule.Branch] = rule
 	}
 
 	return crBPRToConfig
@@ -507,15 +1144,26 @@ func getBPRMapFromCr(rules []v1alpha1.BranchProtectionRule) map[string]v1alpha1.
 // It returns the BranchProtectionRules map, and any error encountered during the process.
 //
 //nolint:gocyclo
-func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo string, branches []*github.Branch) (map[string]v1alpha1.BranchProtectionRule, error) {
-	bprToConfig := make(map[string]v1alpha1.BranchProtectionRule, len(branches))
+func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo string, branches []*github.Branch) (map[string]v1alpha1.RepositoryBranchProtectionRule, error) {
+	bprToConfig := make(map[string]v1alpha1.RepositoryBranchProtectionRule, len(branches))
+
+	protections := make([]*github.Protection, len(branches))
+	g := &errgroup.Group{}
+	g.SetLimit(maxConcurrentRequests)
+	for i, branch := range branches {
+		g.Go(func() error {
+			protection, _, err := gh.Repositories.GetBranchProtection(ctx, owner, repo, branch.GetName())
+			protections[i] = protection
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
 
-	for _, branch := range branches {
-		protection, _, err := gh.Repositories.GetBranchProtection(ctx, owner, repo, branch.GetName())
-		if err != nil {
-			return nil, err
-		}
-		bpr := v1alpha1.BranchProtectionRule{
+	for i, branch := range branches {
+		protection := protections[i]
+		bpr := v1alpha1.RepositoryBranchProtectionRule{
 			Branch:                         branch.GetName(),
 			EnforceAdmins:                  protection.GetEnforceAdmins().Enabled,
 			RequireLinearHistory:           &protection.GetRequireLinearHistory().Enabled,
@@ -539,6 +1187,11 @@ func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo stri
 						Context: check.Context,
 						AppID:   check.AppID,
 					}
+					// GitHub reports checks that any app may set with an app ID of -1,
+					// which is represented by an unset appId in the CR
+					if pointer.Int64Deref(check.AppID, anyAppID) == anyAppID {
+						checks[i].AppID = nil
+					}
 				}
 				util.SortRequiredStatusChecks(checks)
 				bpr.RequiredStatusChecks.Checks = checks
@@ -562,21 +1215,21 @@ func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo stri
 					for i, user := range dismissal.Users {
 						users[i] = user.GetLogin()
 					}
-					bpr.RequiredPullRequestReviews.DismissalRestrictions.Users = util.SortAndReturnPointer(users)
+					bpr.RequiredPullRequestReviews.DismissalRestrictions.Users = util.NormalizeNamesPointer(users)
 				}
 				if len(dismissal.Teams) > 0 {
 					teams := make([]string, len(dismissal.Teams))
 					for i, team := range dismissal.Teams {
 						teams[i] = team.GetSlug()
 					}
-					bpr.RequiredPullRequestReviews.DismissalRestrictions.Teams = util.SortAndReturnPointer(teams)
+					bpr.RequiredPullRequestReviews.DismissalRestrictions.Teams = util.NormalizeNamesPointer(teams)
 				}
 				if len(dismissal.Apps) > 0 {
 					apps := make([]string, len(dismissal.Apps))
 					for i, app := range dismissal.Apps {
 						apps[i] = app.GetSlug()
 					}
-					bpr.RequiredPullRequestReviews.DismissalRestrictions.Apps = util.SortAndReturnPointer(apps)
+					bpr.RequiredPullRequestReviews.DismissalRestrictions.Apps = util.NormalizeNamesPointer(apps)
 				}
 			}
 
@@ -588,21 +1241,21 @@ func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo stri
 					for i, user := range allowances.Users {
 						users[i] = user.GetLogin()
 					}
-					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Users = util.SortAndReturn(users)
+					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Users = util.NormalizeNames(users)
 				}
 				if len(allowances.Teams) > 0 {
 					teams := make([]string, len(allowances.Teams))
 					for i, team := range allowances.Teams {
 						teams[i] = team.GetSlug()
 					}
-					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Teams = util.SortAndReturn(teams)
+					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Teams = util.NormalizeNames(teams)
 				}
 				if len(allowances.Apps) > 0 {
 					apps := make([]string, len(allowances.Apps))
 					for i, app := range allowances.Apps {
 						apps[i] = app.GetSlug()
 					}
-					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Apps = util.SortAndReturn(apps)
+					bpr.RequiredPullRequestReviews.BypassPullRequestAllowances.Apps = util.NormalizeNames(apps)
 				}
 			}
 		}
@@ -616,21 +1269,21 @@ func getBPRWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo stri
 				for i, user := range restr.Users {
 					users[i] = user.GetLogin()
 				}
-				bpr.BranchProtectionRestrictions.Users = util.SortAndReturn(users)
+				bpr.BranchProtectionRestrictions.Users = util.NormalizeNames(users)
 			}
 			if len(restr.Teams) > 0 {
 				teams := make([]string, len(restr.Teams))
 				for i, team := range restr.Teams {
 					teams[i] = team.GetSlug()
 				}
-				bpr.BranchProtectionRestrictions.Teams = util.SortAndReturn(teams)
+				bpr.BranchProtectionRestrictions.Teams = util.NormalizeNames(teams)
 			}
 			if len(restr.Apps) > 0 {
 				apps := make([]string, len(restr.Apps))
 				for i, app := range restr.Apps {
 					apps[i] = app.GetSlug()
 				}
-				bpr.BranchProtectionRestrictions.Apps = util.SortAndReturn(apps)
+				bpr.BranchProtectionRestrictions.Apps = util.NormalizeNames(apps)
 			}
 		}
 
@@ -646,12 +1299,18 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, errors.New(errNotRepository)
 	}
 
-	name := meta.GetExternalName(cr)
+	name, err := repositoryName(cr)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	if err := validateCustomRoles(ctx, c.github, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
 
 	// handle optional *bool fields
 	privateCr := pointer.BoolDeref(cr.Spec.ForProvider.Private, true)
 
-	var err error
 	switch {
 	case cr.Spec.ForProvider.CreateFork != nil:
 		owner := cr.Spec.ForProvider.CreateFork.Owner
@@ -673,9 +1332,14 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		})
 	default:
 		_, _, err = c.github.Repositories.Create(ctx, cr.Spec.ForProvider.Org, &github.Repository{
-			Name:        &name,
-			Description: &cr.Spec.ForProvider.Description,
-			Private:     &privateCr,
+			Name:           &name,
+			Description:    &cr.Spec.ForProvider.Description,
+			Private:        &privateCr,
+			HasIssues:      cr.Spec.ForProvider.HasIssues,
+			HasWiki:        cr.Spec.ForProvider.HasWiki,
+			HasProjects:    cr.Spec.ForProvider.HasProjects,
+			HasDiscussions: cr.Spec.ForProvider.HasDiscussions,
+			HasDownloads:   cr.Spec.ForProvider.HasDownloads,
 		})
 	}
 
@@ -683,20 +1347,17 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalCreation{}, err
 	}
 
-	if cr.Spec.ForProvider.Permissions.Users != nil {
-		for _, user := range cr.Spec.ForProvider.Permissions.Users {
-			opt := &github.RepositoryAddCollaboratorOptions{Permission: user.Role}
-			_, _, err := c.github.Repositories.AddCollaborator(ctx, cr.Spec.ForProvider.Org, name, user.User, opt)
-			if err != nil {
-				return managed.ExternalCreation{}, err
-			}
-		}
+	// The sub-resources of the new repository may not be found for a moment.
+	ctx = ghclient.WithCreationGrace(ctx, creationGrace)
+
+	if err := addRepoUsers(ctx, c.github, cr, name); err != nil {
+		return managed.ExternalCreation{}, err
 	}
 
 	if cr.Spec.ForProvider.Permissions.Teams != nil {
 		for _, team := range cr.Spec.ForProvider.Permissions.Teams {
 			teamSlug := slug.Make(team.Team)
-			opt := &github.TeamAddTeamRepoOptions{Permission: team.Role}
+			opt := &github.TeamAddTeamRepoOptions{Permission: rolePermission(team.Role)}
 			_, err := c.github.Teams.AddTeamRepoBySlug(ctx, cr.Spec.ForProvider.Org, teamSlug, cr.Spec.ForProvider.Org, name, opt)
 			if err != nil {
 				return managed.ExternalCreation{}, err
@@ -707,19 +1368,43 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	if cr.Spec.ForProvider.Webhooks != nil {
 		// getRepoWebhooksMapFromCr() provides defaults for optional *bool fields
 		hooksMap := getRepoWebhooksMapFromCr(cr.Spec.ForProvider.Webhooks)
-		for key := range hooksMap {
-			// avoid "G601: Implicit memory aliasing in for loop"
-			hook := hooksMap[key]
-			_, _, err := c.github.Repositories.CreateHook(ctx, cr.Spec.ForProvider.Org, name, crRepoHookToHookConfig(hook))
-			if err != nil {
+		pinged := pingedWebhooks(cr.Spec.ForProvider.Webhooks)
+		for key, hook := range hooksMap {
+			if err := createRepoWebhook(ctx, c.github, cr.Spec.ForProvider.Org, name, hook, pinged[key]); err != nil {
 				return managed.ExternalCreation{}, err
 			}
 		}
 	}
 
+	if cr.Spec.ForProvider.CustomProperties != nil {
+		if err := updateCustomProperties(ctx, c.github, cr, name); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.Environments != nil {
+		if err := updateEnvironments(ctx, c.github, cr, name); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.OIDCSubjectClaim != nil {
+		if err := updateOIDCSubjectClaim(ctx, c.github, cr, name); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	}
+
+	preflight := preflightRules(cr)
+	setPreflightCondition(cr, preflight)
+
 	if cr.Spec.ForProvider.BranchProtectionRules != nil {
 		// getBPRMapFromCr() provides defaults for optional *bool fields
-		rulesMap := getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules)
+		rulesMap, patternsMap := splitBranchPatterns(withoutInvalid(getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules), preflight.branchProtectionRules))
+		pending, err := getPendingBranches(ctx, c.github, cr.Spec.ForProvider.Org, name, rulesMap, nil)
+		if err != nil {
+			return managed.ExternalCreation{}, err
+		}
+		rulesMap = withoutBranches(rulesMap, pending)
 		for key := range rulesMap {
 			// avoid "G601: Implicit memory aliasing in for loop"
 			rule := rulesMap[key]
@@ -728,13 +1413,25 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 				return managed.ExternalCreation{}, err
 			}
 		}
+		if len(patternsMap) > 0 {
+			ghPatternRules, err := getBranchPatternRules(ctx, c.github, cr.Spec.ForProvider.Org, name)
+			if err != nil {
+				return managed.ExternalCreation{}, err
+			}
+			if err := updateBranchPatternRules(ctx, c.github, ghPatternRules, patternsMap); err != nil {
+				return managed.ExternalCreation{}, err
+			}
+		}
 	}
 	if cr.Spec.ForProvider.RepositoryRules != nil {
-		rulesMap := getRepositoryRulesMapFromCr(cr.Spec.ForProvider.RepositoryRules)
+		rulesMap := withoutInvalid(util.NormalizeRulesets(cr.Spec.ForProvider.RepositoryRules), preflight.repositoryRules)
+		if err := util.ResolveRulesetReferences(ctx, c.github, cr.Spec.ForProvider.Org, name, rulesMap); err != nil {
+			return managed.ExternalCreation{}, err
+		}
 		for key := range rulesMap {
 			// avoid "G601: Implicit memory aliasing in for loop"
 			rule := rulesMap[key]
-			_, _, err := c.github.Repositories.CreateRuleset(ctx, cr.Spec.ForProvider.Org, name, crRepoRulesToRulesConfig(rule))
+			_, _, err := c.github.Repositories.CreateRuleset(ctx, cr.Spec.ForProvider.Org, name, util.RulesetToGitHub(rule))
 			if err != nil {
 				return managed.ExternalCreation{}, err
 			}
@@ -747,21 +1444,51 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	return managed.ExternalCreation{}, nil
 }
 
-func updateRepoUsers(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, repoName string) error {
+// addRepoUsers adds the users of the spec whose access has not expired as
+// collaborators of a new repository. Users whose access already expired are
+// never granted it.
+func addRepoUsers(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	for _, user := range cr.Spec.ForProvider.Permissions.Users {
+		if isExpired(user) {
+			continue
+		}
+		opt := &github.RepositoryAddCollaboratorOptions{Permission: rolePermission(user.Role)}
+		if _, _, err := gh.Repositories.AddCollaborator(ctx, cr.Spec.ForProvider.Org, repoName, user.User, opt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func updateRepoUsers(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, rec event.Recorder, repoName string) error {
 	crMToPermission := getUserPermissionMapFromCr(cr.Spec.ForProvider.Permissions.Users)
 	ghUToPermission, err := getRepoUsersWithPermissions(ctx, gh, cr.Spec.ForProvider.Org, repoName)
-
+	if err == nil {
+		ghUToPermission, err = withoutOrganizationOwners(ctx, gh, cr, ghUToPermission)
+	}
 	if err != nil {
 		return err
 	}
 
+	ghUToPermission = withoutUnmanagedGrants(cr, ghUToPermission, declaredUsers(cr.Spec.ForProvider.Permissions.Users))
+
 	toDelete, toAdd, toUpdate := util.DiffPermissions(ghUToPermission, crMToPermission)
 
+	expired := make(map[string]bool)
+	for _, user := range cr.Spec.ForProvider.Permissions.Users {
+		if isExpired(user) {
+			expired[util.NormalizeName(user.User)] = true
+		}
+	}
+
 	for userName := range toDelete {
 		_, err := gh.Repositories.RemoveCollaborator(ctx, cr.Spec.ForProvider.Org, repoName, userName)
 		if err != nil {
 			return err
 		}
+		if expired[userName] {
+			rec.Event(cr, event.Normal(reasonAccessExpired, fmt.Sprintf("Removed collaborator %s, their access expired", userName)))
+		}
 	}
 
 	for userName, role := range util.MergeMaps(toAdd, toUpdate) {
@@ -781,6 +1508,12 @@ func updateRepoTeams(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.
 	if err != nil {
 		return err
 	}
+	ghTToPermission, err = withCustomTeamRoles(ctx, gh, cr.Spec.ForProvider.Org, repoName, crTToPermission, ghTToPermission)
+	if err != nil {
+		return err
+	}
+
+	ghTToPermission = withoutUnmanagedGrants(cr, ghTToPermission, crTToPermission)
 
 	toDelete, toAdd, toUpdate := util.DiffPermissions(ghTToPermission, crTToPermission)
 
@@ -826,7 +1559,7 @@ func updateRepoWebhooks(ctx context.Context, cr *v1alpha1.Repository, gh *ghclie
 		return err
 	}
 	crWToConfig := getRepoWebhooksMapFromCr(cr.Spec.ForProvider.Webhooks)
-	ghWToConfig := getRepoWebhooksWithConfig(ghRepoWebhooks)
+	ghWToConfig := withoutUnmanagedWebhooks(cr, getRepoWebhooksWithConfig(ghRepoWebhooks), crWToConfig)
 
 	toDelete, toAdd, toUpdate := util.DiffRepoWebhooks(ghWToConfig, crWToConfig)
 
@@ -842,9 +1575,9 @@ func updateRepoWebhooks(ctx context.Context, cr *v1alpha1.Repository, gh *ghclie
 		}
 	}
 
-	for _, hook := range toAdd {
-		_, _, err := gh.Repositories.CreateHook(ctx, cr.Spec.ForProvider.Org, repoName, crRepoHookToHookConfig(hook))
-		if err != nil {
+	pinged := pingedWebhooks(cr.Spec.ForProvider.Webhooks)
+	for key, hook := range toAdd {
+		if err := createRepoWebhook(ctx, gh, cr.Spec.ForProvider.Org, repoName, hook, pinged[key]); err != nil {
 			return err
 		}
 	}
@@ -867,7 +1600,7 @@ func updateRepoWebhooks(ctx context.Context, cr *v1alpha1.Repository, gh *ghclie
 // based on a provided BranchProtectionRule. It returns an error if the update operation fails.
 //
 //nolint:gocyclo
-func editProtectedBranch(ctx context.Context, rule *v1alpha1.BranchProtectionRule, gh *ghclient.Client, owner, repoName string) error {
+func editProtectedBranch(ctx context.Context, rule *v1alpha1.RepositoryBranchProtectionRule, gh *ghclient.Client, owner, repoName string) error {
 	protectionRequest := &github.ProtectionRequest{
 		EnforceAdmins:                  rule.EnforceAdmins,
 		RequireLinearHistory:           rule.RequireLinearHistory,
@@ -882,7 +1615,7 @@ func editProtectedBranch(ctx context.Context, rule *v1alpha1.BranchProtectionRul
 		var checks []*github.RequiredStatusCheck
 		for _, check := range rule.RequiredStatusChecks.Checks {
 			// if nil, allow any app to set the status of a check
-			appId := pointer.Int64Deref(check.AppID, -1)
+			appId := pointer.Int64Deref(check.AppID, anyAppID)
 			checks = append(checks, &github.RequiredStatusCheck{
 				Context: check.Context,
 				AppID:   &appId,
@@ -950,25 +1683,42 @@ func editProtectedBranch(ctx context.Context, rule *v1alpha1.BranchProtectionRul
 // to match with those detailed in the repository resource object.
 // It performs necessary additions, updates, or deletions based on the difference between
 // the actual state on GitHub and the desired state in the resource object.
-func updateProtectedBranches(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, repoName string) error {
+// Rules listed in invalid failed pre-flight validation and are left untouched.
+func updateProtectedBranches(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, kube client.Reader, repoName string, invalid map[string][]string) error {
+	crBPRToConfig, crPatternToConfig := splitBranchPatterns(withoutInvalid(getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules), invalid))
+	ghPatternRules, err := getBranchPatternRules(ctx, gh, cr.Spec.ForProvider.Org, repoName)
+	if err != nil {
+		return err
+	}
 	protectedBranches, err := listProtectedBranches(ctx, gh, cr.Spec.ForProvider.Org, repoName)
 	if err != nil {
 		return err
 	}
-	crBPRToConfig := getBPRMapFromCr(cr.Spec.ForProvider.BranchProtectionRules)
-	ghBPRToConfig, err := getBPRWithConfig(ctx, gh, cr.Spec.ForProvider.Org, repoName, protectedBranches)
+	protectedBranches = withoutPatternProtected(protectedBranches, ghPatternRules, crBPRToConfig)
+	standalone, err := standaloneBranchProtectionRules(ctx, kube, cr.Spec.ForProvider.Org, repoName)
 	if err != nil {
 		return err
 	}
 
-	toDelete, toAdd, toUpdate := util.DiffProtectedBranches(ghBPRToConfig, crBPRToConfig)
+	ghPatternRules.config = withoutBranches(withoutInvalid(ghPatternRules.config, invalid), standalone)
+	ghPatternRules.config, _ = withoutUndeclared(cr.Spec.ForProvider.BranchProtectionManagementPolicy, ghPatternRules.config, crPatternToConfig)
+	if err := updateBranchPatternRules(ctx, gh, ghPatternRules, crPatternToConfig); err != nil {
+		return err
+	}
 
-	for branchName := range toDelete {
-		_, err = gh.Repositories.RemoveBranchProtection(ctx, cr.Spec.ForProvider.Org, repoName, branchName)
-		if err != nil {
-			return err
-		}
+	ghBPRToConfig, err := getBPRWithConfig(ctx, gh, cr.Spec.ForProvider.Org, repoName, protectedBranches)
+	if err != nil {
+		return err
+	}
+	ghBPRToConfig = withoutBranches(withoutInvalid(ghBPRToConfig, invalid), standalone)
+	ghBPRToConfig, _ = withoutUndeclared(cr.Spec.ForProvider.BranchProtectionManagementPolicy, ghBPRToConfig, crBPRToConfig)
+	pending, err := getPendingBranches(ctx, gh, cr.Spec.ForProvider.Org, repoName, crBPRToConfig, ghBPRToConfig)
+	if err != nil {
+		return err
 	}
+	crBPRToConfig = withoutBranches(crBPRToConfig, pending)
+
+	toDelete, toAdd, toUpdate := util.DiffProtectedBranches(ghBPRToConfig, crBPRToConfig)
 
 	for key := range toAdd {
 		// avoid "G601: Implicit memory aliasing in for loop"
@@ -988,6 +1738,14 @@ func updateProtectedBranches(ctx context.Context, cr *v1alpha1.Repository, gh *g
 		}
 	}
 
+	// Protections are only removed once all others are applied.
+	for branchName := range toDelete {
+		_, err = gh.Repositories.RemoveBranchProtection(ctx, cr.Spec.ForProvider.Org, repoName, branchName)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -995,7 +1753,7 @@ func updateProtectedBranches(ctx context.Context, cr *v1alpha1.Repository, gh *g
 // depending on the configuration. If RequireSignedCommits is set to true, it enforces signed commits,
 // making them mandatory for all contributors. If it's false, signing commits is optional.
 // It returns an error if any of the GitHub API calls fail.
-func handleBranchProtectionSignature(ctx context.Context, gh *ghclient.Client, owner, repoName string, protectionRule *v1alpha1.BranchProtectionRule) error {
+func handleBranchProtectionSignature(ctx context.Context, gh *ghclient.Client, owner, repoName string, protectionRule *v1alpha1.RepositoryBranchProtectionRule) error {
 	if protectionRule.RequireSignedCommits != nil && *protectionRule.RequireSignedCommits {
 		_, _, err := gh.Repositories.RequireSignaturesOnProtectedBranch(ctx, owner, repoName, protectionRule.Branch)
 		if err != nil {
@@ -1010,426 +1768,77 @@ func handleBranchProtectionSignature(ctx context.Context, gh *ghclient.Client, o
 	return nil
 }
 
-// getRepositoryRules retrieves all the rules for a given GitHub repository.
-// It uses pagination to handle large numbers of rules, fetching 100 rules per API call.
-func getRepositoryRules(ctx context.Context, gh *ghclient.Client, org, repo string) ([]*github.Ruleset, error) {
-	opt := &github.ListOptions{PerPage: 100}
-	var allRules []*github.Ruleset
-
-	for {
-		rules, resp, err := gh.Repositories.GetAllRulesets(ctx, org, repo, true)
-		if err != nil {
-			return nil, err
-		}
-
-		allRules = append(allRules, rules...)
-
-		if resp.NextPage == 0 {
-			break
-		}
-		opt.Page = resp.NextPage
-	}
-
-	return allRules, nil
-}
-
-// getRepositoryRulesMapFromCr generates a map from the RepositoryRules slice
-// in the Crossplane resource.
-//
-//nolint:gocyclo
-func getRepositoryRulesMapFromCr(rules []v1alpha1.RepositoryRuleset) map[string]v1alpha1.RepositoryRuleset {
-	crRulesToConfig := make(map[string]v1alpha1.RepositoryRuleset, len(rules))
-
-	for i := range rules {
-		// Use a copy to avoid changing passed []v1alpha1.RepositoryRules
-		// This prevents the controller from changing the spec of the live CR
-		// It can also prevent infinite reconciliation loops when managing the resources with ArgoCD
-		orig := &rules[i]
-		rCopy := orig.DeepCopy()
-
-		// handle optional fields
-		rCopy.Target = util.StringDerefToPointer(rCopy.Target, "branch")
-		rCopy.Enforcement = util.StringDerefToPointer(rCopy.Enforcement, "active")
-
-		rConditions := rCopy.Conditions
-
-		if rConditions != nil && rConditions.RefName != nil {
-			if rConditions.RefName.Include != nil {
-				rConditions.RefName.Include = util.SortAndReturn(rConditions.RefName.Include)
-			}
-			if rConditions.RefName.Exclude != nil {
-				rConditions.RefName.Exclude = util.SortAndReturn(rConditions.RefName.Exclude)
-			}
-		}
-
-		if rConditions == nil {
-			rConditions = &v1alpha1.RulesetConditions{
-				RefName: &v1alpha1.RulesetRefName{
-					Include: []string{},
-					Exclude: []string{},
-				},
-			}
-			// Update the rConditions reference in rCopy
-			rCopy.Conditions = rConditions
-		}
-
-		rBActors := rCopy.BypassActors
-		if rBActors != nil {
-			for a := range rBActors {
-				actor := rBActors[a] // Make a copy of the actor
-
-				// Set ActorId, ActorType, and BypassMode fields
-				actor.ActorId = rBActors[a].ActorId
-				actor.ActorType = rBActors[a].ActorType
-				actor.BypassMode = rBActors[a].BypassMode
-
-				// Update the actor in the slice
-				rBActors[a] = actor
-			}
-			util.SortRulesBypassActors(rBActors)
-		}
-		rRules := rCopy.Rules
-		if rRules != nil {
-			rRules.RequiredSignatures = util.BoolDerefToPointer(rRules.RequiredSignatures, false)
-			rRules.NonFastForward = util.BoolDerefToPointer(rRules.NonFastForward, false)
-			rRules.Creation = util.BoolDerefToPointer(rRules.Creation, false)
-			rRules.Deletion = util.BoolDerefToPointer(rRules.Deletion, false)
-			rRules.RequiredLinearHistory = util.BoolDerefToPointer(rRules.RequiredLinearHistory, false)
-			rRules.Update = util.BoolDerefToPointer(rRules.Update, false)
-
-			if rRules.RequiredDeployments != nil {
-				if rRules.RequiredDeployments.Environments != nil {
-					rRules.RequiredDeployments.Environments = util.SortAndReturn(rRules.RequiredDeployments.Environments)
-				}
-			}
-			if rRules.PullRequest != nil {
-				rRules.PullRequest.DismissStaleReviewsOnPush = util.BoolDerefToPointer(rRules.PullRequest.DismissStaleReviewsOnPush, false)
-				rRules.PullRequest.RequireCodeOwnerReview = util.BoolDerefToPointer(rRules.PullRequest.RequireCodeOwnerReview, false)
-				rRules.PullRequest.RequireLastPushApproval = util.BoolDerefToPointer(rRules.PullRequest.RequireLastPushApproval, false)
-				rRules.PullRequest.RequiredReviewThreadResolution = util.BoolDerefToPointer(rRules.PullRequest.RequiredReviewThreadResolution, false)
-				rRules.PullRequest.RequiredApprovingReviewCount = util.IntDerefToPointer(rRules.PullRequest.RequiredApprovingReviewCount, 0)
-			}
-			if rRules.RequiredStatusChecks != nil {
-				if rRules.RequiredStatusChecks.RequiredStatusChecks != nil {
-					copyOfStatusChecks := make([]*v1alpha1.RulesRequiredStatusChecksParameters, len(rRules.RequiredStatusChecks.RequiredStatusChecks))
-					copy(copyOfStatusChecks, rRules.RequiredStatusChecks.RequiredStatusChecks)
-					util.SortRulesRequiredStatusChecks(copyOfStatusChecks)
-					rRules.RequiredStatusChecks.RequiredStatusChecks = copyOfStatusChecks
-				}
-				rRules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy = util.BoolDerefToPointer(rRules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy, false)
-			}
-		}
-		crRulesToConfig[rCopy.Name] = *rCopy
-	}
-
-	return crRulesToConfig
-}
-
-// getRepositoryRulesWithConfig creates a map of RepositoryRules based on the
-// branch rules fetched from the GitHub API.
-//
-//nolint:gocyclo
-func getRepositoryRulesWithConfig(ctx context.Context, gh *ghclient.Client, owner, repo string, ghRulesets []*github.Ruleset) (map[string]v1alpha1.RepositoryRuleset, error) {
-	rulesToConfig := make(map[string]v1alpha1.RepositoryRuleset, len(ghRulesets))
-
-	for _, rule := range ghRulesets {
-		rRuleset, _, err := gh.Repositories.GetRuleset(ctx, owner, repo, *rule.ID, true)
-		if err != nil {
-			return nil, err
-		}
-		ruleset := v1alpha1.RepositoryRuleset{
-			Target:      util.ToStringPtr(rule.GetTarget()),
-			Enforcement: &rule.Enforcement,
-			Name:        rule.Name,
-
-			Conditions: &v1alpha1.RulesetConditions{
-				RefName: &v1alpha1.RulesetRefName{
-					Include: []string{},
-					Exclude: []string{},
-				},
-			},
-			BypassActors: nil,
-			Rules: &v1alpha1.Rules{
-				Creation:              util.ToBoolPtr(false),
-				Update:                util.ToBoolPtr(false),
-				Deletion:              util.ToBoolPtr(false),
-				RequiredLinearHistory: util.ToBoolPtr(false),
-				RequiredDeployments:   nil,
-				RequiredSignatures:    util.ToBoolPtr(false),
-				NonFastForward:        util.ToBoolPtr(false),
-				PullRequest:           nil,
-				RequiredStatusChecks:  nil,
-			},
-		}
-
-		if rRuleset.Conditions != nil {
-			if rRuleset.Conditions.RefName != nil {
-				ruleset.Conditions.RefName = &v1alpha1.RulesetRefName{
-					Include: util.SortAndReturn(rRuleset.Conditions.RefName.Include),
-					Exclude: util.SortAndReturn(rRuleset.Conditions.RefName.Exclude),
-				}
-			}
-		}
-
-		if rRuleset.BypassActors != nil {
-			if len(rRuleset.BypassActors) > 0 {
-				ruleset.BypassActors = make([]*v1alpha1.RulesetByPassActors, len(rRuleset.BypassActors))
-				for i, actor := range rRuleset.BypassActors {
-					ruleset.BypassActors[i] = &v1alpha1.RulesetByPassActors{
-						ActorType:  actor.ActorType,
-						ActorId:    actor.ActorID,
-						BypassMode: actor.BypassMode,
-					}
-				}
-				util.SortRulesBypassActors(ruleset.BypassActors)
-			}
-
-		}
-		if rRuleset != nil {
-			for _, rule := range rRuleset.Rules {
-				switch rule.Type {
-				case "creation":
-					ruleset.Rules.Creation = util.ToBoolPtr(true)
-				case "deletion":
-					ruleset.Rules.Deletion = util.ToBoolPtr(true)
-				case "required_linear_history":
-					ruleset.Rules.RequiredLinearHistory = util.ToBoolPtr(true)
-				case "required_signatures":
-					ruleset.Rules.RequiredSignatures = util.ToBoolPtr(true)
-				case "non_fast_forward":
-					ruleset.Rules.NonFastForward = util.ToBoolPtr(true)
-				case "update":
-					ruleset.Rules.Update = util.ToBoolPtr(true)
-				case "pull_request":
-					if rule.Parameters != nil {
-						params := github.PullRequestRuleParameters{}
-						if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
-							return nil, err
-						}
-						ruleset.Rules.PullRequest = &v1alpha1.RulesPullRequest{
-							RequireCodeOwnerReview:         util.ToBoolPtr(params.RequireCodeOwnerReview),
-							RequireLastPushApproval:        util.ToBoolPtr(params.RequireLastPushApproval),
-							RequiredReviewThreadResolution: util.ToBoolPtr(params.RequiredReviewThreadResolution),
-							RequiredApprovingReviewCount:   util.ToIntPtr(params.RequiredApprovingReviewCount),
-							DismissStaleReviewsOnPush:      util.ToBoolPtr(params.DismissStaleReviewsOnPush),
-						}
-					}
-				case "required_deployments":
-					if rule.Parameters != nil {
-						params := github.RequiredDeploymentEnvironmentsRuleParameters{}
-						if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
-							return nil, err
-						}
-						ruleset.Rules.RequiredDeployments = &v1alpha1.RulesRequiredDeployments{
-							Environments: util.SortAndReturn(params.RequiredDeploymentEnvironments),
-						}
-					}
-				case "required_status_checks":
-					if rule.Parameters != nil {
-						params := github.RequiredStatusChecksRuleParameters{}
-						if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
-							return nil, err
-						}
-						requiredStatusChecksParameters := make([]*v1alpha1.RulesRequiredStatusChecksParameters, len(params.RequiredStatusChecks))
-						for i, statusCheck := range params.RequiredStatusChecks {
-							requiredStatusChecksParameters[i] = &v1alpha1.RulesRequiredStatusChecksParameters{
-								Context:       statusCheck.Context,
-								IntegrationId: statusCheck.IntegrationID,
-							}
-						}
-						util.SortRulesRequiredStatusChecks(requiredStatusChecksParameters)
-
-						ruleset.Rules.RequiredStatusChecks = &v1alpha1.RulesRequiredStatusChecks{
-							StrictRequiredStatusChecksPolicy: util.ToBoolPtr(params.StrictRequiredStatusChecksPolicy),
-							RequiredStatusChecks:             requiredStatusChecksParameters,
-						}
-					}
-				}
-
-			}
-
-		}
-
-		rulesToConfig[rule.Name] = ruleset
-	}
-
-	return rulesToConfig, nil
-
-}
-
-// crRepoRulesToRulesConfig transforms a RepositoryRuleset object from the Crossplane resource
-// into a Ruleset object that can be used with the GitHub API.
-//
-//nolint:gocyclo
-func crRepoRulesToRulesConfig(rule v1alpha1.RepositoryRuleset) *github.Ruleset {
-	githubRuleset := &github.Ruleset{
-		Name:        rule.Name,
-		Enforcement: *rule.Enforcement,
-		Target:      rule.Target,
-	}
-
-	// If BypassActors is not nil, transform it into the github rule BypassActors
-	if rule.BypassActors != nil {
-		githubBypassActors := make([]*github.BypassActor, len(rule.BypassActors))
-		for i, actor := range rule.BypassActors {
-			githubBypassActors[i] = &github.BypassActor{
-				ActorID:    actor.ActorId,
-				ActorType:  actor.ActorType,
-				BypassMode: actor.BypassMode,
-			}
-		}
-		githubRuleset.BypassActors = githubBypassActors
-	}
-
-	// If Conditions is not nil, transform it into the github rule Conditions
-	if rule.Conditions != nil {
-		githubConditions := &github.RulesetConditions{
-			RefName: &github.RulesetRefConditionParameters{
-				Include: rule.Conditions.RefName.Include,
-				Exclude: rule.Conditions.RefName.Exclude,
-			},
-		}
-		githubRuleset.Conditions = githubConditions
-	}
-	// If Rules is not nil, transform it into the github rule Rules
-	if rule.Rules != nil {
-		githubRules := make([]*github.RepositoryRule, 0)
-		if rule.Rules.RequiredStatusChecks != nil {
-			params := github.RequiredStatusChecksRuleParameters{
-				StrictRequiredStatusChecksPolicy: *rule.Rules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy,
-			}
-			requiredStatusChecks := make([]github.RuleRequiredStatusChecks, len(rule.Rules.RequiredStatusChecks.RequiredStatusChecks))
-			for i, statusCheck := range rule.Rules.RequiredStatusChecks.RequiredStatusChecks {
-				requiredStatusChecks[i] = github.RuleRequiredStatusChecks{
-					Context:       statusCheck.Context,
-					IntegrationID: statusCheck.IntegrationId,
-				}
-			}
-			params.RequiredStatusChecks = requiredStatusChecks
-			paramsBytes, err := json.Marshal(params)
-			if err != nil {
-				return nil
-			}
-			rawParams := json.RawMessage(paramsBytes)
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type:       "required_status_checks",
-				Parameters: &rawParams,
-			})
-		}
-
-		if *rule.Rules.Creation {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "creation",
-			})
-		}
-
-		if *rule.Rules.Deletion {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "deletion",
-			})
-		}
-
-		if *rule.Rules.RequiredLinearHistory {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "required_linear_history",
-			})
-		}
-
-		if *rule.Rules.RequiredSignatures {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "required_signatures",
-			})
-		}
-		if *rule.Rules.NonFastForward {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "non_fast_forward",
-			})
-		}
-		if *rule.Rules.Update {
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type: "update",
-			})
-		}
-		if rule.Rules.PullRequest != nil {
-			params := github.PullRequestRuleParameters{
-				DismissStaleReviewsOnPush:      *rule.Rules.PullRequest.DismissStaleReviewsOnPush,
-				RequireCodeOwnerReview:         *rule.Rules.PullRequest.RequireCodeOwnerReview,
-				RequireLastPushApproval:        *rule.Rules.PullRequest.RequireLastPushApproval,
-				RequiredReviewThreadResolution: *rule.Rules.PullRequest.RequiredReviewThreadResolution,
-				RequiredApprovingReviewCount:   *rule.Rules.PullRequest.RequiredApprovingReviewCount,
-			}
-			paramsBytes, err := json.Marshal(params)
-			if err != nil {
-				return nil
-			}
-			rawParams := json.RawMessage(paramsBytes)
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type:       "pull_request",
-				Parameters: &rawParams,
-			})
-		}
-		if rule.Rules.RequiredDeployments != nil {
-			params := github.RequiredDeploymentEnvironmentsRuleParameters{
-				RequiredDeploymentEnvironments: rule.Rules.RequiredDeployments.Environments,
-			}
-			paramsBytes, err := json.Marshal(params)
-			if err != nil {
-				return nil
-			}
-			rawParams := json.RawMessage(paramsBytes)
-			githubRules = append(githubRules, &github.RepositoryRule{
-				Type:       "required_deployments",
-				Parameters: &rawParams,
-			})
-		}
-		githubRuleset.Rules = githubRules
-
-	}
-	return githubRuleset
-}
-
 // updateRepositoryRules synchronizes the repository rules of a GitHub repository
 // to match with those detailed in the repository resource object.
 // It performs necessary additions, updates, or deletions based on the difference between
 // the actual state on GitHub and the desired state in the resource object.
-func updateRepositoryRules(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, repoName string) error {
+// Rulesets listed in invalid failed pre-flight validation and are left untouched,
+// as are the rulesets of RepositoryRuleset managed resources.
+func updateRepositoryRules(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, kube client.Reader, repoName string, invalid map[string][]string) error {
 	// Fetch the current repository rules from GitHub
-	ghRepoRules, err := getRepositoryRules(ctx, gh, cr.Spec.ForProvider.Org, repoName)
+	ghRepoRules, err := util.ListRulesets(ctx, gh, cr.Spec.ForProvider.Org, repoName)
 	if err != nil {
 		return err
 	}
 	// Generate a map of the repository rules from the Crossplane resource
-	crRToConfig := getRepositoryRulesMapFromCr(cr.Spec.ForProvider.RepositoryRules)
+	crRToConfig := withoutInvalid(util.NormalizeRulesets(cr.Spec.ForProvider.RepositoryRules), invalid)
+	if err := util.ResolveRulesetReferences(ctx, gh, cr.Spec.ForProvider.Org, repoName, crRToConfig); err != nil {
+		return err
+	}
 	// Generate a map of the repository rules from GitHub
-	ghRToConfig, err := getRepositoryRulesWithConfig(ctx, gh, cr.Spec.ForProvider.Org, repoName, ghRepoRules)
+	ghRToConfig, err := util.RulesetsFromGitHub(ctx, gh, cr.Spec.ForProvider.Org, repoName, ghRepoRules)
+	if err != nil {
+		return err
+	}
+	standalone, err := standaloneRulesets(ctx, kube, cr.Spec.ForProvider.Org, repoName)
 	if err != nil {
 		return err
 	}
+	managedRToConfig := withoutRulesets(withoutInvalid(ghRToConfig, invalid), standalone)
+	ghRToConfig, _ = withoutUndeclared(cr.Spec.ForProvider.RulesetManagementPolicy, managedRToConfig, crRToConfig)
 	// Determine which rules need to be deleted, added, or updated
 	toDelete, toAdd, toUpdate := util.DiffRepositoryRulesets(ghRToConfig, crRToConfig)
+	ids := cr.Status.AtProvider.RulesetIDs
+	if ids == nil {
+		ids = make(map[string]int64)
+	}
+	// Rulesets renamed in the spec are updated by their ID
+	renamed := renamedRulesets(ids, ghRepoRules, managedRToConfig, crRToConfig, toDelete, toAdd)
+	defer func() {
+		cr.Status.AtProvider.RulesetIDs = declaredRulesetIDs(cr, ids)
+	}()
 
 	// Delete the rules that are no longer needed
 	for name := range toDelete {
-		rulesetID, _ := findRulesetIDByName(ghRepoRules, name)
+		rulesetID, _ := trackedRulesetID(ids, ghRepoRules, name)
 		_, err = gh.Repositories.DeleteRuleset(ctx, cr.Spec.ForProvider.Org, repoName, rulesetID)
 		if err != nil {
 			return err
 		}
 	}
 	// Add the new rules
-	for _, rule := range toAdd {
-		_, _, err := gh.Repositories.CreateRuleset(ctx, cr.Spec.ForProvider.Org, repoName, crRepoRulesToRulesConfig(rule))
+	for name, rule := range toAdd {
+		if id, ok := renamed[name]; ok {
+			if _, _, err := gh.Repositories.UpdateRuleset(ctx, cr.Spec.ForProvider.Org, repoName, id, util.RulesetToGitHub(rule)); err != nil {
+				return err
+			}
+			ids[name] = id
+			continue
+		}
+		created, _, err := gh.Repositories.CreateRuleset(ctx, cr.Spec.ForProvider.Org, repoName, util.RulesetToGitHub(rule))
 		if err != nil {
 			return err
 		}
+		ids[name] = created.GetID()
 	}
 	// Update the existing rules
 	for name, rule := range toUpdate {
-		rulesetID, _ := findRulesetIDByName(ghRepoRules, name)
-		_, _, err := gh.Repositories.UpdateRuleset(ctx, cr.Spec.ForProvider.Org, repoName, rulesetID, crRepoRulesToRulesConfig(rule))
+		rulesetID, _ := trackedRulesetID(ids, ghRepoRules, name)
+		_, _, err := gh.Repositories.UpdateRuleset(ctx, cr.Spec.ForProvider.Org, repoName, rulesetID, util.RulesetToGitHub(rule))
 		if err != nil {
 			return err
 		}
+		ids[name] = rulesetID
 	}
 	return nil
 }
@@ -1452,7 +1861,24 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 		return managed.ExternalUpdate{}, errors.New(errNotRepository)
 	}
 
-	name := meta.GetExternalName(cr)
+	name, err := repositoryName(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	// The repository is renamed before anything else, as all other changes
+	// are applied by its new name.
+	if c.renameFrom != "" {
+		if err := renameRepository(ctx, c.github, cr, c.renameFrom, name); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		c.renameFrom = ""
+	}
+
+	// Validate rules up front so that a malformed rule doesn't abort the
+	// Update and mask the other pending changes.
+	preflight := preflightRules(cr)
+	setPreflightCondition(cr, preflight)
 
 	archivedCr := pointer.BoolDeref(cr.Spec.ForProvider.Archived, false)
 
@@ -1463,72 +1889,196 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
+
+	// Archived repositories reject most edits, so a repository is unarchived
+	// before and archived after all other changes.
+	if repo.GetArchived() {
+		if pointer.BoolDeref(cr.Spec.ForProvider.Archived, true) {
+			return managed.ExternalUpdate{}, nil
+		}
+		if err := setArchived(ctx, c.github, cr.Spec.ForProvider.Org, name, false); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
 	if repo.Fork != nil && !*repo.Fork {
 		val := pointer.BoolDeref(cr.Spec.ForProvider.Private, true)
 		privateCr = &val
 	}
 
-	isTemplate := pointer.BoolDeref(cr.Spec.ForProvider.IsTemplate, false)
+	// Only the settings and sub-resources Observe found to differ are
+	// written. They are all written if it's unknown what differs.
+	if c.drift.has(settingsKeys...) {
+		isTemplate := pointer.BoolDeref(cr.Spec.ForProvider.IsTemplate, false)
+
+		_, _, err = c.github.Repositories.Edit(ctx, cr.Spec.ForProvider.Org, name, &github.Repository{
+			Name:           &name,
+			Description:    &cr.Spec.ForProvider.Description,
+			Private:        privateCr,
+			IsTemplate:     &isTemplate,
+			HasIssues:      cr.Spec.ForProvider.HasIssues,
+			HasWiki:        cr.Spec.ForProvider.HasWiki,
+			HasProjects:    cr.Spec.ForProvider.HasProjects,
+			HasDiscussions: cr.Spec.ForProvider.HasDiscussions,
+			HasDownloads:   cr.Spec.ForProvider.HasDownloads,
+		})
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
 
-	_, _, err = c.github.Repositories.Edit(ctx, cr.Spec.ForProvider.Org, name, &github.Repository{
-		Name:        &name,
-		Description: &cr.Spec.ForProvider.Description,
-		Archived:    &archivedCr,
-		Private:     privateCr,
-		IsTemplate:  &isTemplate,
-	})
-	if err != nil {
-		return managed.ExternalUpdate{}, err
+	if c.drift.has("topics") {
+		requiredTopics, err := getRequiredTopics(ctx, c.kube, cr)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		err = updateRequiredTopics(ctx, cr, c.github, name, requiredTopics, repo.Topics)
+		if err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 	}
 
-	err = updateRepoUsers(ctx, cr, c.github, name)
+	// Bootstrap before protecting branches, so that seed files can still be pushed.
+	err = runBootstrapActions(ctx, cr, c.github, c.kube, name)
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	err = updateRepoTeams(ctx, cr, c.github, name)
-	if err != nil {
-		return managed.ExternalUpdate{}, err
+	skipped := c.drift.skippedOr(&permissions.Skipped{})
+	defer func() { cr.SetConditions(skipped.Condition()) }()
+
+	// Permissions, webhooks, branch protection rules and rulesets are synced
+	// apart from each other, and their errors returned once the rest of the
+	// Repository is synced.
+	groups := &groupSync{cr: cr, skipped: skipped}
+	if c.drift.has("permissions.users", "permissions.teams") {
+		if err := validateCustomRoles(ctx, c.github, cr); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+		var results []subResourceResult
+		if c.drift.has("permissions.users") {
+			results = append(results, subResourceResult{"collaborators", updateRepoUsers(ctx, cr, c.github, c.recorder, name)})
+		}
+		if c.drift.has("permissions.teams") {
+			results = append(results, subResourceResult{"teams", updateRepoTeams(ctx, cr, c.github, name)})
+		}
+		groups.record(v1alpha1.TypePermissionsSynced, results...)
 	}
 
-	if cr.Spec.ForProvider.Webhooks != nil {
-		err = updateRepoWebhooks(ctx, cr, c.github, name)
-		if err != nil {
+	if cr.Spec.ForProvider.Webhooks != nil && c.drift.has("webhooks") {
+		groups.record(v1alpha1.TypeWebhooksSynced,
+			subResourceResult{"webhooks", updateRepoWebhooks(ctx, cr, c.github, name)})
+	}
+
+	if cr.Spec.ForProvider.BranchProtectionRules != nil && c.drift.has("branchProtectionRules") {
+		groups.record(v1alpha1.TypeProtectionsSynced,
+			subResourceResult{"branch protection rules", updateProtectedBranches(ctx, cr, c.github, c.kube, name, preflight.branchProtectionRules)})
+	}
+	if cr.Spec.ForProvider.RepositoryRules != nil && c.drift.has("repositoryRules") {
+		groups.record(v1alpha1.TypeRulesetsSynced,
+			subResourceResult{"repository rulesets", updateRepositoryRules(ctx, cr, c.github, c.kube, name, preflight.repositoryRules)})
+	}
+
+	if cr.Spec.ForProvider.CustomProperties != nil && c.drift.has("customProperties") {
+		err = updateCustomProperties(ctx, c.github, cr, name)
+		if _, err := skipped.Skip("custom properties", err); err != nil {
 			return managed.ExternalUpdate{}, err
 		}
 	}
 
-	if cr.Spec.ForProvider.BranchProtectionRules != nil {
-		err = updateProtectedBranches(ctx, cr, c.github, name)
-		if err != nil {
+	if cr.Spec.ForProvider.Environments != nil && c.drift.has("environments") {
+		err = updateEnvironments(ctx, c.github, cr, name)
+		if _, err := skipped.Skip("environments", err); err != nil {
 			return managed.ExternalUpdate{}, err
 		}
 	}
-	if cr.Spec.ForProvider.RepositoryRules != nil {
-		err = updateRepositoryRules(ctx, cr, c.github, name)
-		if err != nil {
+
+	if cr.Spec.ForProvider.OIDCSubjectClaim != nil && c.drift.has("oidcSubjectClaim") {
+		upToDate, err := getOIDCSubjectClaimUpToDate(ctx, c.github, cr, name)
+		if err == nil && !upToDate {
+			err = updateOIDCSubjectClaim(ctx, c.github, cr, name)
+		}
+		if _, err := skipped.Skip("OIDC subject claim", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	// The default setup isn't configured on Create, since GitHub rejects it
+	// for a repository without code yet.
+	if cr.Spec.ForProvider.CodeScanning != nil && c.drift.has("codeScanning") {
+		err = updateCodeScanning(ctx, c.github, cr, name)
+		if _, err := skipped.Skip("code scanning", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.SecretScanningDelegatedBypass != nil && c.drift.has("secretScanningDelegatedBypass") {
+		err = updateDelegatedBypass(ctx, c.github, cr, name)
+		if _, err := skipped.Skip("secret scanning delegated bypass", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.DependabotSecrets != nil && c.drift.has("dependabotSecrets") {
+		store := dependabotSecretStore{c.github.Dependabot}
+		versions, err := updateRepoSecrets(ctx, c.kube, store, cr.Spec.ForProvider.Org, name, cr.Spec.ForProvider.DependabotSecrets, cr.Status.AtProvider.DependabotSecretVersions)
+		cr.Status.AtProvider.DependabotSecretVersions = versions
+		if _, err := skipped.Skip("dependabot secrets", err); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
+	}
+
+	if cr.Spec.ForProvider.CodespacesSecrets != nil && c.drift.has("codespacesSecrets") {
+		store := codespacesSecretStore{c.github.Codespaces}
+		versions, err := updateRepoSecrets(ctx, c.kube, store, cr.Spec.ForProvider.Org, name, cr.Spec.ForProvider.CodespacesSecrets, cr.Status.AtProvider.CodespacesSecretVersions)
+		cr.Status.AtProvider.CodespacesSecretVersions = versions
+		if _, err := skipped.Skip("codespaces secrets", err); err != nil {
 			return managed.ExternalUpdate{}, err
 		}
+	}
+
+	if groups.err != nil {
+		return managed.ExternalUpdate{}, groups.err
+	}
 
+	if archivedCr {
+		if err := setArchived(ctx, c.github, cr.Spec.ForProvider.Org, name, true); err != nil {
+			return managed.ExternalUpdate{}, err
+		}
 	}
 
 	return managed.ExternalUpdate{}, nil
 }
 
+// setArchived archives or unarchives a repository.
+func setArchived(ctx context.Context, gh *ghclient.Client, org, name string, archived bool) error {
+	_, _, err := gh.Repositories.Edit(ctx, org, name, &github.Repository{Archived: &archived})
+	if archived {
+		return errors.Wrap(err, errArchiveRepository)
+	}
+	return errors.Wrap(err, errUnarchiveRepository)
+}
+
 func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
 	cr, ok := mg.(*v1alpha1.Repository)
 	if !ok {
 		return errors.New(errNotRepository)
 	}
 
-	name := meta.GetExternalName(cr)
+	name, err := repositoryName(cr)
+	if err != nil {
+		return err
+	}
 
-	forceDelete := pointer.BoolDeref(cr.Spec.ForProvider.ForceDelete, false)
-	if !forceDelete {
-		return errors.New("You can only delete repositories by setting `forceDelete: true`")
+	// A repository is never deleted on GitHub without forceDelete, whatever
+	// the deletion policy; the Repository stays until either is changed.
+	if !pointer.BoolDeref(cr.Spec.ForProvider.ForceDelete, false) {
+		cr.SetConditions(v1alpha1.DeletionUnconfirmed())
+		return errors.New(errDeleteUnconfirmed)
 	}
+	cr.SetConditions(v1alpha1.DeletionConfirmed())
 
-	_, err := c.github.Repositories.Delete(ctx, cr.Spec.ForProvider.Org, name)
+	_, err = c.github.Repositories.Delete(ctx, cr.Spec.ForProvider.Org, name)
 	if err != nil {
 		return err
 	}
@@ -18,14 +18,30 @@ package repository
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/nacl/box"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
 	"github.com/crossplane/provider-github/internal/clients/fake"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/util"
 
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
 	"github.com/crossplane/crossplane-runtime/pkg/meta"
 	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
 	"github.com/crossplane/crossplane-runtime/pkg/resource"
@@ -50,10 +66,14 @@ var (
 	private     = true
 	isTemplate  = false
 
-	user1     = "test-user-1"
-	user1Role = "admin"
-	user2     = "test-user-1"
-	user2Role = "pull"
+	errForbidden = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden}, Message: "Resource not accessible by integration"}
+	errNotFound  = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+
+	user1          = "test-user-1"
+	user1MixedCase = "Test-User-1"
+	user1Role      = "admin"
+	user2          = "test-user-1"
+	user2Role      = "pull"
 
 	team1     = "test-team-1"
 	team1Role = "admin"
@@ -78,6 +98,19 @@ var (
 	bpr1allowForkSyncing               = false
 	bpr1requireSignedCommits           = false
 	bpr1requiredStatusCheck            = "terraform_validate"
+	bpr1requiredApprovingReviewCount   = 2
+	bpr1dismissStaleReviews            = true
+	bpr1requireCodeOwnerReviews        = true
+	bpr1requireLastPushApproval        = true
+
+	bpr2pattern         = "release/*"
+	bpr2matchingBranch  = "release/1.0"
+	bpr2ruleID          = "BPR_kwDOAbc123"
+	bpr2repositoryID    = "R_kgDOAbc123"
+	bpr2requiredCheck   = "build"
+	bpr2strictChecks    = true
+	bpr2enforceAdmins   = true
+	bpr2blocksCreations = true
 
 	rr1Id                         int64 = 123
 	rr1name                             = "test-ruleset-1"
@@ -94,6 +127,26 @@ var (
 	rr1actorId                    int64 = 123
 	rr1Include                          = []string{"include"}
 	rr1Exclude                          = []string{"exclude"}
+	rr1pullRequestDismissStale          = true
+	rr1pullRequestApprovingCount        = 2
+	rr1statusCheck1                     = "build"
+	rr1statusCheck2                     = "lint"
+	rr1statusCheckIntegrationId   int64 = 456
+	rr1statusCheckStrict                = true
+	rr1commitMessageOperator            = "starts_with"
+	rr1commitMessagePattern             = "JIRA-"
+	rr1branchNameOperator               = "regex"
+	rr1branchNamePattern                = "^(feature|fix)/"
+	rr1branchNameNegate                 = false
+	rr1workflowPath                     = ".github/workflows/ci.yaml"
+	rr1workflowRepo                     = "shared-workflows"
+	rr1workflowRepoId             int64 = 789
+	rr1pushTarget                       = "push"
+	rr1maxFileSize                      = 10
+
+	org           = "test-org"
+	requiredTopic = "managed-by-crossplane"
+	labelTopicKey = "cost-center"
 )
 
 func withTeamPermission() repositoryModifier {
@@ -102,8 +155,115 @@ func withTeamPermission() repositoryModifier {
 	}
 }
 
+func withPullRequestRule() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].Rules.PullRequest = &v1alpha1.RulesPullRequest{
+			DismissStaleReviewsOnPush:    &rr1pullRequestDismissStale,
+			RequiredApprovingReviewCount: &rr1pullRequestApprovingCount,
+		}
+	}
+}
+
+func withStatusChecksRule() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].Rules.RequiredStatusChecks = &v1alpha1.RulesRequiredStatusChecks{
+			StrictRequiredStatusChecksPolicy: &rr1statusCheckStrict,
+			RequiredStatusChecks: []*v1alpha1.RulesRequiredStatusChecksParameters{
+				{Context: rr1statusCheck2},
+				{Context: rr1statusCheck1, IntegrationId: &rr1statusCheckIntegrationId},
+			},
+		}
+	}
+}
+
+func withPatternRules() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].Rules.CommitMessagePattern = &v1alpha1.RulesPattern{
+			Operator: rr1commitMessageOperator,
+			Pattern:  rr1commitMessagePattern,
+		}
+		r.Spec.ForProvider.RepositoryRules[0].Rules.BranchNamePattern = &v1alpha1.RulesPattern{
+			Operator: rr1branchNameOperator,
+			Pattern:  rr1branchNamePattern,
+			Negate:   &rr1branchNameNegate,
+		}
+	}
+}
+
+func withWorkflowsRule() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].Rules.Workflows = &v1alpha1.RulesWorkflows{
+			Workflows: []*v1alpha1.RulesWorkflow{
+				{Path: rr1workflowPath, Repo: rr1workflowRepo},
+			},
+		}
+	}
+}
+
+func withPushRuleset() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].Target = &rr1pushTarget
+		r.Spec.ForProvider.RepositoryRules[0].Conditions = nil
+		r.Spec.ForProvider.RepositoryRules[0].Rules.FilePathRestriction = &v1alpha1.RulesFilePathRestriction{
+			RestrictedFilePaths: []string{"secrets/**", ".env"},
+		}
+		r.Spec.ForProvider.RepositoryRules[0].Rules.MaxFileSize = &rr1maxFileSize
+	}
+}
+
+func withOrgLabels() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.Org = org
+		r.SetLabels(map[string]string{labelTopicKey: "platform"})
+	}
+}
+
+func withBypassTeam() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.RepositoryRules[0].BypassActors[0] = &v1alpha1.RulesetByPassActors{
+			Team:       team1,
+			BypassMode: &rr1bypassMode,
+		}
+	}
+}
+
+func withRequiredReviews() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.BranchProtectionRules[0].RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{
+			DismissStaleReviews:          bpr1dismissStaleReviews,
+			RequireCodeOwnerReviews:      bpr1requireCodeOwnerReviews,
+			RequiredApprovingReviewCount: bpr1requiredApprovingReviewCount,
+			RequireLastPushApproval:      &bpr1requireLastPushApproval,
+		}
+	}
+}
+
+func withBranchPattern() repositoryModifier {
+	return func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.BranchProtectionRules = append(r.Spec.ForProvider.BranchProtectionRules, v1alpha1.RepositoryBranchProtectionRule{
+			Branch:        bpr2pattern,
+			EnforceAdmins: bpr2enforceAdmins,
+			RequiredStatusChecks: &v1alpha1.RequiredStatusChecks{
+				Strict: bpr2strictChecks,
+				Checks: []*v1alpha1.RequiredStatusCheck{
+					{
+						Context: bpr2requiredCheck,
+					},
+				},
+			},
+			BranchProtectionRestrictions: &v1alpha1.BranchProtectionRestrictions{
+				BlockCreations: &bpr2blocksCreations,
+			},
+		})
+	}
+}
+
 func repository(m ...repositoryModifier) *v1alpha1.Repository {
 	cr := &v1alpha1.Repository{}
+	cr.Spec.ForProvider.Description = description
+	cr.Spec.ForProvider.Archived = &archived
+	cr.Spec.ForProvider.Private = &private
+	cr.Spec.ForProvider.IsTemplate = &isTemplate
 	cr.Spec.ForProvider.Permissions = v1alpha1.RepositoryPermissions{
 		Users: []v1alpha1.RepositoryUser{
 			{
@@ -137,7 +297,7 @@ func repository(m ...repositoryModifier) *v1alpha1.Repository {
 		},
 	}
 
-	cr.Spec.ForProvider.BranchProtectionRules = []v1alpha1.BranchProtectionRule{
+	cr.Spec.ForProvider.BranchProtectionRules = []v1alpha1.RepositoryBranchProtectionRule{
 		{
 			Branch:                         bpr1branch,
 			EnforceAdmins:                  bpr1enforceAdmins,
@@ -158,7 +318,7 @@ func repository(m ...repositoryModifier) *v1alpha1.Repository {
 			},
 		},
 	}
-	cr.Spec.ForProvider.RepositoryRules = []v1alpha1.RepositoryRuleset{
+	cr.Spec.ForProvider.RepositoryRules = []v1alpha1.Ruleset{
 		{
 			Name:        rr1name,
 			Target:      &rr1target,
@@ -257,6 +417,60 @@ func githubProtectedBranch() *github.Protection {
 	}
 }
 
+func githubProtectedBranchWithReviews(count int) *github.Protection {
+	p := githubProtectedBranch()
+	p.RequiredPullRequestReviews = &github.PullRequestReviewsEnforcement{
+		DismissStaleReviews:          bpr1dismissStaleReviews,
+		RequireCodeOwnerReviews:      bpr1requireCodeOwnerReviews,
+		RequiredApprovingReviewCount: count,
+		RequireLastPushApproval:      bpr1requireLastPushApproval,
+	}
+	return p
+}
+
+func githubProtectedBranchWithAnyAppCheck() *github.Protection {
+	p := githubProtectedBranch()
+	anyApp := int64(-1)
+	(*p.RequiredStatusChecks.Checks)[0].AppID = &anyApp
+	return p
+}
+
+func noBranchPatternRules() *fake.MockBranchProtectionRulesClient {
+	return &fake.MockBranchProtectionRulesClient{
+		MockListBranchProtectionRules: func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+			return &ghclient.RepositoryBranchProtectionRules{RepositoryID: bpr2repositoryID}, nil
+		},
+	}
+}
+
+func githubBranchPatternRules() *fake.MockBranchProtectionRulesClient {
+	return &fake.MockBranchProtectionRulesClient{
+		MockListBranchProtectionRules: func(ctx context.Context, owner, repo string) (*ghclient.RepositoryBranchProtectionRules, error) {
+			return &ghclient.RepositoryBranchProtectionRules{
+				RepositoryID: bpr2repositoryID,
+				Rules: []*ghclient.BranchProtectionRule{
+					{
+						ID: bpr2ruleID,
+						BranchProtectionRuleSettings: ghclient.BranchProtectionRuleSettings{
+							Pattern:                    bpr2pattern,
+							IsAdminEnforced:            bpr2enforceAdmins,
+							RequiresStatusChecks:       true,
+							RequiresStrictStatusChecks: bpr2strictChecks,
+							RestrictsPushes:            true,
+							BlocksCreations:            bpr2blocksCreations,
+						},
+						RequiredStatusChecks: []ghclient.RequiredStatusCheckDescription{
+							{
+								Context: bpr2requiredCheck,
+							},
+						},
+					},
+				},
+			}, nil
+		},
+	}
+}
+
 func githubRuleset() []*github.Ruleset {
 	return []*github.Ruleset{
 		{
@@ -302,6 +516,76 @@ func githubRuleset() []*github.Ruleset {
 
 }
 
+func githubRulesetWithPullRequestRule() *github.Ruleset {
+	rs := githubRuleset()[0]
+	rs.Rules = append(rs.Rules, github.NewPullRequestRule(&github.PullRequestRuleParameters{
+		DismissStaleReviewsOnPush:    rr1pullRequestDismissStale,
+		RequiredApprovingReviewCount: rr1pullRequestApprovingCount,
+	}))
+	return rs
+}
+
+func githubRulesetWithStatusChecksRule() *github.Ruleset {
+	rs := githubRuleset()[0]
+	rs.Rules = append(rs.Rules, github.NewRequiredStatusChecksRule(&github.RequiredStatusChecksRuleParameters{
+		RequiredStatusChecks: []github.RuleRequiredStatusChecks{
+			{Context: rr1statusCheck1, IntegrationID: &rr1statusCheckIntegrationId},
+			{Context: rr1statusCheck2},
+		},
+		StrictRequiredStatusChecksPolicy: rr1statusCheckStrict,
+	}))
+	return rs
+}
+
+func githubRulesetWithPatternRules() *github.Ruleset {
+	rs := githubRuleset()[0]
+	rs.Rules = append(rs.Rules,
+		github.NewBranchNamePatternRule(&github.RulePatternParameters{
+			Operator: rr1branchNameOperator,
+			Pattern:  rr1branchNamePattern,
+		}),
+		github.NewCommitMessagePatternRule(&github.RulePatternParameters{
+			Operator: rr1commitMessageOperator,
+			Pattern:  rr1commitMessagePattern,
+		}),
+	)
+	return rs
+}
+
+func githubRulesetWithWorkflowsRule() *github.Ruleset {
+	rs := githubRuleset()[0]
+	rs.Rules = append(rs.Rules, github.NewRequiredWorkflowsRule(&github.RequiredWorkflowsRuleParameters{
+		RequiredWorkflows: []*github.RuleRequiredWorkflow{
+			{Path: rr1workflowPath, RepositoryID: &rr1workflowRepoId},
+		},
+	}))
+	return rs
+}
+
+func githubPushRuleset() *github.Ruleset {
+	rs := githubRuleset()[0]
+	rs.Target = &rr1pushTarget
+	rs.Conditions = nil
+	rs.Rules = append(rs.Rules,
+		util.NewRawRule("file_path_restriction", util.FilePathRestrictionRuleParameters{RestrictedFilePaths: []string{".env", "secrets/**"}}),
+		util.NewRawRule("max_file_size", util.MaxFileSizeRuleParameters{MaxFileSize: rr1maxFileSize}),
+	)
+	return rs
+}
+
+func organizationsWithRepositoryDefaults(obj client.ObjectList) error {
+	o := v1alpha1.Organization{}
+	meta.SetExternalName(&o, org)
+	o.Spec.ForProvider.RepositoryDefaults = &v1alpha1.RepositoryDefaults{
+		Topics:           []string{requiredTopic},
+		TopicsFromLabels: []string{labelTopicKey},
+	}
+	if l, ok := obj.(*v1alpha1.OrganizationList); ok {
+		l.Items = []v1alpha1.Organization{o}
+	}
+	return nil
+}
+
 func githubCollaborators() []*github.User {
 	return []*github.User{
 		{
@@ -344,6 +628,7 @@ func githubBranches() []*github.Branch {
 func TestObserve(t *testing.T) {
 	type fields struct {
 		github *ghclient.Client
+		kube   client.Reader
 	}
 
 	type args struct {
@@ -352,10 +637,21 @@ func TestObserve(t *testing.T) {
 	}
 
 	type want struct {
-		o   managed.ExternalObservation
-		err error
+		o           managed.ExternalObservation
+		ready       *xpv1.Condition
+		permissions *xpv1.Condition
+		upToDate    *xpv1.Condition
+		archived    *xpv1.Condition
+		rulesValid  *xpv1.Condition
+		err         error
 	}
 
+	pendingRulesets := waitingFor("repository rulesets")
+	invalidRuleset := v1alpha1.RulesInvalid(fmt.Sprintf("repository ruleset %q: enforcement must be one of [disabled active evaluate], got \"enforced\"", rr1name))
+	drifted := v1alpha1.Drifted("differs from GitHub: permissions.teams[test-team-2], webhooks[https://example.org/webhook], hasWiki")
+	archivedOnGitHub := v1alpha1.Archived()
+	missingWebhookPermissions := v1alpha1.PermissionsMissing("skipped webhooks: missing token scopes or App permissions")
+
 	cases := map[string]struct {
 		reason string
 		fields fields
@@ -363,11 +659,15 @@ func TestObserve(t *testing.T) {
 		want   want
 	}{
 		"NotUpToDate": {
+			reason: "Every sub-resource that differs should be listed in the up to date condition.",
 			fields: fields{
 				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
 					Repositories: &fake.MockRepositoriesClient{
 						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
-							return githubRepository(), nil, nil
+							r := githubRepository()
+							r.HasWiki = github.Bool(true)
+							return r, nil, nil
 						},
 						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
 							return nil, nil, nil
@@ -384,6 +684,9 @@ func TestObserve(t *testing.T) {
 						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
 							return []*github.Branch{}, fake.GenerateEmptyResponse(), nil
 						},
+						MockGetBranch: func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+							return nil, nil, fake.Generate404Response()
+						},
 						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
 							return githubRuleset(), fake.GenerateEmptyResponse(), nil
 						},
@@ -394,19 +697,24 @@ func TestObserve(t *testing.T) {
 				},
 			},
 			args: args{
-				mg: repository(withTeamPermission()),
+				mg: repository(withTeamPermission(), func(r *v1alpha1.Repository) {
+					r.Spec.ForProvider.HasWiki = github.Bool(false)
+				}),
 			},
 			want: want{
 				o: managed.ExternalObservation{
 					ResourceExists:   true,
 					ResourceUpToDate: false,
+					Diff:             "permissions.teams[test-team-2], webhooks[https://example.org/webhook], hasWiki",
 				},
-				err: nil,
+				upToDate: &drifted,
+				err:      nil,
 			},
 		},
 		"UpToDate": {
 			fields: fields{
 				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
 					Repositories: &fake.MockRepositoriesClient{
 						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
 							return githubRepository(), nil, nil
@@ -423,6 +731,9 @@ func TestObserve(t *testing.T) {
 						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
 							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
 						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
 						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
 							return githubBranches(), fake.GenerateEmptyResponse(), nil
 						},
@@ -449,13 +760,193 @@ func TestObserve(t *testing.T) {
 				err: nil,
 			},
 		},
-		"DoesNotExist": {
+		"UpToDateInvalidRuleset": {
+			reason: "A ruleset that fails pre-flight validation is never applied, so it should not keep the repository from being up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(func(r *v1alpha1.Repository) {
+					r.Spec.ForProvider.RepositoryRules[0].Enforcement = github.String("enforced")
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				rulesValid: &invalidRuleset,
+			},
+		},
+		"UpToDateMissingWebhookPermissions": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return nil, nil, errForbidden
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				permissions: &missingWebhookPermissions,
+				err:         nil,
+			},
+		},
+		"UpToDateMixedCaseNames": {
+			reason: "Logins and slugs that only differ in case from GitHub should be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(func(r *v1alpha1.Repository) {
+					r.Spec.ForProvider.Permissions.Users[0].User = user1MixedCase
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDatePendingBranch": {
+			reason: "A rule for a branch that doesn't exist yet should be pending rather than out of date.",
 			fields: fields{
 				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
 					Repositories: &fake.MockRepositoriesClient{
 						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return []*github.Branch{}, fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranch: func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
 							return nil, nil, fake.Generate404Response()
 						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
 					},
 				},
 			},
@@ -464,24 +955,3519 @@ func TestObserve(t *testing.T) {
 			},
 			want: want{
 				o: managed.ExternalObservation{
-					ResourceExists:   false,
-					ResourceUpToDate: false,
+					ResourceExists:   true,
+					ResourceUpToDate: true,
 				},
 				err: nil,
 			},
 		},
-	}
-
-	for name, tc := range cases {
-		t.Run(name, func(t *testing.T) {
-			e := external{github: tc.fields.github}
-			got, err := e.Observe(tc.args.ctx, tc.args.mg)
-			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
-				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
-			}
-			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+		"UpToDateBranchPattern": {
+			reason: "A rule for a branch pattern should be compared with the GraphQL rules, and the branches it protects should be ignored by the REST comparison.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: githubBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return append(githubBranches(), &github.Branch{Name: &bpr2matchingBranch, Protected: github.Bool(true)}), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withBranchPattern()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDateReviewSettings": {
+			reason: "Matching pull request review settings should be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranchWithReviews(bpr1requiredApprovingReviewCount), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withRequiredReviews()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDateAnyAppStatusCheck": {
+			reason: "A status check GitHub reports with app ID -1 should match a check without an appId.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranchWithAnyAppCheck(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"NotUpToDateReviewSettings": {
+			reason: "A differing required approving review count should not be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranchWithReviews(1), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withRequiredReviews()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "branchProtectionRules",
+				},
+				err: nil,
+			},
+		},
+		"UpToDateBypassTeam": {
+			reason: "Bypass actors referencing a team should be resolved to its ID.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Teams: &fake.MockTeamsClient{
+						MockGetTeamBySlug: func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+							return &github.Team{ID: &rr1actorId}, nil, nil
+						},
+					},
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withBypassTeam()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"MissingRequiredTopics": {
+			reason: "A repository missing topics required by its Organization should not be up to date.",
+			fields: fields{
+				kube: &test.MockClient{MockList: test.NewMockListFn(nil, organizationsWithRepositoryDefaults)},
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withOrgLabels()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "topics",
+				},
+				err: nil,
+			},
+		},
+		"UpToDatePullRequestRule": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRulesetWithPullRequestRule(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withPullRequestRule()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDatePushRuleset": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return []*github.Ruleset{githubPushRuleset()}, fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubPushRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withPushRuleset()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDateWorkflowsRule": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							if repo == rr1workflowRepo {
+								return &github.Repository{ID: &rr1workflowRepoId}, nil, nil
+							}
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRulesetWithWorkflowsRule(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withWorkflowsRule()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"PendingRepositoryRules": {
+			reason: "A repository should not be ready while its declared rulesets are not present.",
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withPullRequestRule()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+					Diff:             "repositoryRules",
+				},
+				ready: &pendingRulesets,
+				err:   nil,
+			},
+		},
+		"UpToDatePatternRules": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRulesetWithPatternRules(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withPatternRules()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"UpToDateStatusChecksRuleOrder": {
+			fields: fields{
+				github: &ghclient.Client{
+					BranchProtectionRules: noBranchPatternRules(),
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return githubRepository(), nil, nil
+						},
+						MockEdit: func(ctx context.Context, owner, repo string, repository *github.Repository) (*github.Repository, *github.Response, error) {
+							return nil, nil, nil
+						},
+						MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+							return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+							return githubTeams(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+							return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+						},
+						MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+							return nil, fake.GenerateEmptyResponse(), nil
+						},
+						MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+							return githubBranches(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+							return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+							return githubRuleset(), fake.GenerateEmptyResponse(), nil
+						},
+						MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+							return githubRulesetWithStatusChecksRule(), fake.GenerateEmptyResponse(), nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(withStatusChecksRule()),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"Archived": {
+			reason: "An archived repository that is meant to be archived should be up to date without observing its sub-resources.",
+			fields: fields{
+				github: &ghclient.Client{
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							r := githubRepository()
+							r.Archived = github.Bool(true)
+							return r, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(func(r *v1alpha1.Repository) {
+					r.Spec.ForProvider.Archived = github.Bool(true)
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				err: nil,
+			},
+		},
+		"ArchivedOnGitHub": {
+			reason: "A repository archived on GitHub should be left alone while the spec doesn't set archived.",
+			fields: fields{
+				github: &ghclient.Client{
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							r := githubRepository()
+							r.Archived = github.Bool(true)
+							return r, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(func(r *v1alpha1.Repository) {
+					r.Spec.ForProvider.Archived = nil
+				}),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+				archived: &archivedOnGitHub,
+				err:      nil,
+			},
+		},
+		"DoesNotExist": {
+			fields: fields{
+				github: &ghclient.Client{
+					Repositories: &fake.MockRepositoriesClient{
+						MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+							return nil, nil, fake.Generate404Response()
+						},
+					},
+				},
+			},
+			args: args{
+				mg: repository(),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   false,
+					ResourceUpToDate: false,
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			kube := tc.fields.kube
+			if kube == nil {
+				kube = &test.MockClient{MockList: test.NewMockListFn(nil)}
+			}
+			e := external{github: tc.fields.github, kube: kube, recorder: event.NewNopRecorder()}
+			got, err := e.Observe(tc.args.ctx, tc.args.mg)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.ready != nil {
+				if got := tc.args.mg.GetCondition(xpv1.TypeReady); !got.Equal(*tc.want.ready) {
+					t.Errorf("\n%s\ne.Observe(...): want ready condition %v, got %v\n", tc.reason, *tc.want.ready, got)
+				}
+			}
+			if tc.want.permissions != nil {
+				if got := tc.args.mg.GetCondition(v1alpha1.TypePermissionsSufficient); !got.Equal(*tc.want.permissions) {
+					t.Errorf("\n%s\ne.Observe(...): want permissions condition %v, got %v\n", tc.reason, *tc.want.permissions, got)
+				}
+			}
+			if tc.want.archived != nil {
+				if got := tc.args.mg.GetCondition(v1alpha1.TypeArchived); !got.Equal(*tc.want.archived) {
+					t.Errorf("\n%s\ne.Observe(...): want archived condition %v, got %v\n", tc.reason, *tc.want.archived, got)
+				}
+			}
+			if tc.want.upToDate != nil {
+				if got := tc.args.mg.GetCondition(v1alpha1.TypeUpToDate); !got.Equal(*tc.want.upToDate) {
+					t.Errorf("\n%s\ne.Observe(...): want up to date condition %v, got %v\n", tc.reason, *tc.want.upToDate, got)
+				}
+			}
+			if tc.want.rulesValid != nil {
+				if got := tc.args.mg.GetCondition(v1alpha1.TypeRulesValid); !got.Equal(*tc.want.rulesValid) {
+					t.Errorf("\n%s\ne.Observe(...): want rules valid condition %v, got %v\n", tc.reason, *tc.want.rulesValid, got)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateArchived(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		archived   *bool
+		archivedGh bool
+		want       []string
+	}{
+		"ArchivesLast": {
+			reason:   "A repository should be archived after all other changes.",
+			archived: github.Bool(true),
+			want:     []string{"edit", "teams", "archive"},
+		},
+		"UnarchivesFirst": {
+			reason:     "A repository should be unarchived before all other changes.",
+			archived:   github.Bool(false),
+			archivedGh: true,
+			want:       []string{"unarchive", "edit", "teams"},
+		},
+		"LeavesArchived": {
+			reason:     "An archived repository that is meant to be archived should not be changed.",
+			archived:   github.Bool(true),
+			archivedGh: true,
+		},
+		"LeavesArchivedOnGitHub": {
+			reason:     "A repository archived on GitHub should not be changed unless the spec explicitly unarchives it.",
+			archivedGh: true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						r := githubRepository()
+						r.Archived = github.Bool(tc.archivedGh)
+						return r, nil, nil
+					},
+					MockEdit: func(ctx context.Context, owner, repo string, r *github.Repository) (*github.Repository, *github.Response, error) {
+						switch {
+						case r.Archived == nil:
+							got = append(got, "edit")
+						case *r.Archived:
+							got = append(got, "archive")
+						default:
+							got = append(got, "unarchive")
+						}
+						return nil, nil, nil
+					},
+					MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+						return nil, nil, nil
+					},
+					MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+						got = append(got, "teams")
+						return nil, nil, nil
+					},
+				},
+			}
+			cr := &v1alpha1.Repository{}
+			meta.SetExternalName(cr, repo)
+			cr.Spec.ForProvider.Org = org
+			cr.Spec.ForProvider.Archived = tc.archived
+
+			e := external{github: gh, kube: &test.MockClient{MockList: test.NewMockListFn(nil)}, recorder: event.NewNopRecorder()}
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	type want struct {
+		deleted   []string
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason      string
+		forceDelete *bool
+		want        want
+	}{
+		"ForceDeleteUnset": {
+			reason: "A repository should never be deleted on GitHub without forceDelete.",
+			want: want{
+				condition: v1alpha1.DeletionUnconfirmed(),
+				err:       errors.New(errDeleteUnconfirmed),
+			},
+		},
+		"ForceDeleteFalse": {
+			reason:      "A repository should never be deleted on GitHub if forceDelete is false.",
+			forceDelete: github.Bool(false),
+			want: want{
+				condition: v1alpha1.DeletionUnconfirmed(),
+				err:       errors.New(errDeleteUnconfirmed),
+			},
+		},
+		"ForceDeleteTrue": {
+			reason:      "A repository should be deleted on GitHub if forceDelete is true.",
+			forceDelete: github.Bool(true),
+			want: want{
+				deleted:   []string{org + "/" + repo},
+				condition: v1alpha1.DeletionConfirmed(),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockDelete: func(ctx context.Context, owner, repo string) (*github.Response, error) {
+						got = append(got, owner+"/"+repo)
+						return nil, nil
+					},
+				},
+			}
+			cr := &v1alpha1.Repository{}
+			meta.SetExternalName(cr, repo)
+			cr.Spec.ForProvider.Org = org
+			cr.Spec.ForProvider.ForceDelete = tc.forceDelete
+
+			e := external{github: gh}
+			err := e.Delete(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.deleted, got); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want deleted, +got deleted:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, cr.GetCondition(v1alpha1.TypeDeletionConfirmed), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want condition, +got condition:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveRenamed(t *testing.T) {
+	type want struct {
+		o          managed.ExternalObservation
+		renameFrom string
+		err        error
+	}
+
+	id := int64(42)
+	repository := func(owner, name string) func(ctx context.Context, id int64) (*github.Repository, *github.Response, error) {
+		return func(ctx context.Context, id int64) (*github.Repository, *github.Response, error) {
+			return &github.Repository{Name: github.String(name), Owner: &github.User{Login: github.String(owner)}}, nil, nil
+		}
+	}
+
+	cases := map[string]struct {
+		reason  string
+		id      *int64
+		getByID func(ctx context.Context, id int64) (*github.Repository, *github.Response, error)
+		want    want
+	}{
+		"Renamed": {
+			reason:  "A repository that exists by its ID under another name should be renamed.",
+			id:      &id,
+			getByID: repository(org, "old-repo"),
+			want: want{
+				o:          managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "name"},
+				renameFrom: "old-repo",
+			},
+		},
+		"NeverObserved": {
+			reason: "A repository that was never observed should be created.",
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Deleted": {
+			reason: "A repository that doesn't exist by its ID either should be created.",
+			id:     &id,
+			getByID: func(ctx context.Context, id int64) (*github.Repository, *github.Response, error) {
+				return nil, nil, errNotFound
+			},
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"Transferred": {
+			reason:  "A repository that was transferred to another organization should not be renamed.",
+			id:      &id,
+			getByID: repository("other-org", "old-repo"),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return nil, nil, errNotFound
+					},
+					MockGetByID: tc.getByID,
+				},
+			}
+			cr := &v1alpha1.Repository{}
+			meta.SetExternalName(cr, repo)
+			cr.Spec.ForProvider.Org = org
+			cr.Status.AtProvider.ID = tc.id
+
+			e := external{github: gh}
+			got, err := e.Observe(context.Background(), cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
 				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
 			}
+			if diff := cmp.Diff(tc.want.renameFrom, e.renameFrom); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want rename, +got rename:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateDrifted(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		drift      *drift
+		renameFrom string
+		want       []string
+	}{
+		"Renamed": {
+			reason:     "A repository whose external name changed should be renamed before anything else is written.",
+			renameFrom: "old-repo",
+			want:       []string{"rename old-repo", "edit", "collaborators", "teams"},
+		},
+		"Unknown": {
+			reason: "Everything should be written if it's unknown what differs.",
+			want:   []string{"edit", "collaborators", "teams"},
+		},
+		"Settings": {
+			reason: "Only the repository should be edited if only its settings differ.",
+			drift:  newDrift([]string{"hasWiki"}, &permissions.Skipped{}),
+			want:   []string{"edit"},
+		},
+		"Teams": {
+			reason: "Only the teams should be written if only they differ.",
+			drift:  newDrift([]string{"permissions.teams[devs]"}, &permissions.Skipped{}),
+			want:   []string{"teams"},
+		},
+		"Webhooks": {
+			reason: "Sub-resources that don't differ should not be written.",
+			drift:  newDrift([]string{"webhooks[https://example.org/hook]"}, &permissions.Skipped{}),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return githubRepository(), nil, nil
+					},
+					MockEdit: func(ctx context.Context, owner, repo string, r *github.Repository) (*github.Repository, *github.Response, error) {
+						if r.GetName() != "" && r.GetName() != repo {
+							got = append(got, "rename "+repo)
+							return nil, nil, nil
+						}
+						got = append(got, "edit")
+						return nil, nil, nil
+					},
+					MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+						got = append(got, "collaborators")
+						return nil, nil, nil
+					},
+					MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+						got = append(got, "teams")
+						return nil, nil, nil
+					},
+				},
+			}
+			cr := &v1alpha1.Repository{}
+			meta.SetExternalName(cr, repo)
+			cr.Spec.ForProvider.Org = org
+
+			e := external{github: gh, kube: &test.MockClient{MockList: test.NewMockListFn(nil)}, recorder: event.NewNopRecorder(), drift: tc.drift, renameFrom: tc.renameFrom}
+			if _, err := e.Update(context.Background(), cr); err != nil {
+				t.Fatalf("\n%s\ne.Update(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if tc.renameFrom != "" {
+				if diff := cmp.Diff([]string{tc.renameFrom}, cr.Status.AtProvider.PreviousNames); diff != "" {
+					t.Errorf("\n%s\ne.Update(...): -want previous names, +got previous names:\n%s\n", tc.reason, diff)
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestPreflightRules(t *testing.T) {
+	type want struct {
+		branchProtectionRules map[string][]string
+		repositoryRules       map[string][]string
+	}
+
+	invalidEnforcement := "enabled"
+	rr1tagTarget := "tag"
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Repository
+		want   want
+	}{
+		"Valid": {
+			reason: "A well formed repository should pass pre-flight validation.",
+			cr:     repository(),
+			want: want{
+				branchProtectionRules: map[string][]string{},
+				repositoryRules:       map[string][]string{},
+			},
+		},
+		"InvalidRules": {
+			reason: "Malformed rules should be reported by branch and ruleset name.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.BranchProtectionRules[0].RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{
+					RequiredApprovingReviewCount: 7,
+				}
+				r.Spec.ForProvider.RepositoryRules[0].Enforcement = &invalidEnforcement
+				r.Spec.ForProvider.RepositoryRules[0].BypassActors[0].ActorId = nil
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{
+					bpr1branch: {"requiredApprovingReviewCount must be between 0 and 6, got 7"},
+				},
+				repositoryRules: map[string][]string{
+					rr1name: {
+						"enforcement must be one of [disabled active evaluate], got \"enabled\"",
+						"bypass actor must set one of actorId, team and appSlug",
+					},
+				},
+			},
+		},
+		"UnsupportedBranchPatternSettings": {
+			reason: "Settings the GraphQL API can't manage by name should be reported for branch patterns.",
+			cr: repository(withBranchPattern(), func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.BranchProtectionRules[1].RequiredStatusChecks.Checks[0].AppID = &rr1statusCheckIntegrationId
+				r.Spec.ForProvider.BranchProtectionRules[1].BranchProtectionRestrictions.Teams = []string{team1}
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{
+					bpr2pattern: {
+						"required status check appId is not supported for branch patterns",
+						"push restrictions for users, teams and apps are not supported for branch patterns",
+					},
+				},
+				repositoryRules: map[string][]string{},
+			},
+		},
+		"FileRulesOnBranchRuleset": {
+			reason: "File rules should only be accepted on push rulesets.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.RepositoryRules[0].Rules.MaxFileSize = &rr1maxFileSize
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{},
+				repositoryRules: map[string][]string{
+					rr1name: {"file rules are only supported by rulesets with target \"push\""},
+				},
+			},
+		},
+		"BranchRulesOnTagRuleset": {
+			reason: "Rules and ref names that only apply to branches should be rejected on tag rulesets.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.RepositoryRules[0].Target = &rr1tagTarget
+				r.Spec.ForProvider.RepositoryRules[0].Conditions.RefName.Include = []string{"refs/tags/v*", "~DEFAULT_BRANCH"}
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{},
+				repositoryRules: map[string][]string{
+					rr1name: {
+						"conditions.refName.include \"~DEFAULT_BRANCH\" matches branches, but the ruleset targets tags",
+						"requiredLinearHistory is only supported by rulesets with target \"branch\"",
+					},
+				},
+			},
+		},
+		"TagRulesOnBranchRuleset": {
+			reason: "Rules and ref names that only apply to tags should be rejected on branch rulesets.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.RepositoryRules[0].Conditions.RefName.Exclude = []string{"refs/tags/v*"}
+				r.Spec.ForProvider.RepositoryRules[0].Rules.TagNamePattern = &v1alpha1.RulesPattern{
+					Operator: "starts_with",
+					Pattern:  "v",
+				}
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{},
+				repositoryRules: map[string][]string{
+					rr1name: {
+						"conditions.refName.exclude \"refs/tags/v*\" matches tags, but the ruleset targets branches",
+						"tagNamePattern is only supported by rulesets with target \"tag\"",
+					},
+				},
+			},
+		},
+		"InvalidPatternRules": {
+			reason: "Malformed pattern rules should be reported by ruleset name.",
+			cr: repository(withPatternRules(), func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.RepositoryRules[0].Rules.CommitMessagePattern.Operator = "equals"
+				r.Spec.ForProvider.RepositoryRules[0].Rules.BranchNamePattern.Pattern = "feature/("
+			}),
+			want: want{
+				branchProtectionRules: map[string][]string{},
+				repositoryRules: map[string][]string{
+					rr1name: {
+						"commitMessagePattern.operator must be one of [starts_with ends_with contains regex], got \"equals\"",
+						"branchNamePattern.pattern is not a valid regular expression: error parsing regexp: missing closing ): `feature/(`",
+					},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := preflightRules(tc.cr)
+			if diff := cmp.Diff(tc.want.branchProtectionRules, got.branchProtectionRules); diff != "" {
+				t.Errorf("\n%s\npreflightRules(...): -want branchProtectionRules, +got branchProtectionRules:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.repositoryRules, got.repositoryRules); diff != "" {
+				t.Errorf("\n%s\npreflightRules(...): -want repositoryRules, +got repositoryRules:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRunBootstrapActions(t *testing.T) {
+	type want struct {
+		completed []string
+		committed []string
+		err       error
+	}
+
+	created := func(r *v1alpha1.Repository) {
+		meta.SetExternalCreateSucceeded(r, time.Now())
+	}
+	withBootstrap := func(r *v1alpha1.Repository) {
+		r.Spec.ForProvider.Bootstrap = &v1alpha1.RepositoryBootstrap{
+			Files:        []v1alpha1.BootstrapFile{{Path: "README.md", Content: "# test"}},
+			Environments: []string{"production"},
+		}
+	}
+
+	withTemplates := func(key string) func(r *v1alpha1.Repository) {
+		return func(r *v1alpha1.Repository) {
+			r.Spec.ForProvider.Bootstrap.Templates = &v1alpha1.BootstrapTemplates{
+				IssueTemplatesRef: &v1alpha1.ConfigMapReference{Name: "issue-templates", Namespace: "default"},
+				PullRequestTemplateRef: &v1alpha1.ConfigMapKeySelector{
+					ConfigMapReference: v1alpha1.ConfigMapReference{Name: "pr-template", Namespace: "default"},
+					Key:                key,
+				},
+			}
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.Repository
+		want   want
+	}{
+		"RunsPendingActions": {
+			reason: "Pending actions of a created repository should run and be recorded.",
+			cr:     repository(created, withBootstrap),
+			want: want{
+				completed: []string{"file:README.md", "environment:production"},
+				committed: []string{"README.md"},
+			},
+		},
+		"SkipsCompletedActions": {
+			reason: "Completed actions should never run again.",
+			cr: repository(created, withBootstrap, func(r *v1alpha1.Repository) {
+				r.Status.AtProvider.CompletedBootstrapActions = []string{"file:README.md"}
+			}),
+			want: want{
+				completed: []string{"file:README.md", "environment:production"},
+			},
+		},
+		"SeedsCommitStatus": {
+			reason: "Commit statuses should be set on the default branch after the seed files were committed.",
+			cr: repository(created, withBootstrap, func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Bootstrap.CommitStatuses = []v1alpha1.BootstrapCommitStatus{{Context: "ci/build"}}
+			}),
+			want: want{
+				completed: []string{"file:README.md", "environment:production", "status:ci/build"},
+				committed: []string{"README.md"},
+			},
+		},
+		"CommitsTemplates": {
+			reason: "Issue and pull request templates should be committed from their ConfigMaps, except for the ones that already exist.",
+			cr:     repository(created, withBootstrap, withTemplates("template.md")),
+			want: want{
+				completed: []string{"file:README.md", "templates:issue", "templates:pull-request", "environment:production"},
+				committed: []string{"README.md", ".github/ISSUE_TEMPLATE/bug_report.md", ".github/PULL_REQUEST_TEMPLATE.md"},
+			},
+		},
+		"MissingTemplateKey": {
+			reason: "A pull request template key missing from its ConfigMap should be reported.",
+			cr:     repository(created, withBootstrap, withTemplates("missing.md")),
+			want: want{
+				completed: []string{"file:README.md", "templates:issue"},
+				committed: []string{"README.md", ".github/ISSUE_TEMPLATE/bug_report.md"},
+				err:       errors.Wrapf(errors.Errorf(errConfigMapKeyNotFound, "default", "pr-template", "missing.md"), errBootstrapAction, "templates:pull-request"),
+			},
+		},
+		"SkipsAdoptedRepository": {
+			reason: "Repositories not created by the controller should never be bootstrapped.",
+			cr:     repository(withBootstrap),
+			want:   want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var committed []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockCreateFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						if util.Contains(tc.cr.Status.AtProvider.CompletedBootstrapActions, "file:"+path) {
+							t.Errorf("\n%s\nCreateFile(...): unexpected call for completed action", tc.reason)
+						}
+						committed = append(committed, path)
+						return nil, nil, nil
+					},
+					MockGetContents: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+						// A previous attempt already committed config.yml.
+						if path == ".github/ISSUE_TEMPLATE/config.yml" {
+							return &github.RepositoryContent{}, nil, nil, nil
+						}
+						return nil, nil, nil, errNotFound
+					},
+					MockCreateUpdateEnvironment: func(ctx context.Context, owner, repo, name string, environment *github.CreateUpdateEnvironment) (*github.Environment, *github.Response, error) {
+						return nil, nil, nil
+					},
+					MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+						return &github.Repository{DefaultBranch: github.String("main")}, nil, nil
+					},
+					MockGetBranch: func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+						return &github.Branch{Name: github.String(branch), Commit: &github.RepositoryCommit{SHA: github.String("head")}}, nil, nil
+					},
+					MockCreateStatus: func(ctx context.Context, owner, repo, ref string, status *github.RepoStatus) (*github.RepoStatus, *github.Response, error) {
+						if ref != "head" || status.GetState() != "success" {
+							t.Errorf("\n%s\nCreateStatus(...): want success on head, got %s on %s", tc.reason, status.GetState(), ref)
+						}
+						return status, nil, nil
+					},
+				},
+			}
+			kube := &test.MockClient{
+				MockGet: func(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+					cm := obj.(*corev1.ConfigMap)
+					switch key.Name {
+					case "issue-templates":
+						cm.Data = map[string]string{"bug_report.md": "# Bug", "config.yml": "blank_issues_enabled: false"}
+					case "pr-template":
+						cm.Data = map[string]string{"template.md": "## Summary"}
+					}
+					return nil
+				},
+			}
+			err := runBootstrapActions(context.Background(), tc.cr, gh, kube, repo)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nrunBootstrapActions(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.completed, tc.cr.Status.AtProvider.CompletedBootstrapActions); diff != "" {
+				t.Errorf("\n%s\nrunBootstrapActions(...): -want completed, +got completed:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.committed, committed); diff != "" {
+				t.Errorf("\n%s\nrunBootstrapActions(...): -want committed, +got committed:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRunBootstrapActionsLostStatus(t *testing.T) {
+	type want struct {
+		committed []string
+		opened    []string
+	}
+
+	cases := map[string]struct {
+		reason    string
+		bootstrap *v1alpha1.RepositoryBootstrap
+		want      want
+	}{
+		"SeedFile": {
+			reason:    "A seed file whose action wasn't recorded as completed should not be committed again.",
+			bootstrap: &v1alpha1.RepositoryBootstrap{Files: []v1alpha1.BootstrapFile{{Path: "README.md", Content: "# test"}}},
+			want: want{
+				committed: []string{"README.md"},
+			},
+		},
+		"Issue": {
+			reason:    "A tracking issue whose action wasn't recorded as completed should not be opened again.",
+			bootstrap: &v1alpha1.RepositoryBootstrap{Issue: &v1alpha1.BootstrapIssue{Title: "Set up the repository"}},
+			want: want{
+				opened: []string{"Set up the repository"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var committed []string
+			// An open pull request with the title of the issue is not the issue.
+			issues := []*github.Issue{{Title: github.String("Set up the repository"), PullRequestLinks: &github.PullRequestLinks{}}}
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockCreateFile: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentFileOptions) (*github.RepositoryContentResponse, *github.Response, error) {
+						committed = append(committed, path)
+						return nil, nil, nil
+					},
+					MockGetContents: func(ctx context.Context, owner, repo, path string, opts *github.RepositoryContentGetOptions) (*github.RepositoryContent, []*github.RepositoryContent, *github.Response, error) {
+						if util.Contains(committed, path) {
+							return &github.RepositoryContent{}, nil, nil, nil
+						}
+						return nil, nil, nil, errNotFound
+					},
+				},
+				Issues: &fake.MockIssuesClient{
+					MockCreate: func(ctx context.Context, owner, repo string, issue *github.IssueRequest) (*github.Issue, *github.Response, error) {
+						created := &github.Issue{Title: issue.Title}
+						issues = append(issues, created)
+						return created, nil, nil
+					},
+					MockListByRepo: func(ctx context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, *github.Response, error) {
+						return issues, &github.Response{}, nil
+					},
+				},
+			}
+			cr := repository(func(r *v1alpha1.Repository) {
+				meta.SetExternalCreateSucceeded(r, time.Now())
+				r.Spec.ForProvider.Bootstrap = tc.bootstrap
+			})
+
+			if err := runBootstrapActions(context.Background(), cr, gh, nil, repo); err != nil {
+				t.Fatalf("\n%s\nrunBootstrapActions(...): %v", tc.reason, err)
+			}
+			// The completed actions are lost, e.g. as the status update
+			// conflicted, and the actions run a second time.
+			cr.Status.AtProvider.CompletedBootstrapActions = nil
+			if err := runBootstrapActions(context.Background(), cr, gh, nil, repo); err != nil {
+				t.Fatalf("\n%s\nrunBootstrapActions(...): %v", tc.reason, err)
+			}
+
+			var opened []string
+			for _, i := range issues {
+				if !i.IsPullRequest() {
+					opened = append(opened, i.GetTitle())
+				}
+			}
+			if diff := cmp.Diff(tc.want.committed, committed); diff != "" {
+				t.Errorf("\n%s\nrunBootstrapActions(...): -want committed, +got committed:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.opened, opened); diff != "" {
+				t.Errorf("\n%s\nrunBootstrapActions(...): -want opened, +got opened:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateRequiredTopics(t *testing.T) {
+	type want struct {
+		topics []string
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason   string
+		required []string
+		current  []string
+		want     want
+	}{
+		"AddsMissingTopics": {
+			reason:   "Missing required topics should be added to the current topics.",
+			required: []string{requiredTopic, "cost-center-platform"},
+			current:  []string{"go"},
+			want: want{
+				topics: []string{"cost-center-platform", "go", requiredTopic},
+			},
+		},
+		"NothingMissing": {
+			reason:   "Topics should not be replaced when no required topic is missing.",
+			required: []string{requiredTopic},
+			current:  []string{requiredTopic, "go"},
+			want:     want{},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockReplaceAllTopics: func(ctx context.Context, owner, repo string, topics []string) ([]string, *github.Response, error) {
+						got = topics
+						return topics, nil, nil
+					},
+				},
+			}
+			err := updateRequiredTopics(context.Background(), repository(), gh, repo, tc.required, tc.current)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nupdateRequiredTopics(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.topics, got); diff != "" {
+				t.Errorf("\n%s\nupdateRequiredTopics(...): -want topics, +got topics:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateCustomProperties(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		properties map[string]string
+		current    []*github.CustomPropertyValue
+		want       []*github.CustomPropertyValue
+	}{
+		"SetsOutdatedValues": {
+			reason:     "Missing and changed values should be set, unmanaged properties left untouched.",
+			properties: map[string]string{"tier": "gold", "team": "platform"},
+			current: []*github.CustomPropertyValue{
+				{PropertyName: "tier", Value: github.String("bronze")},
+				{PropertyName: "compliance", Value: github.String("sox")},
+			},
+			want: []*github.CustomPropertyValue{
+				{PropertyName: "team", Value: github.String("platform")},
+				{PropertyName: "tier", Value: github.String("gold")},
+			},
+		},
+		"UpToDate": {
+			reason:     "Values should not be set when all managed values match.",
+			properties: map[string]string{"tier": "gold"},
+			current: []*github.CustomPropertyValue{
+				{PropertyName: "tier", Value: github.String("gold")},
+				{PropertyName: "team"},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []*github.CustomPropertyValue
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGetAllCustomPropertyValues: func(ctx context.Context, org, repo string) ([]*github.CustomPropertyValue, *github.Response, error) {
+						return tc.current, nil, nil
+					},
+					MockCreateOrUpdateCustomProperties: func(ctx context.Context, org, repo string, values []*github.CustomPropertyValue) (*github.Response, error) {
+						got = values
+						return nil, nil
+					},
+				},
+			}
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.CustomProperties = tc.properties
+			})
+			if err := updateCustomProperties(context.Background(), gh, cr, repo); err != nil {
+				t.Fatalf("\n%s\nupdateCustomProperties(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nupdateCustomProperties(...): -want values, +got values:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateEnvironments(t *testing.T) {
+	custom := &github.BranchPolicy{ProtectedBranches: github.Bool(false), CustomBranchPolicies: github.Bool(true)}
+	releases := v1alpha1.RepositoryEnvironment{
+		Name: "production",
+		DeploymentBranchPolicies: []v1alpha1.DeploymentBranchPolicy{
+			{Name: "release/*"},
+			{Name: "v*", Type: github.String("tag")},
+		},
+	}
+
+	cases := map[string]struct {
+		reason   string
+		env      v1alpha1.RepositoryEnvironment
+		exists   bool
+		policy   *github.BranchPolicy
+		policies []*github.DeploymentBranchPolicy
+		want     []string
+	}{
+		"CreatesEnvironment": {
+			reason: "A missing environment should be created with its deployment branch policies.",
+			env:    releases,
+			want: []string{
+				"update production custom",
+				"create production branch:release/*",
+				"create production tag:v*",
+			},
+		},
+		"ReplacesPolicies": {
+			reason: "Undeclared deployment branch policies should be deleted and missing ones created.",
+			env:    releases,
+			exists: true,
+			policy: custom,
+			policies: []*github.DeploymentBranchPolicy{
+				{ID: github.Int64(1), Name: github.String("main"), Type: github.String("branch")},
+				{ID: github.Int64(2), Name: github.String("release/*"), Type: github.String("branch")},
+			},
+			want: []string{
+				"delete production 1",
+				"create production tag:v*",
+			},
+		},
+		"ProtectedBranches": {
+			reason: "An unrestricted environment should be restricted to protected branches.",
+			env:    v1alpha1.RepositoryEnvironment{Name: "staging", ProtectedBranches: github.Bool(true)},
+			exists: true,
+			want:   []string{"update staging protected"},
+		},
+		"UpToDate": {
+			reason: "An environment with the declared deployment branch policies should not be changed.",
+			env:    releases,
+			exists: true,
+			policy: custom,
+			policies: []*github.DeploymentBranchPolicy{
+				{ID: github.Int64(2), Name: github.String("release/*"), Type: github.String("branch")},
+				{ID: github.Int64(3), Name: github.String("v*"), Type: github.String("tag")},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []string
+			exists, policy := tc.exists, tc.policy
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGetEnvironment: func(ctx context.Context, owner, repo, name string) (*github.Environment, *github.Response, error) {
+						if !exists {
+							return nil, nil, fake.Generate404Response()
+						}
+						return &github.Environment{Name: github.String(name), DeploymentBranchPolicy: policy}, nil, nil
+					},
+					MockUpdateEnvironmentBranchPolicy: func(ctx context.Context, owner, repo, name string, p *github.BranchPolicy) (*github.Environment, *github.Response, error) {
+						mode := "unrestricted"
+						if p.GetProtectedBranches() {
+							mode = "protected"
+						}
+						if p.GetCustomBranchPolicies() {
+							mode = "custom"
+						}
+						got = append(got, fmt.Sprintf("update %s %s", name, mode))
+						exists, policy = true, p
+						return nil, nil, nil
+					},
+					MockListDeploymentBranchPolicies: func(ctx context.Context, owner, repo, environment string, opts *github.ListOptions) ([]*github.DeploymentBranchPolicy, *github.Response, error) {
+						return tc.policies, nil, nil
+					},
+					MockCreateDeploymentBranchPolicy: func(ctx context.Context, owner, repo, environment string, request *github.DeploymentBranchPolicyRequest) (*github.DeploymentBranchPolicy, *github.Response, error) {
+						got = append(got, fmt.Sprintf("create %s %s:%s", environment, request.GetType(), request.GetName()))
+						return nil, nil, nil
+					},
+					MockDeleteDeploymentBranchPolicy: func(ctx context.Context, owner, repo, environment string, branchPolicyID int64) (*github.Response, error) {
+						got = append(got, fmt.Sprintf("delete %s %d", environment, branchPolicyID))
+						return nil, nil
+					},
+				},
+			}
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Environments = []v1alpha1.RepositoryEnvironment{tc.env}
+			})
+			if err := updateEnvironments(context.Background(), gh, cr, repo); err != nil {
+				t.Fatalf("\n%s\nupdateEnvironments(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nupdateEnvironments(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func tokenSecret(version string) test.MockGetFn {
+	return func(_ context.Context, key client.ObjectKey, obj client.Object) error {
+		s := obj.(*corev1.Secret)
+		s.ResourceVersion = version
+		s.Data = map[string][]byte{"token": []byte("s3cr3t")}
+		return nil
+	}
+}
+
+func TestObserveRepoSecrets(t *testing.T) {
+	secrets := []v1alpha1.RepositorySecret{{
+		Name:           "NPM_TOKEN",
+		ValueSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "npm", Namespace: "default"}, Key: "token"},
+	}}
+
+	cases := map[string]struct {
+		reason   string
+		versions map[string]string
+		getErr   error
+		want     bool
+	}{
+		"UpToDate": {
+			reason:   "A secret that was set from the current version of its Kubernetes Secret should be up to date.",
+			versions: map[string]string{"NPM_TOKEN": "2"},
+			want:     true,
+		},
+		"SecretChanged": {
+			reason:   "A secret whose Kubernetes Secret changed since it was set should not be up to date.",
+			versions: map[string]string{"NPM_TOKEN": "1"},
+			want:     false,
+		},
+		"DeletedOnGitHub": {
+			reason:   "A secret that was deleted on GitHub should not be up to date.",
+			versions: map[string]string{"NPM_TOKEN": "2"},
+			getErr:   &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want:     false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			store := dependabotSecretStore{&fake.MockDependabotClient{
+				MockGetRepoSecret: func(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error) {
+					return &github.Secret{Name: name}, nil, tc.getErr
+				},
+			}}
+			kube := &test.MockClient{MockGet: tokenSecret("2")}
+			got, err := observeRepoSecrets(context.Background(), kube, store, "test-org", repo, secrets, tc.versions)
+			if err != nil {
+				t.Fatalf("\n%s\nobserveRepoSecrets(...): unexpected error: %v\n", tc.reason, err)
+			}
+			if got != tc.want {
+				t.Errorf("\n%s\nobserveRepoSecrets(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestCodeownersCondition(t *testing.T) {
+	errBoom := errors.New("boom")
+	unknownOwner := &github.CodeownersError{Line: 3, Kind: "Unknown owner", Path: ".github/CODEOWNERS"}
+
+	cases := map[string]struct {
+		reason string
+		errs   *github.CodeownersErrors
+		getErr error
+		want   xpv1.Condition
+		err    error
+	}{
+		"Valid": {
+			reason: "A CODEOWNERS file without errors should be valid.",
+			errs:   &github.CodeownersErrors{},
+			want:   v1alpha1.CodeownersValid(""),
+		},
+		"NoFile": {
+			reason: "A repository without a CODEOWNERS file should be valid.",
+			getErr: &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}},
+			want:   v1alpha1.CodeownersValid("no CODEOWNERS file"),
+		},
+		"Invalid": {
+			reason: "The errors in a CODEOWNERS file should be listed with their line.",
+			errs:   &github.CodeownersErrors{Errors: []*github.CodeownersError{unknownOwner}},
+			want:   v1alpha1.CodeownersInvalid(".github/CODEOWNERS:3: Unknown owner"),
+		},
+		"GetError": {
+			reason: "Errors getting the CODEOWNERS errors should be returned.",
+			getErr: errBoom,
+			err:    errors.Wrap(errBoom, errGetCodeownersErrors),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+				MockGetCodeownersErrors: func(ctx context.Context, owner, repo string, opts *github.GetCodeownersErrorsOptions) (*github.CodeownersErrors, *github.Response, error) {
+					return tc.errs, nil, tc.getErr
+				},
+			}}
+			got, err := codeownersCondition(context.Background(), gh, "test-org", repo)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ncodeownersCondition(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\ncodeownersCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWebhookEventsCondition(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		allow  *bool
+		events []string
+		want   xpv1.Condition
+	}{
+		"KnownEvents": {
+			reason: "Webhooks that only subscribe to known events should be valid.",
+			events: []string{"push", "pull_request"},
+			want:   v1alpha1.WebhookEventsValid(),
+		},
+		"Typo": {
+			reason: "An unknown event should be reported with the known event it was likely meant to be.",
+			events: []string{"push", "pull-request", "pushes"},
+			want:   v1alpha1.WebhookEventsUnknown("webhooks[" + webhook1url + "]: unknown events pull-request (did you mean pull_request?), pushes"),
+		},
+		"UnknownAllowed": {
+			reason: "Unknown events of a webhook that allows them should not be reported.",
+			allow:  github.Bool(true),
+			events: []string{"new_event"},
+			want:   v1alpha1.WebhookEventsValid(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			webhooks := []v1alpha1.RepositoryWebhook{{Url: webhook1url, Events: tc.events, AllowUnknownEvents: tc.allow}}
+			got := webhookEventsCondition(webhooks)
+			if diff := cmp.Diff(tc.want, got, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nwebhookEventsCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateCodeScanning(t *testing.T) {
+	errBoom := errors.New("boom")
+	configured := &v1alpha1.RepositoryCodeScanning{State: "configured", QuerySuite: github.String("extended"), Languages: []string{"python", "go"}}
+
+	cases := map[string]struct {
+		reason    string
+		cs        *v1alpha1.RepositoryCodeScanning
+		cfg       *github.DefaultSetupConfiguration
+		updateErr error
+		want      *github.UpdateDefaultSetupConfigurationOptions
+		err       error
+	}{
+		"UpToDate": {
+			reason: "A default setup with the languages in another order should be left as it is.",
+			cs:     configured,
+			cfg:    &github.DefaultSetupConfiguration{State: github.String("configured"), QuerySuite: github.String("extended"), Languages: []string{"go", "python"}},
+		},
+		"UnmanagedLanguages": {
+			reason: "The languages of the default setup should be left as they are if the spec doesn't set them.",
+			cs:     &v1alpha1.RepositoryCodeScanning{State: "configured"},
+			cfg:    &github.DefaultSetupConfiguration{State: github.String("configured"), QuerySuite: github.String("default"), Languages: []string{"go"}},
+		},
+		"Configure": {
+			reason:    "A default setup that isn't configured should be configured, and GitHub accepting it should succeed.",
+			cs:        configured,
+			cfg:       &github.DefaultSetupConfiguration{State: github.String("not-configured")},
+			updateErr: &github.AcceptedError{},
+			want:      &github.UpdateDefaultSetupConfigurationOptions{State: "configured", QuerySuite: github.String("extended"), Languages: []string{"python", "go"}},
+		},
+		"NotConfigured": {
+			reason: "Only the state should be sent when the default setup is turned off.",
+			cs:     &v1alpha1.RepositoryCodeScanning{State: "not-configured", QuerySuite: github.String("extended")},
+			cfg:    &github.DefaultSetupConfiguration{State: github.String("configured"), QuerySuite: github.String("default")},
+			want:   &github.UpdateDefaultSetupConfigurationOptions{State: "not-configured"},
+		},
+		"UpdateError": {
+			reason:    "Errors updating the default setup should be returned.",
+			cs:        configured,
+			cfg:       &github.DefaultSetupConfiguration{State: github.String("not-configured")},
+			updateErr: errBoom,
+			want:      &github.UpdateDefaultSetupConfigurationOptions{State: "configured", QuerySuite: github.String("extended"), Languages: []string{"python", "go"}},
+			err:       errors.Wrap(errBoom, errUpdateCodeScanningDefaultSetup),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *github.UpdateDefaultSetupConfigurationOptions
+			gh := &ghclient.Client{CodeScanning: &fake.MockCodeScanningClient{
+				MockGetDefaultSetupConfiguration: func(ctx context.Context, owner, repo string) (*github.DefaultSetupConfiguration, *github.Response, error) {
+					return tc.cfg, nil, nil
+				},
+				MockUpdateDefaultSetupConfiguration: func(ctx context.Context, owner, repo string, options *github.UpdateDefaultSetupConfigurationOptions) (*github.UpdateDefaultSetupConfigurationResponse, *github.Response, error) {
+					got = options
+					return nil, nil, tc.updateErr
+				},
+			}}
+			cr := repository()
+			cr.Spec.ForProvider.CodeScanning = tc.cs
+			err := updateCodeScanning(context.Background(), gh, cr, repo)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nupdateCodeScanning(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nupdateCodeScanning(...): -want options, +got options:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateDelegatedBypass(t *testing.T) {
+	errBoom := errors.New("boom")
+	security := &ghclient.SecretScanningBypassReviewer{ReviewerID: 2, ReviewerType: "TEAM"}
+	platform := &ghclient.SecretScanningBypassReviewer{ReviewerID: 1, ReviewerType: "TEAM"}
+	enabled := &v1alpha1.SecretScanningDelegatedBypass{Enabled: true, ReviewerTeams: []v1alpha1.SecretScanningBypassReviewerTeam{{Team: "Security"}, {Team: "platform"}}}
+
+	cases := map[string]struct {
+		reason   string
+		bypass   *v1alpha1.SecretScanningDelegatedBypass
+		observed *ghclient.SecretScanningDelegatedBypass
+		teamErr  error
+		want     *ghclient.SecretScanningDelegatedBypass
+		err      error
+	}{
+		"UpToDate": {
+			reason:   "A delegated bypass with the reviewers in another order should be left as it is.",
+			bypass:   enabled,
+			observed: &ghclient.SecretScanningDelegatedBypass{Status: "enabled", Reviewers: []*ghclient.SecretScanningBypassReviewer{security, platform}},
+		},
+		"Enable": {
+			reason:   "A disabled delegated bypass should be enabled with the IDs of the reviewer teams.",
+			bypass:   enabled,
+			observed: &ghclient.SecretScanningDelegatedBypass{Status: "disabled"},
+			want:     &ghclient.SecretScanningDelegatedBypass{Status: "enabled", Reviewers: []*ghclient.SecretScanningBypassReviewer{platform, security}},
+		},
+		"ChangeReviewers": {
+			reason:   "Reviewers that aren't listed should be removed.",
+			bypass:   &v1alpha1.SecretScanningDelegatedBypass{Enabled: true, ReviewerTeams: []v1alpha1.SecretScanningBypassReviewerTeam{{Team: "platform"}}},
+			observed: &ghclient.SecretScanningDelegatedBypass{Status: "enabled", Reviewers: []*ghclient.SecretScanningBypassReviewer{security, platform}},
+			want:     &ghclient.SecretScanningDelegatedBypass{Status: "enabled", Reviewers: []*ghclient.SecretScanningBypassReviewer{platform}},
+		},
+		"Disabled": {
+			reason:   "The reviewers of a disabled delegated bypass should be left as they are.",
+			bypass:   &v1alpha1.SecretScanningDelegatedBypass{},
+			observed: &ghclient.SecretScanningDelegatedBypass{Status: "disabled", Reviewers: []*ghclient.SecretScanningBypassReviewer{security}},
+		},
+		"TeamError": {
+			reason:  "Errors getting a reviewer team should be returned.",
+			bypass:  enabled,
+			teamErr: errBoom,
+			err:     errors.Wrapf(errBoom, errGetBypassReviewerTeam, "Security"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *ghclient.SecretScanningDelegatedBypass
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGetSecretScanningDelegatedBypass: func(ctx context.Context, owner, repo string) (*ghclient.SecretScanningDelegatedBypass, *github.Response, error) {
+						return tc.observed, nil, nil
+					},
+					MockUpdateSecretScanningDelegatedBypass: func(ctx context.Context, owner, repo string, bypass *ghclient.SecretScanningDelegatedBypass) (*github.Response, error) {
+						got = bypass
+						return nil, nil
+					},
+				},
+				Teams: &fake.MockTeamsClient{
+					MockGetTeamBySlug: func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+						ids := map[string]int64{"security": 2, "platform": 1}
+						return &github.Team{ID: github.Int64(ids[slug])}, nil, tc.teamErr
+					},
+				},
+			}
+			cr := repository()
+			cr.Spec.ForProvider.SecretScanningDelegatedBypass = tc.bypass
+			err := updateDelegatedBypass(context.Background(), gh, cr, repo)
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nupdateDelegatedBypass(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nupdateDelegatedBypass(...): -want bypass, +got bypass:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestOIDCSubjectClaimUpToDate(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		claim  *v1alpha1.OIDCSubjectClaim
+		tmpl   *github.OIDCSubjectClaimCustomTemplate
+		want   bool
+	}{
+		"UsesDefault": {
+			reason: "The claims of a repository that uses the template of its organization should not be compared.",
+			claim:  &v1alpha1.OIDCSubjectClaim{UseDefault: true},
+			tmpl:   &github.OIDCSubjectClaimCustomTemplate{UseDefault: github.Bool(true), IncludeClaimKeys: []string{"repo"}},
+			want:   true,
+		},
+		"Customized": {
+			reason: "A repository should use its own template if the spec customizes it.",
+			claim:  &v1alpha1.OIDCSubjectClaim{IncludeClaimKeys: []string{"repo", "context"}},
+			tmpl:   &github.OIDCSubjectClaimCustomTemplate{UseDefault: github.Bool(true)},
+			want:   false,
+		},
+		"ClaimOrder": {
+			reason: "The order of the claims should matter, it is the order of the subject claim.",
+			claim:  &v1alpha1.OIDCSubjectClaim{IncludeClaimKeys: []string{"repo", "job_workflow_ref"}},
+			tmpl:   &github.OIDCSubjectClaimCustomTemplate{UseDefault: github.Bool(false), IncludeClaimKeys: []string{"job_workflow_ref", "repo"}},
+			want:   false,
+		},
+		"UpToDate": {
+			reason: "A template with the claims of the spec should be up to date.",
+			claim:  &v1alpha1.OIDCSubjectClaim{IncludeClaimKeys: []string{"repo", "job_workflow_ref"}},
+			tmpl:   &github.OIDCSubjectClaimCustomTemplate{UseDefault: github.Bool(false), IncludeClaimKeys: []string{"repo", "job_workflow_ref"}},
+			want:   true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if got := oidcSubjectClaimUpToDate(tc.claim, tc.tmpl); got != tc.want {
+				t.Errorf("\n%s\noidcSubjectClaimUpToDate(...): want %t, got %t\n", tc.reason, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestUpdateRepoSecrets(t *testing.T) {
+	publicKey, privateKey, err := box.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := &github.PublicKey{KeyID: github.String("key-id"), Key: github.String(base64.StdEncoding.EncodeToString(publicKey[:]))}
+
+	var got *github.DependabotEncryptedSecret
+	store := dependabotSecretStore{&fake.MockDependabotClient{
+		MockGetRepoPublicKey: func(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error) {
+			return key, nil, nil
+		},
+		MockCreateOrUpdateRepoSecret: func(ctx context.Context, owner, repo string, eSecret *github.DependabotEncryptedSecret) (*github.Response, error) {
+			got = eSecret
+			return nil, nil
+		},
+	}}
+	kube := &test.MockClient{MockGet: tokenSecret("2")}
+	secrets := []v1alpha1.RepositorySecret{{
+		Name:           "NPM_TOKEN",
+		ValueSecretRef: xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Name: "npm", Namespace: "default"}, Key: "token"},
+	}}
+
+	versions, err := updateRepoSecrets(context.Background(), kube, store, "test-org", repo, secrets, map[string]string{"REMOVED": "1"})
+	if err != nil {
+		t.Fatalf("updateRepoSecrets(...): unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(map[string]string{"NPM_TOKEN": "2"}, versions); diff != "" {
+		t.Errorf("updateRepoSecrets(...): -want versions, +got versions:\n%s", diff)
+	}
+	if got == nil || got.Name != "NPM_TOKEN" || got.KeyID != "key-id" {
+		t.Fatalf("updateRepoSecrets(...): unexpected secret %+v", got)
+	}
+	encrypted, err := base64.StdEncoding.DecodeString(got.EncryptedValue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	value, ok := box.OpenAnonymous(nil, encrypted, publicKey, privateKey)
+	if !ok || string(value) != "s3cr3t" {
+		t.Errorf("updateRepoSecrets(...): secret value can't be decrypted with the repository key")
+	}
+}
+
+func TestWithoutUnmanagedWebhooks(t *testing.T) {
+	declared := v1alpha1.RepositoryWebhook{Url: webhook1url, ContentType: "json"}
+	argo := v1alpha1.RepositoryWebhook{Url: "https://argocd.example.org/api/webhook", ContentType: "json"}
+	crHooks := map[string]v1alpha1.RepositoryWebhook{webhook1url: declared}
+	ghHooks := map[string]v1alpha1.RepositoryWebhook{webhook1url: declared, argo.Url: argo}
+
+	cases := map[string]struct {
+		reason string
+		policy *string
+		want   map[string]v1alpha1.RepositoryWebhook
+	}{
+		"Full": {
+			reason: "All webhooks should be reconciled by default, so that unlisted ones are deleted.",
+			want:   ghHooks,
+		},
+		"Patch": {
+			reason: "Webhooks that are not listed should be ignored with the Patch policy.",
+			policy: github.String("Patch"),
+			want:   crHooks,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.WebhookManagementPolicy = tc.policy
+			})
+			got := withoutUnmanagedWebhooks(cr, ghHooks, crHooks)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nwithoutUnmanagedWebhooks(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdateRepoWebhooks(t *testing.T) {
+	type want struct {
+		created []string
+		edited  []int64
+		deleted []int64
+		pinged  []int64
+	}
+
+	hook := func(id int64, url string, events ...string) *github.Hook {
+		return &github.Hook{
+			ID: github.Int64(id),
+			Config: &github.HookConfig{
+				URL:         github.String(url),
+				ContentType: &webhook1ContentType,
+				InsecureSSL: &webhook1InsecureSslStr,
+			},
+			Events: events,
+			Active: github.Bool(webhook1active),
+		}
+	}
+
+	cases := map[string]struct {
+		reason string
+		hooks  []*github.Hook
+		cr     *v1alpha1.Repository
+		want   want
+	}{
+		"EventsInOtherOrder": {
+			reason: "A webhook whose events GitHub lists in another order should be left as it is.",
+			hooks:  []*github.Hook{hook(1, webhook1url, webhook1event2, webhook1event1)},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Webhooks[0].Events = []string{webhook1event2, webhook1event1, webhook1event2}
+			}),
+			want: want{},
+		},
+		"URLSpelledDifferently": {
+			reason: "A webhook whose URL GitHub spells with another case of the host or the default port should be edited, not recreated.",
+			hooks:  []*github.Hook{hook(1, "https://Example.org:443/webhook", webhook1event1, webhook1event2)},
+			cr:     repository(),
+			want: want{
+				edited: []int64{1},
+			},
+		},
+		"URLWithTrailingSlash": {
+			reason: "A webhook whose URL only differs by a trailing slash is another endpoint, and should be replaced rather than edited.",
+			hooks:  []*github.Hook{hook(1, webhook1url+"/", webhook1event1, webhook1event2)},
+			cr:     repository(),
+			want: want{
+				created: []string{webhook1url},
+				deleted: []int64{1},
+			},
+		},
+		"URLsDifferingByTrailingSlash": {
+			reason: "Webhooks whose URLs only differ by a trailing slash should each be matched to their own entry of the spec.",
+			hooks: []*github.Hook{
+				hook(1, webhook1url, webhook1event1, webhook1event2),
+				hook(2, webhook1url+"/", webhook1event1),
+			},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Webhooks = append(r.Spec.ForProvider.Webhooks, v1alpha1.RepositoryWebhook{
+					Url:         webhook1url + "/",
+					ContentType: webhook1ContentType,
+					Events:      []string{webhook1event1},
+				})
+			}),
+			want: want{},
+		},
+		"ReorderedWebhooks": {
+			reason: "Reordering the webhooks of the spec should change none of them.",
+			hooks: []*github.Hook{
+				hook(1, webhook1url, webhook1event1, webhook1event2),
+				hook(2, "https://other.example.org/webhook", webhook1event1),
+			},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Webhooks = append([]v1alpha1.RepositoryWebhook{{
+					Url:         "https://other.example.org/webhook",
+					ContentType: webhook1ContentType,
+					Events:      []string{webhook1event1},
+				}}, r.Spec.ForProvider.Webhooks...)
+			}),
+			want: want{},
+		},
+		"ChangedURL": {
+			reason: "A webhook whose URL changed should be replaced by one with the new URL.",
+			hooks:  []*github.Hook{hook(1, "https://old.example.org/webhook", webhook1event1, webhook1event2)},
+			cr:     repository(),
+			want: want{
+				created: []string{webhook1url},
+				deleted: []int64{1},
+			},
+		},
+		"PingsCreatedWebhook": {
+			reason: "A webhook that is pinged on creation should be pinged once it is created.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Webhooks[0].PingOnCreate = github.Bool(true)
+			}),
+			want: want{
+				created: []string{webhook1url},
+				pinged:  []int64{3},
+			},
+		},
+		"InactiveWebhookNotPinged": {
+			reason: "An inactive webhook should not be pinged on creation.",
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Webhooks[0].PingOnCreate = github.Bool(true)
+				r.Spec.ForProvider.Webhooks[0].Active = github.Bool(false)
+			}),
+			want: want{
+				created: []string{webhook1url},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			gh := &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+				MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+					return tc.hooks, fake.GenerateEmptyResponse(), nil
+				},
+				MockCreateHook: func(ctx context.Context, owner, repo string, hook *github.Hook) (*github.Hook, *github.Response, error) {
+					got.created = append(got.created, hook.Config.GetURL())
+					return &github.Hook{ID: github.Int64(3)}, fake.GenerateEmptyResponse(), nil
+				},
+				MockPingHook: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+					got.pinged = append(got.pinged, id)
+					return fake.GenerateEmptyResponse(), nil
+				},
+				MockEditHook: func(ctx context.Context, owner, repo string, id int64, hook *github.Hook) (*github.Hook, *github.Response, error) {
+					got.edited = append(got.edited, id)
+					return hook, fake.GenerateEmptyResponse(), nil
+				},
+				MockDeleteHook: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+					got.deleted = append(got.deleted, id)
+					return fake.GenerateEmptyResponse(), nil
+				},
+			}}
+
+			if err := updateRepoWebhooks(context.Background(), tc.cr, gh, repo); err != nil {
+				t.Errorf("\n%s\nupdateRepoWebhooks(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nupdateRepoWebhooks(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveWebhookDeliveries(t *testing.T) {
+	delivered := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	deliveredAt := metav1.NewTime(delivered)
+
+	hooks := []*github.Hook{
+		{ID: github.Int64(1), Config: &github.HookConfig{URL: github.String(webhook1url)}},
+		{ID: github.Int64(2), Config: &github.HookConfig{URL: github.String("https://unmanaged.example.org/webhook")}},
+	}
+
+	cases := map[string]struct {
+		reason     string
+		deliveries []*github.HookDelivery
+		want       []v1alpha1.RepositoryWebhookObservation
+	}{
+		"LastDelivery": {
+			reason: "The last delivery of a declared webhook should be observed.",
+			deliveries: []*github.HookDelivery{
+				{StatusCode: github.Int(502), Status: github.String("Bad Gateway"), DeliveredAt: &github.Timestamp{Time: delivered}},
+			},
+			want: []v1alpha1.RepositoryWebhookObservation{
+				{URL: webhook1url, ID: 1, LastDeliveryStatusCode: github.Int(502), LastDeliveryStatus: "Bad Gateway", LastDeliveryTime: &deliveredAt},
+			},
+		},
+		"NeverDelivered": {
+			reason: "A declared webhook that was never delivered should be observed without a delivery.",
+			want: []v1alpha1.RepositoryWebhookObservation{
+				{URL: webhook1url, ID: 1},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+				MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+					if id != 1 {
+						t.Errorf("\n%s\nListHookDeliveries(...): want webhook 1, got %d\n", tc.reason, id)
+					}
+					return tc.deliveries, fake.GenerateEmptyResponse(), nil
+				},
+			}}
+
+			got, err := observeWebhookDeliveries(context.Background(), gh, org, repo, hooks, repository().Spec.ForProvider.Webhooks)
+			if err != nil {
+				t.Fatalf("observeWebhookDeliveries(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nobserveWebhookDeliveries(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUndeclaredRules(t *testing.T) {
+	crRules := map[string]string{"main": "declared"}
+	ghRules := map[string]string{"main": "declared", "release/*": "manual"}
+
+	type want struct {
+		rules     map[string]string
+		condition xpv1.Condition
+		report    bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		policy *string
+		want   want
+	}{
+		"Full": {
+			reason: "All rules should be reconciled by default, so that undeclared ones are deleted.",
+			want: want{
+				rules: ghRules,
+			},
+		},
+		"Patch": {
+			reason: "Undeclared rules should be ignored with the Patch policy.",
+			policy: github.String("Patch"),
+			want: want{
+				rules: crRules,
+			},
+		},
+		"Report": {
+			reason: "Undeclared rules should be ignored and reported with the Report policy.",
+			policy: github.String("Report"),
+			want: want{
+				rules:     crRules,
+				condition: v1alpha1.RulesUndeclared("not declared in the spec: branch protection rule release/*"),
+				report:    true,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			undeclared := &undeclaredRules{}
+			got, keys := withoutUndeclared(tc.policy, ghRules, crRules)
+			undeclared.Add(tc.policy, "branch protection rule", keys)
+			if diff := cmp.Diff(tc.want.rules, got); diff != "" {
+				t.Errorf("\n%s\nwithoutUndeclared(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			condition, report := undeclared.Condition()
+			if diff := cmp.Diff(tc.want.report, report); diff != "" {
+				t.Errorf("\n%s\nCondition(...): -want report, +got report:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.condition, condition, test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGroupSync(t *testing.T) {
+	errBoom := errors.New("boom")
+	errOther := errors.New("other")
+
+	type want struct {
+		condition xpv1.Condition
+		err       error
+	}
+
+	cases := map[string]struct {
+		reason  string
+		results []subResourceResult
+		want    want
+	}{
+		"Synced": {
+			reason:  "A group whose sub-resources were all synced should be reported as synced.",
+			results: []subResourceResult{{"collaborators", nil}, {"teams", nil}},
+			want: want{
+				condition: v1alpha1.SubResourcesSynced(v1alpha1.TypePermissionsSynced),
+			},
+		},
+		"Skipped": {
+			reason:  "A group with sub-resources skipped for missing permissions should be reported as skipped without failing the Update.",
+			results: []subResourceResult{{"collaborators", nil}, {"teams", errForbidden}},
+			want: want{
+				condition: v1alpha1.SubResourcesSkipped(v1alpha1.TypePermissionsSynced, errForbidden.Error()),
+			},
+		},
+		"Failed": {
+			reason:  "A group with a sub-resource that failed should report the failure and keep its error for the Update.",
+			results: []subResourceResult{{"collaborators", errForbidden}, {"teams", errBoom}},
+			want: want{
+				condition: v1alpha1.SubResourcesSyncFailed(v1alpha1.TypePermissionsSynced, errBoom.Error()),
+				err:       errBoom,
+			},
+		},
+		"FirstError": {
+			reason:  "The first error of the sub-resources of a group should be kept.",
+			results: []subResourceResult{{"collaborators", errBoom}, {"teams", errOther}},
+			want: want{
+				condition: v1alpha1.SubResourcesSyncFailed(v1alpha1.TypePermissionsSynced, errBoom.Error()),
+				err:       errBoom,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Repository{}
+			groups := &groupSync{cr: cr, skipped: &permissions.Skipped{}}
+			groups.record(v1alpha1.TypePermissionsSynced, tc.results...)
+			if diff := cmp.Diff(tc.want.condition, cr.GetCondition(v1alpha1.TypePermissionsSynced), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nrecord(...): -want condition, +got condition:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.err, groups.err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nrecord(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetObservedCondition(t *testing.T) {
+	synced := v1alpha1.SubResourcesSynced(v1alpha1.TypeWebhooksSynced)
+	failed := v1alpha1.SubResourcesSyncFailed(v1alpha1.TypeWebhooksSynced, "boom")
+
+	cases := map[string]struct {
+		reason   string
+		previous xpv1.Condition
+		upToDate bool
+		err      error
+		want     xpv1.Condition
+	}{
+		"UpToDate": {
+			reason:   "A group that matches its spec should be reported as synced, e.g. once it was fixed on GitHub.",
+			previous: failed,
+			upToDate: true,
+			want:     synced,
+		},
+		"Differs": {
+			reason:   "A group that differs from its spec should keep its condition until the Update syncs it.",
+			previous: failed,
+			want:     failed,
+		},
+		"Skipped": {
+			reason:   "A group that couldn't be observed for missing permissions should be reported as skipped.",
+			previous: synced,
+			err:      errForbidden,
+			want:     v1alpha1.SubResourcesSkipped(v1alpha1.TypeWebhooksSynced, errForbidden.Error()),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := &v1alpha1.Repository{}
+			cr.SetConditions(tc.previous)
+			setObservedCondition(cr, v1alpha1.TypeWebhooksSynced, tc.upToDate, tc.err)
+			if diff := cmp.Diff(tc.want, cr.GetCondition(v1alpha1.TypeWebhooksSynced), test.EquateConditions()); diff != "" {
+				t.Errorf("\n%s\nsetObservedCondition(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNewDriftReport(t *testing.T) {
+	many := make([]string, maxDriftChanges+1)
+	for i := range many {
+		many[i] = fmt.Sprintf("environments[env-%d]", i)
+	}
+
+	cases := map[string]struct {
+		reason  string
+		differs []string
+		values  driftValues
+		want    *v1alpha1.DriftReport
+	}{
+		"Settings": {
+			reason:  "A differing setting should be replaced with its desired value.",
+			differs: []string{"description"},
+			values:  driftValues{"description": {desired: "new", observed: "old"}},
+			want: &v1alpha1.DriftReport{Changes: []v1alpha1.DriftChange{
+				{Op: "replace", Path: "/description", Value: `"new"`, Observed: `"old"`},
+			}},
+		},
+		"Entries": {
+			reason:  "Entries missing on GitHub should be added, and undeclared ones removed, by the JSON pointer of their key.",
+			differs: []string{"permissions.users[octocat]", "webhooks[https://example.org/hook]"},
+			values: driftValues{
+				"permissions.users[octocat]":         {desired: "push"},
+				"webhooks[https://example.org/hook]": {observed: "x"},
+			},
+			want: &v1alpha1.DriftReport{Changes: []v1alpha1.DriftChange{
+				{Op: "add", Path: "/permissions/users/octocat", Value: `"push"`},
+				{Op: "remove", Path: "/webhooks/https:~1~1example.org~1hook", Observed: `"x"`},
+			}},
+		},
+		"WithoutValues": {
+			reason:  "Fields whose values aren't compared as a whole should be replaced without values.",
+			differs: []string{"repositoryRules"},
+			values:  driftValues{},
+			want: &v1alpha1.DriftReport{Changes: []v1alpha1.DriftChange{
+				{Op: "replace", Path: "/repositoryRules"},
+			}},
+		},
+		"Capped": {
+			reason:  "The report should list at most maxDriftChanges changes, and values cut to maxDriftValueLength.",
+			differs: many,
+			values:  driftValues{many[0]: {desired: strings.Repeat("a", 2*maxDriftValueLength), observed: "b"}},
+			want: func() *v1alpha1.DriftReport {
+				r := &v1alpha1.DriftReport{Truncated: true}
+				for i, key := range many[:maxDriftChanges] {
+					r.Changes = append(r.Changes, v1alpha1.DriftChange{Op: "replace", Path: driftPath(key)})
+					if i == 0 {
+						r.Changes[0].Value = `"` + strings.Repeat("a", maxDriftValueLength-1)
+						r.Changes[0].Observed = `"b"`
+					}
+				}
+				return r
+			}(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := newDriftReport(tc.differs, tc.values)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nnewDriftReport(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRepositoryName(t *testing.T) {
+	type want struct {
+		name string
+		org  string
+		err  error
+	}
+
+	cases := map[string]struct {
+		reason       string
+		externalName string
+		org          string
+		want         want
+	}{
+		"BareName": {
+			reason:       "A bare external name should be the name of the repository in its org.",
+			externalName: repo,
+			org:          org,
+			want: want{
+				name: repo,
+				org:  org,
+			},
+		},
+		"OwnerInitializesOrg": {
+			reason:       "The owner of the external name should initialize an unset org.",
+			externalName: org + "/" + repo,
+			want: want{
+				name: repo,
+				org:  org,
+			},
+		},
+		"OwnerMatchesOrg": {
+			reason:       "The owner of the external name may repeat the org.",
+			externalName: org + "/" + repo,
+			org:          org,
+			want: want{
+				name: repo,
+				org:  org,
+			},
+		},
+		"OwnerMismatch": {
+			reason:       "An owner that differs from the org should be an error.",
+			externalName: "other-org/" + repo,
+			org:          org,
+			want: want{
+				org: org,
+				err: errors.Errorf(errFmtOwnerMismatch, "other-org", org),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Org = tc.org
+				meta.SetExternalName(r, tc.externalName)
+			})
+			got, err := repositoryName(cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nrepositoryName(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.name, got); diff != "" {
+				t.Errorf("\n%s\nrepositoryName(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.org, cr.Spec.ForProvider.Org); diff != "" {
+				t.Errorf("\n%s\nrepositoryName(...): -want org, +got org:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestLateInitialize(t *testing.T) {
+	ghRepo := &github.Repository{
+		Description: github.String("observed"),
+		Private:     github.Bool(false),
+		IsTemplate:  github.Bool(true),
+		Archived:    github.Bool(false),
+		HasWiki:     github.Bool(false),
+	}
+
+	cases := map[string]struct {
+		reason string
+		params v1alpha1.RepositoryParameters
+		want   v1alpha1.RepositoryParameters
+		li     bool
+	}{
+		"Unset": {
+			reason: "Omitted parameters other than archived should be initialized from the repository.",
+			want: v1alpha1.RepositoryParameters{
+				Description: "observed",
+				Private:     github.Bool(false),
+				IsTemplate:  github.Bool(true),
+				HasWiki:     github.Bool(false),
+			},
+			li: true,
+		},
+		"Set": {
+			reason: "Parameters that are set should not be overwritten.",
+			params: v1alpha1.RepositoryParameters{
+				Description: "desired",
+				Private:     github.Bool(true),
+				IsTemplate:  github.Bool(false),
+				Archived:    github.Bool(true),
+				HasWiki:     github.Bool(true),
+			},
+			want: v1alpha1.RepositoryParameters{
+				Description: "desired",
+				Private:     github.Bool(true),
+				IsTemplate:  github.Bool(false),
+				Archived:    github.Bool(true),
+				HasWiki:     github.Bool(true),
+			},
+			li: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			li := lateInitialize(&tc.params, ghRepo)
+			if li != tc.li {
+				t.Errorf("\n%s\nlateInitialize(...): want %t, got %t\n", tc.reason, tc.li, li)
+			}
+			if diff := cmp.Diff(tc.want, tc.params); diff != "" {
+				t.Errorf("\n%s\nlateInitialize(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestSetObservation(t *testing.T) {
+	created := time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC)
+	pushed := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	ghRepo := &github.Repository{
+		ID:            github.Int64(42),
+		NodeID:        github.String("R_kgDOAbCdEf"),
+		FullName:      github.String("test-org/test-repo"),
+		HTMLURL:       github.String("https://github.com/test-org/test-repo"),
+		DefaultBranch: github.String("main"),
+		Visibility:    github.String("internal"),
+		Archived:      github.Bool(false),
+		CreatedAt:     &github.Timestamp{Time: created},
+		PushedAt:      &github.Timestamp{Time: pushed},
+	}
+	createdAt := metav1.NewTime(created)
+	pushedAt := metav1.NewTime(pushed)
+	want := v1alpha1.RepositoryObservation{
+		CompletedBootstrapActions: []string{"files"},
+		ID:                        github.Int64(42),
+		NodeID:                    "R_kgDOAbCdEf",
+		FullName:                  "test-org/test-repo",
+		HTMLURL:                   "https://github.com/test-org/test-repo",
+		DefaultBranch:             "main",
+		Visibility:                "internal",
+		Archived:                  github.Bool(false),
+		CreatedAt:                 &createdAt,
+		PushedAt:                  &pushedAt,
+	}
+
+	cr := repository(func(r *v1alpha1.Repository) {
+		r.Status.AtProvider.CompletedBootstrapActions = []string{"files"}
+	})
+	setObservation(cr, ghRepo)
+	if diff := cmp.Diff(want, cr.Status.AtProvider); diff != "" {
+		t.Errorf("setObservation(...): -want, +got:\n%s", diff)
+	}
+}
+
+func TestConnectionDetails(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		repo   *github.Repository
+		want   managed.ConnectionDetails
+	}{
+		"AllDetails": {
+			reason: "The URLs and node ID of a repository should be published.",
+			repo: &github.Repository{
+				CloneURL: github.String("https://github.com/test-org/test-repo.git"),
+				SSHURL:   github.String("git@github.com:test-org/test-repo.git"),
+				HTMLURL:  github.String("https://github.com/test-org/test-repo"),
+				NodeID:   github.String("R_kgDOAbCdEf"),
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailCloneURL: []byte("https://github.com/test-org/test-repo.git"),
+				ConnectionDetailSSHURL:   []byte("git@github.com:test-org/test-repo.git"),
+				ConnectionDetailHTMLURL:  []byte("https://github.com/test-org/test-repo"),
+				ConnectionDetailNodeID:   []byte("R_kgDOAbCdEf"),
+			},
+		},
+		"MissingDetails": {
+			reason: "Details GitHub didn't return should not be published.",
+			repo: &github.Repository{
+				NodeID: github.String("R_kgDOAbCdEf"),
+			},
+			want: managed.ConnectionDetails{
+				ConnectionDetailNodeID: []byte("R_kgDOAbCdEf"),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, connectionDetails(tc.repo)); diff != "" {
+				t.Errorf("\n%s\nconnectionDetails(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+type recordedEvents []event.Event
+
+func (r *recordedEvents) Event(_ runtime.Object, e event.Event) {
+	*r = append(*r, e)
+}
+
+func (r *recordedEvents) WithAnnotations(_ ...string) event.Recorder {
+	return r
+}
+
+func TestUpdateRepoUsers(t *testing.T) {
+	type want struct {
+		removed []string
+		events  int
+		err     error
+	}
+
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+
+	bot := "deploy-app[bot]"
+
+	cases := map[string]struct {
+		reason    string
+		expiresAt *metav1.Time
+		policy    *string
+		bot       bool
+		want      want
+	}{
+		"AccessExpired": {
+			reason:    "A collaborator whose access expired should be removed and the removal recorded.",
+			expiresAt: &past,
+			want: want{
+				removed: []string{user1},
+				events:  1,
+			},
+		},
+		"AccessNotExpired": {
+			reason:    "A collaborator whose access has not expired yet should be kept.",
+			expiresAt: &future,
+			want:      want{},
+		},
+		"UnmanagedRemoved": {
+			reason: "A collaborator that is not declared should be removed with the Full policy.",
+			bot:    true,
+			want: want{
+				removed: []string{bot},
+			},
+		},
+		"UnmanagedKeptWithPatch": {
+			reason: "A collaborator that is not declared should be kept with the Patch policy.",
+			policy: github.String("Patch"),
+			bot:    true,
+			want:   want{},
+		},
+		"AccessExpiredWithPatch": {
+			reason:    "A declared collaborator whose access expired should be removed with the Patch policy.",
+			expiresAt: &past,
+			policy:    github.String("Patch"),
+			bot:       true,
+			want: want{
+				removed: []string{user1},
+				events:  1,
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var removed []string
+			rec := &recordedEvents{}
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+						collaborators := githubCollaborators()
+						if tc.bot {
+							collaborators = append(collaborators, &github.User{Login: &bot, Permissions: map[string]bool{"push": true}})
+						}
+						return collaborators, fake.GenerateEmptyResponse(), nil
+					},
+					MockRemoveCollaborator: func(ctx context.Context, owner, repo, user string) (*github.Response, error) {
+						removed = append(removed, user)
+						return nil, nil
+					},
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						return nil, nil, nil
+					},
+				},
+			}
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Permissions.Users = r.Spec.ForProvider.Permissions.Users[:1]
+				r.Spec.ForProvider.Permissions.Users[0].ExpiresAt = tc.expiresAt
+				r.Spec.ForProvider.PermissionManagementPolicy = tc.policy
+			})
+			err := updateRepoUsers(context.Background(), cr, gh, rec, repo)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nupdateRepoUsers(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.removed, removed); diff != "" {
+				t.Errorf("\n%s\nupdateRepoUsers(...): -want removed, +got removed:\n%s\n", tc.reason, diff)
+			}
+			if len(*rec) != tc.want.events {
+				t.Errorf("\n%s\nupdateRepoUsers(...): want %d events, got %d\n", tc.reason, tc.want.events, len(*rec))
+			}
+		})
+	}
+}
+
+func TestUpdateBranchPatternRules(t *testing.T) {
+	type want struct {
+		created []string
+		updated []string
+		deleted []string
+	}
+
+	cases := map[string]struct {
+		reason  string
+		ghRules *fake.MockBranchProtectionRulesClient
+		cr      *v1alpha1.Repository
+		want    want
+	}{
+		"CreatesMissingRule": {
+			reason:  "A rule for a branch pattern missing on GitHub should be created in the repository.",
+			ghRules: noBranchPatternRules(),
+			cr:      repository(withBranchPattern()),
+			want: want{
+				created: []string{bpr2pattern},
+			},
+		},
+		"UpdatesChangedRule": {
+			reason:  "A rule for a branch pattern that differs on GitHub should be updated by its ID.",
+			ghRules: githubBranchPatternRules(),
+			cr: repository(withBranchPattern(), func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.BranchProtectionRules[1].EnforceAdmins = false
+			}),
+			want: want{
+				updated: []string{bpr2ruleID},
+			},
+		},
+		"ReusesReplacedRule": {
+			reason:  "A rule for a branch pattern that replaces another should update the replaced rule in place rather than delete it.",
+			ghRules: githubBranchPatternRules(),
+			cr: repository(withBranchPattern(), func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.BranchProtectionRules[1].Branch = "hotfix/*"
+			}),
+			want: want{
+				updated: []string{bpr2ruleID},
+			},
+		},
+		"DeletesUnmanagedRule": {
+			reason:  "A rule for a branch pattern that is not declared should be deleted.",
+			ghRules: githubBranchPatternRules(),
+			cr:      repository(),
+			want: want{
+				deleted: []string{bpr2ruleID},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got want
+			tc.ghRules.MockCreateBranchProtectionRule = func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+				if input.RepositoryID != bpr2repositoryID {
+					t.Errorf("\n%s\nCreateBranchProtectionRule(...): want repository %q, got %q\n", tc.reason, bpr2repositoryID, input.RepositoryID)
+				}
+				got.created = append(got.created, input.Pattern)
+				return nil
+			}
+			tc.ghRules.MockUpdateBranchProtectionRule = func(ctx context.Context, input *ghclient.BranchProtectionRuleInput) error {
+				got.updated = append(got.updated, input.BranchProtectionRuleID)
+				return nil
+			}
+			tc.ghRules.MockDeleteBranchProtectionRule = func(ctx context.Context, id string) error {
+				got.deleted = append(got.deleted, id)
+				return nil
+			}
+			gh := &ghclient.Client{BranchProtectionRules: tc.ghRules}
+
+			ghRules, err := getBranchPatternRules(context.Background(), gh, org, repo)
+			if err != nil {
+				t.Fatalf("getBranchPatternRules(...): %v", err)
+			}
+			_, crRules := splitBranchPatterns(getBPRMapFromCr(tc.cr.Spec.ForProvider.BranchProtectionRules))
+			if err := updateBranchPatternRules(context.Background(), gh, ghRules, crRules); err != nil {
+				t.Errorf("\n%s\nupdateBranchPatternRules(...): %v\n", tc.reason, err)
+			}
+			if diff := cmp.Diff(tc.want, got, cmp.AllowUnexported(want{})); diff != "" {
+				t.Errorf("\n%s\nupdateBranchPatternRules(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRenamedRulesets(t *testing.T) {
+	type want struct {
+		renamed  map[string]int64
+		toDelete []string
+	}
+
+	ruleset := func(name string, id int64) *github.Ruleset {
+		return &github.Ruleset{ID: github.Int64(id), Name: name}
+	}
+	rulesets := func(names ...string) map[string]v1alpha1.Ruleset {
+		res := make(map[string]v1alpha1.Ruleset, len(names))
+		for _, name := range names {
+			res[name] = v1alpha1.Ruleset{Name: name}
+		}
+		return res
+	}
+
+	cases := map[string]struct {
+		reason   string
+		ids      map[string]int64
+		rulesets []*github.Ruleset
+		managed  []string
+		declared []string
+		toDelete []string
+		toAdd    []string
+		want     want
+	}{
+		"RenamedInSpec": {
+			reason:   "A tracked ruleset that is no longer declared should be renamed to the ruleset to add.",
+			ids:      map[string]int64{"old": 1},
+			rulesets: []*github.Ruleset{ruleset("old", 1)},
+			managed:  []string{"old"},
+			declared: []string{"new"},
+			toDelete: []string{"old"},
+			toAdd:    []string{"new"},
+			want: want{
+				renamed:  map[string]int64{"new": 1},
+				toDelete: []string{},
+			},
+		},
+		"RenamedOnGitHub": {
+			reason:   "A ruleset renamed on GitHub should be renamed back by its tracked ID.",
+			ids:      map[string]int64{"main": 1, "old": 2},
+			rulesets: []*github.Ruleset{ruleset("old", 2), ruleset("renamed", 1)},
+			managed:  []string{"old", "renamed"},
+			declared: []string{"main"},
+			toDelete: []string{"old", "renamed"},
+			toAdd:    []string{"main"},
+			want: want{
+				renamed:  map[string]int64{"main": 1},
+				toDelete: []string{"old"},
+			},
+		},
+		"Untracked": {
+			reason:   "A ruleset that is not tracked should not be renamed.",
+			rulesets: []*github.Ruleset{ruleset("old", 1)},
+			managed:  []string{"old"},
+			declared: []string{"new"},
+			toDelete: []string{"old"},
+			toAdd:    []string{"new"},
+			want: want{
+				renamed:  map[string]int64{},
+				toDelete: []string{"old"},
+			},
+		},
+		"StillDeclared": {
+			reason:   "A tracked ruleset that is still declared should not be renamed.",
+			ids:      map[string]int64{"old": 1},
+			rulesets: []*github.Ruleset{ruleset("old", 1)},
+			managed:  []string{"old"},
+			declared: []string{"old", "new"},
+			toAdd:    []string{"new"},
+			want: want{
+				renamed:  map[string]int64{},
+				toDelete: []string{},
+			},
+		},
+		"Unmanaged": {
+			reason:   "A tracked ruleset that is no longer managed, e.g. one of a RepositoryRuleset, should not be renamed.",
+			ids:      map[string]int64{"old": 1},
+			rulesets: []*github.Ruleset{ruleset("old", 1)},
+			declared: []string{"new"},
+			toAdd:    []string{"new"},
+			want: want{
+				renamed:  map[string]int64{},
+				toDelete: []string{},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			toDelete := rulesets(tc.toDelete...)
+			got := renamedRulesets(tc.ids, tc.rulesets, rulesets(tc.managed...), rulesets(tc.declared...), toDelete, rulesets(tc.toAdd...))
+			if diff := cmp.Diff(tc.want.renamed, got); diff != "" {
+				t.Errorf("\n%s\nrenamedRulesets(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(rulesets(tc.want.toDelete...), toDelete); diff != "" {
+				t.Errorf("\n%s\nrenamedRulesets(...): -want toDelete, +got toDelete:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGetPendingBranches(t *testing.T) {
+	type want struct {
+		pending []string
+		err     error
+	}
+
+	cases := map[string]struct {
+		reason    string
+		ghRules   map[string]v1alpha1.RepositoryBranchProtectionRule
+		getBranch func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error)
+		want      want
+	}{
+		"ProtectedBranch": {
+			reason:  "A branch that is already protected should not be pending.",
+			ghRules: getBPRMapFromCr(repository().Spec.ForProvider.BranchProtectionRules),
+			want:    want{},
+		},
+		"UnprotectedBranch": {
+			reason: "A branch that exists but isn't protected yet should not be pending.",
+			getBranch: func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+				return &github.Branch{Name: &branch}, nil, nil
+			},
+			want: want{},
+		},
+		"MissingBranch": {
+			reason: "A branch that doesn't exist yet should be pending.",
+			getBranch: func(ctx context.Context, owner, repo, branch string, maxRedirects int) (*github.Branch, *github.Response, error) {
+				return nil, nil, fake.Generate404Response()
+			},
+			want: want{
+				pending: []string{bpr1branch},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{MockGetBranch: tc.getBranch},
+			}
+			crRules := getBPRMapFromCr(repository().Spec.ForProvider.BranchProtectionRules)
+			got, err := getPendingBranches(context.Background(), gh, org, repo, crRules, tc.ghRules)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ngetPendingBranches(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.pending, got); diff != "" {
+				t.Errorf("\n%s\ngetPendingBranches(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestExportParameters(t *testing.T) {
+	ghRepo := githubRepository()
+	ghRepo.HasWiki = github.Bool(false)
+	ghRepo.AllowAutoMerge = github.Bool(true)
+	ghRepo.Topics = []string{"platform"}
+
+	gh := &ghclient.Client{
+		BranchProtectionRules: githubBranchPatternRules(),
+		Repositories: &fake.MockRepositoriesClient{
+			MockGet: func(ctx context.Context, owner, repo string) (*github.Repository, *github.Response, error) {
+				return ghRepo, nil, nil
+			},
+			MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+				return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+				return githubTeams(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+				return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+				return nil, fake.GenerateEmptyResponse(), nil
+			},
+			MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+				return githubBranches(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+				return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+				return githubRuleset(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+				return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+			},
+		},
+	}
+
+	params, unmodeled, err := ExportParameters(context.Background(), gh, org, ghRepo)
+	if err != nil {
+		t.Fatalf("ExportParameters(...): %v", err)
+	}
+	if diff := cmp.Diff([]string{"allowAutoMerge", "topics"}, unmodeled); diff != "" {
+		t.Errorf("ExportParameters(...): -want unmodeled settings, +got unmodeled settings:\n%s\n", diff)
+	}
+
+	// The exported parameters have to reproduce the settings they were exported from.
+	cr := &v1alpha1.Repository{}
+	cr.Spec.ForProvider = *params
+	meta.SetExternalName(cr, repo)
+	e := external{github: gh, kube: &test.MockClient{MockList: test.NewMockListFn(nil)}, recorder: event.NewNopRecorder()}
+	got, err := e.Observe(context.Background(), cr)
+	if err != nil {
+		t.Fatalf("e.Observe(...): %v", err)
+	}
+	if !got.ResourceUpToDate {
+		t.Errorf("e.Observe(...): exported parameters are not up to date")
+	}
+}
+
+func TestDiscoverRepositories(t *testing.T) {
+	ghRepo := githubRepository()
+	ghRepo.Name = github.String("Legacy_Repo")
+	gh := &ghclient.Client{
+		BranchProtectionRules: githubBranchPatternRules(),
+		Repositories: &fake.MockRepositoriesClient{
+			MockListByOrg: func(ctx context.Context, org string, opts *github.RepositoryListByOrgOptions) ([]*github.Repository, *github.Response, error) {
+				return []*github.Repository{ghRepo}, fake.GenerateEmptyResponse(), nil
+			},
+			MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+				return githubCollaborators(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListTeams: func(ctx context.Context, owner string, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+				return githubTeams(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListHooks: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Hook, *github.Response, error) {
+				return githubWebhooks(), fake.GenerateEmptyResponse(), nil
+			},
+			MockListHookDeliveries: func(ctx context.Context, owner, repo string, id int64, opts *github.ListCursorOptions) ([]*github.HookDelivery, *github.Response, error) {
+				return nil, fake.GenerateEmptyResponse(), nil
+			},
+			MockListBranches: func(ctx context.Context, owner, repo string, opts *github.BranchListOptions) ([]*github.Branch, *github.Response, error) {
+				return githubBranches(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetBranchProtection: func(ctx context.Context, owner, repo, branch string) (*github.Protection, *github.Response, error) {
+				return githubProtectedBranch(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+				return githubRuleset(), fake.GenerateEmptyResponse(), nil
+			},
+			MockGetRuleset: func(ctx context.Context, owner, repo string, rulesetID int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+				return githubRuleset()[0], fake.GenerateEmptyResponse(), nil
+			},
+		},
+	}
+
+	got, err := DiscoverRepositories(context.Background(), gh, org)
+	if err != nil {
+		t.Fatalf("DiscoverRepositories(...): %v", err)
+	}
+	if len(got) != 1 {
+		t.Fatalf("DiscoverRepositories(...): want 1 repository, got %d", len(got))
+	}
+	cr := got[0].Repository
+	if diff := cmp.Diff("legacy-repo", cr.GetName()); diff != "" {
+		t.Errorf("DiscoverRepositories(...): -want name, +got name:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(org+"/Legacy_Repo", meta.GetExternalName(&cr)); diff != "" {
+		t.Errorf("DiscoverRepositories(...): -want external name, +got external name:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(v1alpha1.RepositoryGroupVersionKind, cr.GroupVersionKind()); diff != "" {
+		t.Errorf("DiscoverRepositories(...): -want kind, +got kind:\n%s\n", diff)
+	}
+	if diff := cmp.Diff(org, cr.Spec.ForProvider.Org); diff != "" {
+		t.Errorf("DiscoverRepositories(...): -want org, +got org:\n%s\n", diff)
+	}
+}
+
+func TestGetRepoUsersWithPermissions(t *testing.T) {
+	gh := &ghclient.Client{
+		Repositories: &fake.MockRepositoriesClient{
+			MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+				if opts.Affiliation != affiliationDirect {
+					return nil, nil, errors.Errorf("collaborators should be listed with affiliation %q, not %q", affiliationDirect, opts.Affiliation)
+				}
+				users := githubCollaborators()
+				if opts.Page == 0 {
+					return users[:1], &github.Response{NextPage: 2}, nil
+				}
+				return users[1:], fake.GenerateEmptyResponse(), nil
+			},
+		},
+	}
+
+	got, err := getRepoUsersWithPermissions(context.Background(), gh, org, repo)
+	if err != nil {
+		t.Fatalf("getRepoUsersWithPermissions(...): %v", err)
+	}
+	want := map[string]string{user1: user1Role, user2: user2Role}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getRepoUsersWithPermissions(...): collaborators of all pages should be listed: -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestValidateCustomRoles(t *testing.T) {
+	customRole := "security-engineer"
+	customRoles := &fake.MockOrganizationsClient{
+		MockListCustomRepoRoles: func(ctx context.Context, org string) (*github.OrganizationCustomRepoRoles, *github.Response, error) {
+			return &github.OrganizationCustomRepoRoles{
+				CustomRepoRoles: []*github.CustomRepoRoles{{Name: &customRole}},
+			}, nil, nil
+		},
+	}
+
+	cases := map[string]struct {
+		reason string
+		gh     *ghclient.Client
+		cr     *v1alpha1.Repository
+		want   string
+	}{
+		"BuiltinRoles": {
+			reason: "Built-in roles should be accepted without listing the custom roles of the organization.",
+			gh:     &ghclient.Client{},
+			cr:     repository(),
+		},
+		"DefinedCustomRoles": {
+			reason: "Custom roles the organization defines should be accepted.",
+			gh:     &ghclient.Client{Organizations: customRoles},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Permissions.Users[0].Role = customRole
+				r.Spec.ForProvider.Permissions.Teams[0].Role = customRole
+			}),
+		},
+		"UndefinedCustomRoles": {
+			reason: "Custom roles the organization doesn't define should be rejected.",
+			gh:     &ghclient.Client{Organizations: customRoles},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Permissions.Users[0].Role = "release-manager"
+				r.Spec.ForProvider.Permissions.Teams[0].Role = customRole
+			}),
+			want: "repository roles release-manager are not defined by organization ",
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got string
+			if err := validateCustomRoles(context.Background(), tc.gh, tc.cr); err != nil {
+				got = err.Error()
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nvalidateCustomRoles(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithCustomTeamRoles(t *testing.T) {
+	customRole := "security-engineer"
+	gh := &ghclient.Client{
+		Teams: &fake.MockTeamsClient{
+			MockIsTeamRepoBySlug: func(ctx context.Context, org, slug, owner, repo string) (*github.Repository, *github.Response, error) {
+				if slug != team1 {
+					return nil, nil, errors.New("unexpected team")
+				}
+				return &github.Repository{RoleName: &customRole}, nil, nil
+			},
+		},
+	}
+
+	cr := map[string]string{team1: customRole, team2: team2Role}
+	got, err := withCustomTeamRoles(context.Background(), gh, "", repo, cr, map[string]string{team1: "push", team2: team2Role})
+	if err != nil {
+		t.Fatalf("withCustomTeamRoles(...): %v", err)
+	}
+	if diff := cmp.Diff(cr, got); diff != "" {
+		t.Errorf("withCustomTeamRoles(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestRepoPermissionRoles(t *testing.T) {
+	admin, maintainer, triager, writer, custom := "admin", "maintainer", "triager", "writer", "custom"
+	customRole, writeRole, maintainPermission := "security-engineer", "write", "maintain"
+	gh := &ghclient.Client{
+		Repositories: &fake.MockRepositoriesClient{
+			MockListCollaborators: func(ctx context.Context, owner, repo string, opts *github.ListCollaboratorsOptions) ([]*github.User, *github.Response, error) {
+				return []*github.User{
+					{Login: &admin, Permissions: map[string]bool{"admin": true, "maintain": true, "push": true, "triage": true, "pull": true}},
+					{Login: &maintainer, Permissions: map[string]bool{"admin": false, "maintain": true, "push": true, "triage": true, "pull": true}},
+					{Login: &triager, Permissions: map[string]bool{"admin": false, "maintain": false, "push": false, "triage": true, "pull": true}},
+					{Login: &writer, RoleName: &writeRole, Permissions: map[string]bool{"push": true, "triage": true, "pull": true}},
+					{Login: &custom, RoleName: &customRole, Permissions: map[string]bool{"push": true, "pull": true}},
+				}, fake.GenerateEmptyResponse(), nil
+			},
+			MockListTeams: func(ctx context.Context, owner, repo string, opts *github.ListOptions) ([]*github.Team, *github.Response, error) {
+				return []*github.Team{
+					{Slug: &admin, Permissions: map[string]bool{"admin": true, "push": true, "pull": true}},
+					{Slug: &maintainer, Permission: &maintainPermission, Permissions: map[string]bool{"maintain": true, "push": true, "triage": true, "pull": true}},
+					{Slug: &triager, Permissions: map[string]bool{"triage": true, "pull": true}},
+				}, fake.GenerateEmptyResponse(), nil
+			},
+		},
+	}
+
+	cases := map[string]struct {
+		reason string
+		list   func() (map[string]string, error)
+		want   map[string]string
+	}{
+		"Users": {
+			reason: "The highest permission of a collaborator's permission map, or the permission of their role, should be reported.",
+			list: func() (map[string]string, error) {
+				return getRepoUsersWithPermissions(context.Background(), gh, org, repo)
+			},
+			want: map[string]string{admin: "admin", maintainer: "maintain", triager: "triage", writer: "push", custom: customRole},
+		},
+		"Teams": {
+			reason: "The permission of a team should be reported by name, or as the highest of its permission map.",
+			list: func() (map[string]string, error) {
+				return getRepoTeamsWithPermissions(context.Background(), gh, org, repo)
+			},
+			want: map[string]string{admin: "admin", maintainer: "maintain", triager: "triage"},
+		},
+		"Declared": {
+			reason: "The names of built-in roles should be granted and compared as the permissions GitHub reports.",
+			list: func() (map[string]string, error) {
+				return getUserPermissionMapFromCr([]v1alpha1.RepositoryUser{
+					{User: admin, Role: "admin"},
+					{User: maintainer, Role: "maintain"},
+					{User: triager, Role: "triage"},
+					{User: writer, Role: "write"},
+					{User: custom, Role: customRole},
+				}), nil
+			},
+			want: map[string]string{admin: "admin", maintainer: "maintain", triager: "triage", writer: "push", custom: customRole},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := tc.list()
+			if err != nil {
+				t.Fatalf("%s: %v", name, err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\n-want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestWithoutOrganizationOwners(t *testing.T) {
+	owner := "org-owner"
+	owners := &fake.MockOrganizationsClient{
+		MockListMembers: func(ctx context.Context, org string, opts *github.ListMembersOptions) ([]*github.User, *github.Response, error) {
+			if opts.Role != "admin" {
+				return nil, nil, errors.New("unexpected role")
+			}
+			return []*github.User{{Login: &owner}, {Login: &user1}}, fake.GenerateEmptyResponse(), nil
+		},
+	}
+
+	cases := map[string]struct {
+		reason string
+		gh     *ghclient.Client
+		cr     *v1alpha1.Repository
+		users  map[string]string
+		want   map[string]string
+	}{
+		"UndeclaredOwner": {
+			reason: "An owner of the organization that isn't declared should not be compared.",
+			gh:     &ghclient.Client{Organizations: owners},
+			cr:     repository(),
+			users:  map[string]string{user1: user1Role, user2: user2Role, owner: "admin"},
+			want:   map[string]string{user1: user1Role, user2: user2Role},
+		},
+		"NoUndeclaredAdmin": {
+			reason: "The owners of the organization should not be listed if no undeclared collaborator is an admin.",
+			gh:     &ghclient.Client{},
+			cr:     repository(),
+			users:  map[string]string{user1: user1Role, user2: user2Role},
+			want:   map[string]string{user1: user1Role, user2: user2Role},
+		},
+		"ComparingOwners": {
+			reason: "The owners of the organization should be compared if the Repository doesn't ignore them.",
+			gh:     &ghclient.Client{},
+			cr: repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.IgnoreOrganizationOwners = util.ToBoolPtr(false)
+			}),
+			users: map[string]string{user1: user1Role, owner: "admin"},
+			want:  map[string]string{user1: user1Role, owner: "admin"},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got, err := withoutOrganizationOwners(context.Background(), tc.gh, tc.cr, tc.users)
+			if err != nil {
+				t.Fatalf("withoutOrganizationOwners(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nwithoutOrganizationOwners(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestEnvironmentGates(t *testing.T) {
+	login, teamSlug := "release-manager", "sre"
+	userType, teamType := "User", "Team"
+	waitTimer := 30
+
+	cases := map[string]struct {
+		reason string
+		rules  []*github.ProtectionRule
+		want   v1alpha1.RepositoryEnvironmentObservation
+	}{
+		"Ungated": {
+			reason: "An environment without protection rules should only be reported by name.",
+			want:   v1alpha1.RepositoryEnvironmentObservation{Name: "production"},
+		},
+		"Gated": {
+			reason: "The wait timer and the logins and slugs of the reviewers of an environment should be reported.",
+			rules: []*github.ProtectionRule{
+				{Type: github.String("wait_timer"), WaitTimer: &waitTimer},
+				{
+					Type:              github.String("required_reviewers"),
+					PreventSelfReview: github.Bool(true),
+					Reviewers: []*github.RequiredReviewer{
+						{Type: &userType, Reviewer: &github.User{ID: github.Int64(2), Login: &login}},
+						{Type: &teamType, Reviewer: &github.Team{ID: github.Int64(1), Slug: &teamSlug}},
+					},
+				},
+				{Type: github.String("branch_policy")},
+			},
+			want: v1alpha1.RepositoryEnvironmentObservation{
+				Name:              "production",
+				WaitTimer:         &waitTimer,
+				PreventSelfReview: github.Bool(true),
+				Reviewers: []v1alpha1.EnvironmentReviewer{
+					{Type: teamType, ID: 1, Name: teamSlug},
+					{Type: userType, ID: 2, Name: login},
+				},
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := environmentGates("production", tc.rules)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nenvironmentGates(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestAddRepoUsers(t *testing.T) {
+	past := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(time.Hour))
+
+	cases := map[string]struct {
+		reason    string
+		expiresAt *metav1.Time
+		want      []string
+	}{
+		"NoExpiry": {
+			reason: "The users of a new repository should be added as collaborators.",
+			want:   []string{user1, user2},
+		},
+		"AccessNotExpired": {
+			reason:    "A user whose access has not expired yet should be added as a collaborator.",
+			expiresAt: &future,
+			want:      []string{user1, user2},
+		},
+		"AccessExpired": {
+			reason:    "A user whose access already expired should never be granted access to a new repository.",
+			expiresAt: &past,
+			want:      []string{user2},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var added []string
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockAddCollaborator: func(ctx context.Context, owner, repo, user string, opts *github.RepositoryAddCollaboratorOptions) (*github.CollaboratorInvitation, *github.Response, error) {
+						added = append(added, user)
+						return nil, nil, nil
+					},
+				},
+			}
+			cr := repository(func(r *v1alpha1.Repository) {
+				r.Spec.ForProvider.Permissions.Users[0].ExpiresAt = tc.expiresAt
+			})
+			if err := addRepoUsers(context.Background(), gh, cr, repo); err != nil {
+				t.Fatalf("addRepoUsers(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, added); diff != "" {
+				t.Errorf("\n%s\naddRepoUsers(...): -want added, +got added:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestObserveRepositoryRulesErrors(t *testing.T) {
+	type want struct {
+		err     bool
+		missing bool
+	}
+
+	cases := map[string]struct {
+		reason string
+		status int
+		want   want
+	}{
+		"ServerError": {
+			reason: "Rulesets that can't be listed should fail the observation rather than be reported up to date.",
+			status: http.StatusInternalServerError,
+			want:   want{err: true},
+		},
+		"MissingPermissions": {
+			reason: "Rulesets the credentials aren't allowed to list should be returned as missing permissions, so that they are skipped.",
+			status: http.StatusForbidden,
+			want:   want{err: true, missing: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			gh := &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{
+					MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+						return nil, nil, &github.ErrorResponse{Response: &http.Response{StatusCode: tc.status}, Message: "Resource not accessible by integration"}
+					},
+				},
+			}
+			upToDate, err := observeRepositoryRules(context.Background(), gh, &test.MockClient{MockList: test.NewMockListFn(nil)}, repository(), repo, nil, &undeclaredRules{})
+			if upToDate {
+				t.Errorf("\n%s\nobserveRepositoryRules(...): rulesets that couldn't be listed should not be up to date", tc.reason)
+			}
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nobserveRepositoryRules(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.missing, permissions.IsMissing(err)); diff != "" {
+				t.Errorf("\n%s\nobserveRepositoryRules(...): -want missing permissions, +got missing permissions:\n%s\n", tc.reason, diff)
+			}
 		})
 	}
 }
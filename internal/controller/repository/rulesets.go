@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const errListStandaloneRulesets = "cannot list RepositoryRuleset managed resources"
+
+// standaloneRulesets returns the names of the rulesets of the RepositoryRuleset
+// managed resources of a repository. Their rulesets are left alone by the
+// Repository, whatever its management policy.
+func standaloneRulesets(ctx context.Context, kube client.Reader, org, repo string) ([]string, error) {
+	l := &v1alpha1.RepositoryRulesetList{}
+	if err := kube.List(ctx, l); err != nil {
+		return nil, errors.Wrap(err, errListStandaloneRulesets)
+	}
+	var names []string
+	for _, r := range l.Items {
+		p := r.Spec.ForProvider
+		// The names of organizations and repositories are case-insensitive.
+		if strings.EqualFold(p.Org, org) && strings.EqualFold(p.Repo, repo) {
+			names = append(names, p.Name)
+		}
+	}
+	return names, nil
+}
+
+// withoutRulesets returns a copy of rulesets without the rulesets with the
+// given names.
+func withoutRulesets(rulesets map[string]v1alpha1.Ruleset, names []string) map[string]v1alpha1.Ruleset {
+	res := make(map[string]v1alpha1.Ruleset, len(rulesets))
+	for name, rs := range rulesets {
+		if !util.Contains(names, name) {
+			res[name] = rs
+		}
+	}
+	return res
+}
+
+// trackRulesets records the IDs of the rulesets on GitHub that are declared in
+// the spec by name. IDs of rulesets that are no longer declared are kept until
+// the next update, which may rename them.
+func trackRulesets(cr *v1alpha1.Repository, rulesets []*github.Ruleset) {
+	declared := declaredRulesets(cr)
+	ids := make(map[string]int64, len(cr.Status.AtProvider.RulesetIDs))
+	for name, id := range cr.Status.AtProvider.RulesetIDs {
+		ids[name] = id
+	}
+	for _, rs := range rulesets {
+		if util.Contains(declared, rs.Name) {
+			ids[rs.Name] = rs.GetID()
+		}
+	}
+	if len(ids) == 0 {
+		ids = nil
+	}
+	cr.Status.AtProvider.RulesetIDs = ids
+}
+
+// declaredRulesets returns the names of the repositoryRules of the spec.
+func declaredRulesets(cr *v1alpha1.Repository) []string {
+	names := make([]string, len(cr.Spec.ForProvider.RepositoryRules))
+	for i, rs := range cr.Spec.ForProvider.RepositoryRules {
+		names[i] = rs.Name
+	}
+	return names
+}
+
+// renamedRulesets returns the IDs of the rulesets on GitHub to rename to the
+// rulesets to add, keyed by the name to add. A tracked ruleset is renamed if it
+// is managed, i.e. neither invalid nor one of a RepositoryRuleset managed
+// resource, and its name on GitHub is no longer declared. A ruleset to add
+// takes the ruleset tracked by its name first, e.g. one renamed on GitHub, and
+// else the remaining ones in the order of their names. Renamed rulesets are
+// removed from toDelete.
+func renamedRulesets(ids map[string]int64, rulesets []*github.Ruleset, managed, declared, toDelete, toAdd map[string]v1alpha1.Ruleset) map[string]int64 {
+	names := make(map[int64]string, len(rulesets))
+	for _, rs := range rulesets {
+		_, isManaged := managed[rs.Name]
+		_, isDeclared := declared[rs.Name]
+		if isManaged && !isDeclared {
+			names[rs.GetID()] = rs.Name
+		}
+	}
+
+	added := make([]string, 0, len(toAdd))
+	for name := range toAdd {
+		added = append(added, name)
+	}
+	sort.Strings(added)
+
+	renamed := make(map[string]int64)
+	rename := func(name string, id int64) {
+		renamed[name] = id
+		delete(toDelete, names[id])
+		delete(names, id)
+	}
+
+	var remaining []string
+	for _, name := range added {
+		if id, ok := ids[name]; ok && names[id] != "" {
+			rename(name, id)
+			continue
+		}
+		remaining = append(remaining, name)
+	}
+
+	var reusable []int64
+	for _, id := range ids {
+		if names[id] != "" {
+			reusable = append(reusable, id)
+		}
+	}
+	sort.Slice(reusable, func(i, j int) bool { return names[reusable[i]] < names[reusable[j]] })
+
+	for _, id := range reusable {
+		// A ruleset may be tracked by more than one name.
+		if len(remaining) == 0 || names[id] == "" {
+			continue
+		}
+		rename(remaining[0], id)
+		remaining = remaining[1:]
+	}
+	return renamed
+}
+
+// trackedRulesetID returns the ID of the ruleset with the given name on GitHub,
+// preferring its tracked ID while a ruleset with that ID and name exists.
+func trackedRulesetID(ids map[string]int64, rulesets []*github.Ruleset, name string) (int64, error) {
+	if id, ok := ids[name]; ok {
+		for _, rs := range rulesets {
+			if rs.GetID() == id && rs.Name == name {
+				return id, nil
+			}
+		}
+	}
+	return findRulesetIDByName(rulesets, name)
+}
+
+// declaredRulesetIDs returns the IDs of the rulesets declared in the spec,
+// dropping the ones that are no longer declared.
+func declaredRulesetIDs(cr *v1alpha1.Repository, ids map[string]int64) map[string]int64 {
+	res := make(map[string]int64, len(ids))
+	for _, name := range declaredRulesets(cr) {
+		if id, ok := ids[name]; ok {
+			res[name] = id
+		}
+	}
+	if len(res) == 0 {
+		return nil
+	}
+	return res
+}
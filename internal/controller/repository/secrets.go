@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetSecretValue     = "cannot get secret value"
+	errGetRepoPublicKey   = "cannot get repository secrets public key"
+	errGetRepoSecret      = "cannot get repository secret"
+	errSetRepoSecret      = "cannot set repository secret"
+	errSecretKeyNotFoundF = "secret %s/%s has no key %s"
+)
+
+// repoSecretStore is a store of encrypted repository secrets, e.g. the
+// Dependabot or Codespaces secrets of a repository.
+type repoSecretStore interface {
+	GetRepoPublicKey(ctx context.Context, owner, repo string) (*github.PublicKey, *github.Response, error)
+	GetRepoSecret(ctx context.Context, owner, repo, name string) (*github.Secret, *github.Response, error)
+	SetRepoSecret(ctx context.Context, owner, repo, name, keyID, encryptedValue string) error
+}
+
+type dependabotSecretStore struct {
+	ghclient.DependabotClient
+}
+
+func (d dependabotSecretStore) SetRepoSecret(ctx context.Context, owner, repo, name, keyID, encryptedValue string) error {
+	_, err := d.CreateOrUpdateRepoSecret(ctx, owner, repo, &github.DependabotEncryptedSecret{
+		Name:           name,
+		KeyID:          keyID,
+		EncryptedValue: encryptedValue,
+	})
+	return err
+}
+
+type codespacesSecretStore struct {
+	ghclient.CodespacesClient
+}
+
+func (c codespacesSecretStore) SetRepoSecret(ctx context.Context, owner, repo, name, keyID, encryptedValue string) error {
+	_, err := c.CreateOrUpdateRepoSecret(ctx, owner, repo, &github.EncryptedSecret{
+		Name:           name,
+		KeyID:          keyID,
+		EncryptedValue: encryptedValue,
+	})
+	return err
+}
+
+// getSecretValue returns the value a secret key selector references, and the
+// resource version of its Kubernetes Secret.
+func getSecretValue(ctx context.Context, kube client.Reader, ref xpv1.SecretKeySelector) ([]byte, string, error) {
+	s := &corev1.Secret{}
+	if err := kube.Get(ctx, types.NamespacedName{Namespace: ref.Namespace, Name: ref.Name}, s); err != nil {
+		return nil, "", errors.Wrap(err, errGetSecretValue)
+	}
+	value, ok := s.Data[ref.Key]
+	if !ok {
+		return nil, "", errors.Errorf(errSecretKeyNotFoundF, ref.Namespace, ref.Name, ref.Key)
+	}
+	return value, s.ResourceVersion, nil
+}
+
+// observeRepoSecrets returns whether all secrets exist in the store and were
+// last set from the current version of their Kubernetes Secrets. GitHub never
+// returns secret values, so the versions recorded when setting them are
+// compared instead.
+func observeRepoSecrets(ctx context.Context, kube client.Reader, store repoSecretStore, owner, repo string, secrets []v1alpha1.RepositorySecret, versions map[string]string) (bool, error) {
+	for _, s := range secrets {
+		_, version, err := getSecretValue(ctx, kube, s.ValueSecretRef)
+		if err != nil {
+			return false, err
+		}
+		if versions[s.Name] != version {
+			return false, nil
+		}
+		_, _, err = store.GetRepoSecret(ctx, owner, repo, s.Name)
+		if ghclient.Is404(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, errors.Wrap(err, errGetRepoSecret)
+		}
+	}
+	return true, nil
+}
+
+// updateRepoSecrets encrypts and sets all secrets in the store. It returns the
+// versions of the Kubernetes Secrets that were set, also when setting one of
+// the secrets failed.
+func updateRepoSecrets(ctx context.Context, kube client.Reader, store repoSecretStore, owner, repo string, secrets []v1alpha1.RepositorySecret, versions map[string]string) (map[string]string, error) {
+	updated := make(map[string]string, len(secrets))
+	for _, s := range secrets {
+		if v, ok := versions[s.Name]; ok {
+			updated[s.Name] = v
+		}
+	}
+
+	key, _, err := store.GetRepoPublicKey(ctx, owner, repo)
+	if err != nil {
+		return updated, errors.Wrap(err, errGetRepoPublicKey)
+	}
+
+	for _, s := range secrets {
+		value, version, err := getSecretValue(ctx, kube, s.ValueSecretRef)
+		if err != nil {
+			return updated, err
+		}
+		encrypted, err := ghclient.EncryptSecret(key, value)
+		if err != nil {
+			return updated, err
+		}
+		if err := store.SetRepoSecret(ctx, owner, repo, s.Name, key.GetKeyID(), encrypted); err != nil {
+			return updated, errors.Wrap(err, errSetRepoSecret)
+		}
+		updated[s.Name] = version
+	}
+	return updated, nil
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetDelegatedBypass    = "cannot get secret scanning delegated bypass"
+	errUpdateDelegatedBypass = "cannot update secret scanning delegated bypass"
+	errGetBypassReviewerTeam = "cannot get bypass reviewer team %s"
+
+	delegatedBypassEnabled      = "enabled"
+	delegatedBypassDisabled     = "disabled"
+	delegatedBypassReviewerTeam = "TEAM"
+)
+
+// desiredDelegatedBypass returns the delegated bypass of the spec, with the
+// reviewer teams resolved to their IDs and sorted.
+func desiredDelegatedBypass(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository) (*ghclient.SecretScanningDelegatedBypass, error) {
+	b := cr.Spec.ForProvider.SecretScanningDelegatedBypass
+	if !b.Enabled {
+		return &ghclient.SecretScanningDelegatedBypass{Status: delegatedBypassDisabled}, nil
+	}
+
+	desired := &ghclient.SecretScanningDelegatedBypass{Status: delegatedBypassEnabled}
+	for _, r := range b.ReviewerTeams {
+		team, _, err := gh.Teams.GetTeamBySlug(ctx, cr.Spec.ForProvider.Org, slug.Make(r.Team))
+		if err != nil {
+			return nil, errors.Wrapf(err, errGetBypassReviewerTeam, r.Team)
+		}
+		desired.Reviewers = append(desired.Reviewers, &ghclient.SecretScanningBypassReviewer{ReviewerID: team.GetID(), ReviewerType: delegatedBypassReviewerTeam})
+	}
+	sortBypassReviewers(desired.Reviewers)
+	return desired, nil
+}
+
+func sortBypassReviewers(reviewers []*ghclient.SecretScanningBypassReviewer) {
+	slices.SortFunc(reviewers, func(a, b *ghclient.SecretScanningBypassReviewer) int {
+		return cmp.Or(cmp.Compare(a.ReviewerType, b.ReviewerType), cmp.Compare(a.ReviewerID, b.ReviewerID))
+	})
+}
+
+// delegatedBypassUpToDate returns whether the observed delegated bypass of a
+// repository matches the desired one. The reviewers of a disabled delegated
+// bypass aren't compared.
+func delegatedBypassUpToDate(desired, observed *ghclient.SecretScanningDelegatedBypass) bool {
+	if desired.Status != observed.Status {
+		return false
+	}
+	if desired.Status != delegatedBypassEnabled {
+		return true
+	}
+	reviewers := slices.Clone(observed.Reviewers)
+	sortBypassReviewers(reviewers)
+	return slices.EqualFunc(desired.Reviewers, reviewers, func(a, b *ghclient.SecretScanningBypassReviewer) bool {
+		return *a == *b
+	})
+}
+
+// getDelegatedBypassState returns the desired delegated bypass of a
+// repository, and whether it matches the one on GitHub.
+func getDelegatedBypassState(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) (*ghclient.SecretScanningDelegatedBypass, bool, error) {
+	desired, err := desiredDelegatedBypass(ctx, gh, cr)
+	if err != nil {
+		return nil, false, err
+	}
+	observed, _, err := gh.Repositories.GetSecretScanningDelegatedBypass(ctx, cr.Spec.ForProvider.Org, repoName)
+	if err != nil {
+		return nil, false, errors.Wrap(err, errGetDelegatedBypass)
+	}
+	return desired, delegatedBypassUpToDate(desired, observed), nil
+}
+
+// updateDelegatedBypass sets the delegated bypass of a repository as in its
+// spec, if it differs.
+func updateDelegatedBypass(ctx context.Context, gh *ghclient.Client, cr *v1alpha1.Repository, repoName string) error {
+	desired, upToDate, err := getDelegatedBypassState(ctx, gh, cr, repoName)
+	if err != nil || upToDate {
+		return err
+	}
+	_, err = gh.Repositories.UpdateSecretScanningDelegatedBypass(ctx, cr.Spec.ForProvider.Org, repoName, desired)
+	return errors.Wrap(err, errUpdateDelegatedBypass)
+}
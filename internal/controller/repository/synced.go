@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	"github.com/crossplane/provider-github/internal/permissions"
+)
+
+// syncedCondition returns the condition of type t of a group of sub-resources
+// that was synced with the supplied errors, one per sub-resource of the group.
+func syncedCondition(t xpv1.ConditionType, errs ...error) xpv1.Condition {
+	var skipped []string
+	for _, err := range errs {
+		switch {
+		case err == nil:
+		case permissions.IsMissing(err):
+			skipped = append(skipped, err.Error())
+		default:
+			return v1alpha1.SubResourcesSyncFailed(t, err.Error())
+		}
+	}
+	if len(skipped) > 0 {
+		return v1alpha1.SubResourcesSkipped(t, strings.Join(skipped, "; "))
+	}
+	return v1alpha1.SubResourcesSynced(t)
+}
+
+// setObservedCondition sets the condition of type t of a group of
+// sub-resources that was observed with the supplied errors, unless the group
+// differs from the spec. Those keep their condition until they are synced.
+func setObservedCondition(cr *v1alpha1.Repository, t xpv1.ConditionType, upToDate bool, errs ...error) {
+	if c := syncedCondition(t, errs...); c.Status != corev1.ConditionTrue || upToDate {
+		cr.SetConditions(c)
+	}
+}
+
+// subResourceResult is the outcome of syncing a sub-resource of a Repository.
+type subResourceResult struct {
+	name string
+	err  error
+}
+
+// groupSync syncs the groups of sub-resources of a Repository apart from each
+// other, so that a failing group doesn't keep the others from being synced.
+// It keeps the first error that isn't caused by missing permissions.
+type groupSync struct {
+	cr      *v1alpha1.Repository
+	skipped *permissions.Skipped
+	err     error
+}
+
+// record sets the condition of type t of a group from the results of syncing
+// its sub-resources.
+func (s *groupSync) record(t xpv1.ConditionType, results ...subResourceResult) {
+	errs := make([]error, len(results))
+	for i, r := range results {
+		errs[i] = r.err
+		if _, err := s.skipped.Skip(r.name, r.err); err != nil && s.err == nil {
+			s.err = err
+		}
+	}
+	s.cr.SetConditions(syncedCondition(t, errs...))
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"sort"
+
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errListOrganizations = "cannot list Organization managed resources"
+	errReplaceTopics     = "cannot replace repository topics"
+)
+
+// getRequiredTopics returns the topics the managed Organization of a Repository
+// requires all its repositories to have, sorted and without duplicates.
+func getRequiredTopics(ctx context.Context, kube client.Reader, cr *v1alpha1.Repository) ([]string, error) {
+	orgs := &v1alpha1.OrganizationList{}
+	if err := kube.List(ctx, orgs); err != nil {
+		return nil, errors.Wrap(err, errListOrganizations)
+	}
+
+	var topics []string
+	for i := range orgs.Items {
+		org := &orgs.Items[i]
+		defaults := org.Spec.ForProvider.RepositoryDefaults
+		if meta.GetExternalName(org) != cr.Spec.ForProvider.Org || defaults == nil {
+			continue
+		}
+		topics = append(topics, defaults.Topics...)
+		for _, key := range defaults.TopicsFromLabels {
+			if value, ok := cr.GetLabels()[key]; ok && value != "" {
+				topics = append(topics, slug.Make(key+"-"+value))
+			}
+		}
+	}
+
+	return uniqueSorted(topics), nil
+}
+
+// missingTopics returns the required topics that are not in current.
+func missingTopics(required, current []string) []string {
+	var missing []string
+	for _, t := range required {
+		if !util.Contains(current, t) {
+			missing = append(missing, t)
+		}
+	}
+	return missing
+}
+
+// updateRequiredTopics adds the required topics missing from the current topics
+// of a repository. Topics that are not required are left untouched.
+func updateRequiredTopics(ctx context.Context, cr *v1alpha1.Repository, gh *ghclient.Client, repoName string, required, current []string) error {
+	if len(missingTopics(required, current)) == 0 {
+		return nil
+	}
+
+	topics := uniqueSorted(append(append([]string{}, current...), required...))
+	_, _, err := gh.Repositories.ReplaceAllTopics(ctx, cr.Spec.ForProvider.Org, repoName, topics)
+	return errors.Wrap(err, errReplaceTopics)
+}
+
+func uniqueSorted(s []string) []string {
+	if len(s) == 0 {
+		return nil
+	}
+	sort.Strings(s)
+	out := s[:1]
+	for _, v := range s[1:] {
+		if v != out[len(out)-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+// withoutUndeclared returns the rules of the repository without the ones that
+// are not declared in the spec, unless the management policy is Full. It also
+// returns the sorted keys of the undeclared rules that were removed.
+func withoutUndeclared[T any](policy *string, ghRules, crRules map[string]T) (map[string]T, []string) {
+	if pointer.StringDeref(policy, policyFull) == policyFull {
+		return ghRules, nil
+	}
+	declared := make(map[string]T, len(crRules))
+	var undeclared []string
+	for key, rule := range ghRules {
+		if _, ok := crRules[key]; ok {
+			declared[key] = rule
+			continue
+		}
+		undeclared = append(undeclared, key)
+	}
+	sort.Strings(undeclared)
+	return declared, undeclared
+}
+
+// undeclaredRules records the rules of a repository that are not declared in
+// its spec, for the rule kinds with the Report management policy. Rule kinds
+// may be added concurrently.
+type undeclaredRules struct {
+	mu     sync.Mutex
+	report bool
+	rules  []string
+}
+
+// Add records the undeclared rules of a kind, if its policy is Report.
+func (u *undeclaredRules) Add(policy *string, kind string, keys []string) {
+	if pointer.StringDeref(policy, policyFull) != policyReport {
+		return
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.report = true
+	for _, k := range keys {
+		u.rules = append(u.rules, fmt.Sprintf("%s %s", kind, k))
+	}
+}
+
+// Condition returns the RulesDeclared condition, and false if no rule kind
+// has the Report management policy.
+func (u *undeclaredRules) Condition() (xpv1.Condition, bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if !u.report {
+		return xpv1.Condition{}, false
+	}
+	if len(u.rules) == 0 {
+		return v1alpha1.RulesDeclared(), true
+	}
+	sort.Strings(u.rules)
+	return v1alpha1.RulesUndeclared("not declared in the spec: " + strings.Join(u.rules, ", ")), true
+}
@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repository
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errPingWebhook           = "cannot ping webhook %s"
+	errListWebhookDeliveries = "cannot list deliveries of webhook %s"
+)
+
+// knownWebhookEvents are the events GitHub delivers to repository webhooks, see
+// https://docs.github.com/en/webhooks/webhook-events-and-payloads. The
+// wildcard subscribes to all of them.
+var knownWebhookEvents = map[string]bool{
+	"*":                               true,
+	"branch_protection_configuration": true,
+	"branch_protection_rule":          true,
+	"check_run":                       true,
+	"check_suite":                     true,
+	"code_scanning_alert":             true,
+	"commit_comment":                  true,
+	"create":                          true,
+	"delete":                          true,
+	"dependabot_alert":                true,
+	"deploy_key":                      true,
+	"deployment":                      true,
+	"deployment_protection_rule":      true,
+	"deployment_review":               true,
+	"deployment_status":               true,
+	"discussion":                      true,
+	"discussion_comment":              true,
+	"fork":                            true,
+	"gollum":                          true,
+	"issue_comment":                   true,
+	"issues":                          true,
+	"label":                           true,
+	"member":                          true,
+	"merge_group":                     true,
+	"meta":                            true,
+	"milestone":                       true,
+	"package":                         true,
+	"page_build":                      true,
+	"project":                         true,
+	"project_card":                    true,
+	"project_column":                  true,
+	"public":                          true,
+	"pull_request":                    true,
+	"pull_request_review":             true,
+	"pull_request_review_comment":     true,
+	"pull_request_review_thread":      true,
+	"push":                            true,
+	"registry_package":                true,
+	"release":                         true,
+	"repository":                      true,
+	"repository_advisory":             true,
+	"repository_import":               true,
+	"repository_ruleset":              true,
+	"repository_vulnerability_alert":  true,
+	"secret_scanning_alert":           true,
+	"secret_scanning_alert_location":  true,
+	"security_and_analysis":           true,
+	"star":                            true,
+	"status":                          true,
+	"team_add":                        true,
+	"watch":                           true,
+	"workflow_job":                    true,
+	"workflow_run":                    true,
+}
+
+// unknownWebhookEvent describes an event of a webhook that isn't known, with
+// the known event it was likely meant to be, e.g. pull_request for
+// pull-request.
+func unknownWebhookEvent(event string) string {
+	if guess := strings.ReplaceAll(strings.ToLower(strings.TrimSpace(event)), "-", "_"); knownWebhookEvents[guess] {
+		return fmt.Sprintf("%s (did you mean %s?)", event, guess)
+	}
+	return event
+}
+
+// webhookEventsCondition returns the WebhookEventsValid condition of the
+// webhooks of a repository, with the unknown events of the webhooks that don't
+// allow them.
+func webhookEventsCondition(webhooks []v1alpha1.RepositoryWebhook) xpv1.Condition {
+	var msgs []string
+	for _, h := range webhooks {
+		if pointer.BoolDeref(h.AllowUnknownEvents, false) {
+			continue
+		}
+		var unknown []string
+		for _, e := range h.Events {
+			if !knownWebhookEvents[e] {
+				unknown = append(unknown, unknownWebhookEvent(e))
+			}
+		}
+		if len(unknown) > 0 {
+			msgs = append(msgs, fmt.Sprintf("webhooks[%s]: unknown events %s", h.Url, strings.Join(unknown, ", ")))
+		}
+	}
+	if len(msgs) == 0 {
+		return v1alpha1.WebhookEventsValid()
+	}
+	return v1alpha1.WebhookEventsUnknown(strings.Join(msgs, "; "))
+}
+
+// pingedWebhooks returns the keys of the webhooks of the spec that are pinged
+// once they are created.
+func pingedWebhooks(webhooks []v1alpha1.RepositoryWebhook) map[string]bool {
+	pinged := make(map[string]bool)
+	for _, h := range webhooks {
+		if pointer.BoolDeref(h.PingOnCreate, false) {
+			pinged[webhookKey(h.Url)] = true
+		}
+	}
+	return pinged
+}
+
+// createRepoWebhook creates a webhook in a repository, and pings it if ping
+// is set and the webhook is active.
+func createRepoWebhook(ctx context.Context, gh *ghclient.Client, org, repoName string, hook v1alpha1.RepositoryWebhook, ping bool) error {
+	created, _, err := gh.Repositories.CreateHook(ctx, org, repoName, crRepoHookToHookConfig(hook))
+	if err != nil {
+		return err
+	}
+	if !ping || !pointer.BoolDeref(hook.Active, true) {
+		return nil
+	}
+	_, err = gh.Repositories.PingHook(ctx, org, repoName, created.GetID())
+	return errors.Wrapf(err, errPingWebhook, hook.Url)
+}
+
+// observeWebhookDeliveries returns the last deliveries of the webhooks of a
+// repository that are declared in the spec, sorted by URL.
+func observeWebhookDeliveries(ctx context.Context, gh *ghclient.Client, org, repoName string, hooks []*github.Hook, declared []v1alpha1.RepositoryWebhook) ([]v1alpha1.RepositoryWebhookObservation, error) {
+	keys := make(map[string]bool, len(declared))
+	for _, h := range declared {
+		keys[webhookKey(h.Url)] = true
+	}
+
+	var obs []v1alpha1.RepositoryWebhookObservation
+	for _, h := range hooks {
+		url := h.Config.GetURL()
+		if !keys[webhookKey(url)] {
+			continue
+		}
+		// GitHub lists the most recent deliveries first.
+		deliveries, _, err := gh.Repositories.ListHookDeliveries(ctx, org, repoName, h.GetID(), &github.ListCursorOptions{PerPage: 1})
+		if err != nil {
+			return nil, errors.Wrapf(err, errListWebhookDeliveries, url)
+		}
+		o := v1alpha1.RepositoryWebhookObservation{URL: url, ID: h.GetID()}
+		if len(deliveries) > 0 {
+			d := deliveries[0]
+			o.LastDeliveryStatusCode = d.StatusCode
+			o.LastDeliveryStatus = d.GetStatus()
+			o.LastDeliveryTime = toTime(d.DeliveredAt)
+		}
+		obs = append(obs, o)
+	}
+
+	sort.Slice(obs, func(i, j int) bool {
+		return obs[i].URL < obs[j].URL
+	})
+	return obs, nil
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorydispatch
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+)
+
+const (
+	errNotRepositoryDispatch   = "managed resource is not a RepositoryDispatch custom resource"
+	errTrackPCUsage            = "cannot track ProviderConfig usage"
+	errGetPC                   = "cannot get ProviderConfig"
+	errGetCreds                = "cannot get credentials"
+	errNewClient               = "cannot create new Service"
+	errParseDispatchedAt       = "cannot parse dispatch time"
+	errParseDispatchGeneration = "cannot parse dispatched generation"
+	errMarshalClientPayload    = "cannot marshal client payload"
+	errDispatch                = "cannot dispatch repository_dispatch event"
+
+	// AnnotationKeyDispatchedAt records when the repository_dispatch event
+	// was first sent, and AnnotationKeyDispatchedGeneration for which
+	// generation of the spec. They are kept as annotations rather than in
+	// the status, because only annotations are persisted after a successful
+	// Create.
+	AnnotationKeyDispatchedAt         = "github.crossplane.io/dispatched-at"
+	AnnotationKeyDispatchedGeneration = "github.crossplane.io/dispatched-generation"
+)
+
+// Setup adds a controller that reconciles RepositoryDispatch managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RepositoryDispatchGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RepositoryDispatchGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.RepositoryDispatch{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.RepositoryDispatchList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.RepositoryDispatchGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryDispatch)
+	if !ok {
+		return nil, errors.New(errNotRepositoryDispatch)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.RepositoryDispatchKind, &external{github: gh})))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryDispatch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryDispatch)
+	}
+
+	// A dispatched event can't be undone, so report it as gone once it is
+	// deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The status records the dispatches of an Update, the annotations the
+	// one of the Create.
+	if cr.Status.AtProvider.DispatchedGeneration == nil {
+		obs, err := dispatchAnnotations(cr)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		if obs == nil {
+			return managed.ExternalObservation{ResourceExists: false}, nil
+		}
+		cr.Status.AtProvider = *obs
+	}
+
+	cr.SetConditions(xpv1.Available())
+
+	// The event is dispatched again for every new generation of the spec.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: *cr.Status.AtProvider.DispatchedGeneration >= cr.GetGeneration(),
+	}, nil
+}
+
+// dispatchAnnotations returns the dispatch recorded in the annotations of cr,
+// or nil if the event wasn't dispatched yet.
+func dispatchAnnotations(cr *v1alpha1.RepositoryDispatch) (*v1alpha1.RepositoryDispatchObservation, error) {
+	a := cr.GetAnnotations()
+	if a[AnnotationKeyDispatchedGeneration] == "" {
+		return nil, nil
+	}
+	gen, err := strconv.ParseInt(a[AnnotationKeyDispatchedGeneration], 10, 64)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseDispatchGeneration)
+	}
+	t, err := time.Parse(time.RFC3339, a[AnnotationKeyDispatchedAt])
+	if err != nil {
+		return nil, errors.Wrap(err, errParseDispatchedAt)
+	}
+	at := metav1.NewTime(t)
+	return &v1alpha1.RepositoryDispatchObservation{DispatchedGeneration: &gen, DispatchedAt: &at}, nil
+}
+
+// dispatch sends the repository_dispatch event of the spec of cr and records
+// it in the status of cr.
+func (c *external) dispatch(ctx context.Context, cr *v1alpha1.RepositoryDispatch) error {
+	p := cr.Spec.ForProvider
+	opts := github.DispatchRequestOptions{EventType: p.EventType}
+	if p.ClientPayload != nil {
+		payload, err := json.Marshal(p.ClientPayload)
+		if err != nil {
+			return errors.Wrap(err, errMarshalClientPayload)
+		}
+		raw := json.RawMessage(payload)
+		opts.ClientPayload = &raw
+	}
+
+	if _, _, err := c.github.Repositories.Dispatch(ctx, p.Org, p.Repo, opts); err != nil {
+		return errors.Wrap(err, errDispatch)
+	}
+
+	gen := cr.GetGeneration()
+	at := metav1.NewTime(time.Now().UTC().Truncate(time.Second))
+	cr.Status.AtProvider = v1alpha1.RepositoryDispatchObservation{DispatchedGeneration: &gen, DispatchedAt: &at}
+	return nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryDispatch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryDispatch)
+	}
+
+	if err := c.dispatch(ctx, cr); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	obs := cr.Status.AtProvider
+	meta.AddAnnotations(cr, map[string]string{
+		AnnotationKeyDispatchedAt:         obs.DispatchedAt.Format(time.RFC3339),
+		AnnotationKeyDispatchedGeneration: strconv.FormatInt(*obs.DispatchedGeneration, 10),
+	})
+	cr.SetConditions(xpv1.Available())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryDispatch)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepositoryDispatch)
+	}
+
+	return managed.ExternalUpdate{}, c.dispatch(ctx, cr)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RepositoryDispatch)
+	if !ok {
+		return errors.New(errNotRepositoryDispatch)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	return nil
+}
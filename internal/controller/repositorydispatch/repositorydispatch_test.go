@@ -0,0 +1,297 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositorydispatch
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org          = "test-org"
+	repo         = "test-repo"
+	eventType    = "bootstrap"
+	dispatchedAt = "2024-01-01T00:00:00Z"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type repositoryDispatchModifier func(*v1alpha1.RepositoryDispatch)
+
+func repositoryDispatch(m ...repositoryDispatchModifier) *v1alpha1.RepositoryDispatch {
+	cr := &v1alpha1.RepositoryDispatch{}
+	cr.SetGeneration(1)
+	cr.Spec.ForProvider = v1alpha1.RepositoryDispatchParameters{
+		Org:           org,
+		Repo:          repo,
+		EventType:     eventType,
+		ClientPayload: map[string]string{"environment": "production"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withGeneration(gen int64) repositoryDispatchModifier {
+	return func(r *v1alpha1.RepositoryDispatch) {
+		r.SetGeneration(gen)
+	}
+}
+
+func withDispatchAnnotations(gen string) repositoryDispatchModifier {
+	return func(r *v1alpha1.RepositoryDispatch) {
+		meta.AddAnnotations(r, map[string]string{
+			AnnotationKeyDispatchedAt:         dispatchedAt,
+			AnnotationKeyDispatchedGeneration: gen,
+		})
+	}
+}
+
+func withDispatchedGeneration(gen int64) repositoryDispatchModifier {
+	return func(r *v1alpha1.RepositoryDispatch) {
+		r.Status.AtProvider.DispatchedGeneration = &gen
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o                    managed.ExternalObservation
+		dispatchedGeneration *int64
+		ready                bool
+		err                  error
+	}
+
+	_, errParse := strconv.ParseInt("one", 10, 64)
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.RepositoryDispatch
+		want   want
+	}{
+		"NotDispatched": {
+			reason: "A RepositoryDispatch without a dispatch should be dispatched.",
+			cr:     repositoryDispatch(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"DispatchedOnCreate": {
+			reason: "The dispatch recorded in the annotations should be observed.",
+			cr:     repositoryDispatch(withDispatchAnnotations("1")),
+			want: want{
+				o:                    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				dispatchedGeneration: int64Ptr(1),
+				ready:                true,
+			},
+		},
+		"DispatchedOnUpdate": {
+			reason: "The dispatch recorded in the status should take precedence over the annotations.",
+			cr:     repositoryDispatch(withGeneration(2), withDispatchAnnotations("1"), withDispatchedGeneration(2)),
+			want: want{
+				o:                    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				dispatchedGeneration: int64Ptr(2),
+				ready:                true,
+			},
+		},
+		"NewGeneration": {
+			reason: "A RepositoryDispatch whose spec changed since the dispatch should be dispatched again.",
+			cr:     repositoryDispatch(withGeneration(2), withDispatchAnnotations("1")),
+			want: want{
+				o:                    managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				dispatchedGeneration: int64Ptr(1),
+				ready:                true,
+			},
+		},
+		"InvalidAnnotation": {
+			reason: "Errors parsing the dispatched generation should be returned.",
+			cr:     repositoryDispatch(withDispatchAnnotations("one")),
+			want: want{
+				err: errors.Wrap(errParse, errParseDispatchGeneration),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{}}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.dispatchedGeneration, tc.cr.Status.AtProvider.DispatchedGeneration); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want dispatchedGeneration, +got dispatchedGeneration:\n%s\n", tc.reason, diff)
+			}
+			if ready := tc.cr.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()); ready != tc.want.ready {
+				t.Errorf("\n%s\ne.Observe(...): want ready %t, got %t\n", tc.reason, tc.want.ready, ready)
+			}
+		})
+	}
+}
+
+func mockDispatch(err error) func(ctx context.Context, owner, repo string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error) {
+	return func(ctx context.Context, owner, name string, opts github.DispatchRequestOptions) (*github.Repository, *github.Response, error) {
+		if err != nil {
+			return nil, nil, err
+		}
+		var payload map[string]string
+		if opts.ClientPayload == nil || json.Unmarshal(*opts.ClientPayload, &payload) != nil {
+			return nil, nil, errors.New("invalid client payload")
+		}
+		if owner != org || name != repo || opts.EventType != eventType || payload["environment"] != "production" {
+			return nil, nil, errors.New("unexpected dispatch")
+		}
+		return nil, nil, nil
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		o          managed.ExternalCreation
+		generation string
+		err        error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.RepositoryDispatch
+		want   want
+	}{
+		"Dispatched": {
+			reason: "Create should dispatch the event and record the dispatched generation.",
+			github: &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{MockDispatch: mockDispatch(nil)},
+			},
+			cr: repositoryDispatch(),
+			want: want{
+				generation: "1",
+			},
+		},
+		"DispatchError": {
+			reason: "Errors dispatching the event should be returned.",
+			github: &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{MockDispatch: mockDispatch(errBoom)},
+			},
+			cr: repositoryDispatch(),
+			want: want{
+				err: errors.Wrap(errBoom, errDispatch),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.generation, tc.cr.GetAnnotations()[AnnotationKeyDispatchedGeneration]); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want generation, +got generation:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	type want struct {
+		o                    managed.ExternalUpdate
+		dispatchedGeneration *int64
+		err                  error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.RepositoryDispatch
+		want   want
+	}{
+		"Dispatched": {
+			reason: "Update should dispatch the event again and record the new generation in the status.",
+			github: &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{MockDispatch: mockDispatch(nil)},
+			},
+			cr: repositoryDispatch(withGeneration(2), withDispatchAnnotations("1")),
+			want: want{
+				dispatchedGeneration: int64Ptr(2),
+			},
+		},
+		"DispatchError": {
+			reason: "Errors dispatching the event should be returned.",
+			github: &ghclient.Client{
+				Repositories: &fake.MockRepositoriesClient{MockDispatch: mockDispatch(errBoom)},
+			},
+			cr: repositoryDispatch(withGeneration(2), withDispatchedGeneration(1)),
+			want: want{
+				dispatchedGeneration: int64Ptr(1),
+				err:                  errors.Wrap(errBoom, errDispatch),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Update(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.dispatchedGeneration, tc.cr.Status.AtProvider.DispatchedGeneration); diff != "" {
+				t.Errorf("\n%s\ne.Update(...): -want dispatchedGeneration, +got dispatchedGeneration:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
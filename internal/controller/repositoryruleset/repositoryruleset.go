@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryruleset
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+	"github.com/crossplane/provider-github/internal/util"
+)
+
+const (
+	errNotRepositoryRuleset = "managed resource is not a RepositoryRuleset custom resource"
+	errTrackPCUsage         = "cannot track ProviderConfig usage"
+	errGetPC                = "cannot get ProviderConfig"
+	errGetCreds             = "cannot get credentials"
+	errNewClient            = "cannot create new Service"
+	errListRulesets         = "cannot list rulesets"
+	errGetRuleset           = "cannot get ruleset"
+	errCreateRuleset        = "cannot create ruleset"
+	errUpdateRuleset        = "cannot update ruleset"
+	errDeleteRuleset        = "cannot delete ruleset"
+	errInvalidID            = "external name %q is not a ruleset ID"
+
+	// sourceTypeOrganization is the source type of the rulesets of the
+	// organization, which apply to its repositories but aren't theirs.
+	sourceTypeOrganization = "Organization"
+)
+
+// Setup adds a controller that reconciles RepositoryRuleset managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.RepositoryRulesetGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.RepositoryRulesetGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		// The external name is the ID GitHub gives the ruleset, which is
+		// looked up by name rather than set from the name of the
+		// RepositoryRuleset.
+		managed.WithInitializers(),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.RepositoryRuleset{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.RepositoryRulesetList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.RepositoryRulesetGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRuleset)
+	if !ok {
+		return nil, errors.New(errNotRepositoryRuleset)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.RepositoryRulesetKind, &external{github: gh}))))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+// rulesetID returns the ID of the ruleset of cr, and false if it was not
+// created or imported yet.
+func rulesetID(cr *v1alpha1.RepositoryRuleset) (int64, bool, error) {
+	name := meta.GetExternalName(cr)
+	if name == "" {
+		return 0, false, nil
+	}
+	id, err := strconv.ParseInt(name, 10, 64)
+	if err != nil {
+		return 0, false, errors.Errorf(errInvalidID, name)
+	}
+	return id, true, nil
+}
+
+// findRuleset returns the ruleset of the repository with the ID id, or the one
+// called name if there is none, e.g. because the ruleset is yet to be
+// imported. The rulesets of the organization are never returned.
+func findRuleset(rulesets []*github.Ruleset, id int64, name string) *github.Ruleset {
+	var byName *github.Ruleset
+	for _, rs := range rulesets {
+		if rs.GetSourceType() == sourceTypeOrganization {
+			continue
+		}
+		if id != 0 && rs.GetID() == id {
+			return rs
+		}
+		if rs.Name == name && byName == nil {
+			byName = rs
+		}
+	}
+	return byName
+}
+
+// desired returns the normalized ruleset of p, with the teams, apps and
+// repositories it references by name resolved to their IDs.
+func (c *external) desired(ctx context.Context, p v1alpha1.RepositoryRulesetParameters) (v1alpha1.Ruleset, error) {
+	rulesets := util.NormalizeRulesets([]v1alpha1.Ruleset{p.Ruleset})
+	if err := util.ResolveRulesetReferences(ctx, c.github, p.Org, p.Repo, rulesets); err != nil {
+		return v1alpha1.Ruleset{}, err
+	}
+	return rulesets[p.Name], nil
+}
+
+// validate returns an error listing the problems of a ruleset.
+func validate(rs v1alpha1.Ruleset) error {
+	if problems := util.ValidateRuleset(rs); len(problems) > 0 {
+		return errors.New(strings.Join(problems, ", "))
+	}
+	return nil
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRuleset)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotRepositoryRuleset)
+	}
+
+	id, _, err := rulesetID(cr)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	p := cr.Spec.ForProvider
+	rulesets, err := util.ListRulesets(ctx, c.github, p.Org, p.Repo)
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errListRulesets)
+	}
+	rs := findRuleset(rulesets, id, p.Name)
+	if rs == nil {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	// The ruleset was imported by name.
+	lateInitialized := rs.GetID() != id
+	meta.SetExternalName(cr, strconv.FormatInt(rs.GetID(), 10))
+	cr.Status.AtProvider.ID = rs.GetID()
+	cr.SetConditions(xpv1.Available())
+
+	observed, err := util.RulesetsFromGitHub(ctx, c.github, p.Org, p.Repo, []*github.Ruleset{rs})
+	if err != nil {
+		return managed.ExternalObservation{}, errors.Wrap(err, errGetRuleset)
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	return managed.ExternalObservation{
+		ResourceExists:          true,
+		ResourceUpToDate:        cmp.Equal(desired, observed[rs.Name]),
+		ResourceLateInitialized: lateInitialized,
+	}, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRuleset)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotRepositoryRuleset)
+	}
+
+	p := cr.Spec.ForProvider
+	if err := validate(p.Ruleset); err != nil {
+		return managed.ExternalCreation{}, err
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
+
+	rs, _, err := c.github.Repositories.CreateRuleset(ctx, p.Org, p.Repo, util.RulesetToGitHub(desired))
+	if err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errCreateRuleset)
+	}
+	meta.SetExternalName(cr, strconv.FormatInt(rs.GetID(), 10))
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	cr, ok := mg.(*v1alpha1.RepositoryRuleset)
+	if !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotRepositoryRuleset)
+	}
+
+	id, _, err := rulesetID(cr)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	p := cr.Spec.ForProvider
+	if err := validate(p.Ruleset); err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	desired, err := c.desired(ctx, p)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+
+	_, _, err = c.github.Repositories.UpdateRuleset(ctx, p.Org, p.Repo, id, util.RulesetToGitHub(desired))
+	return managed.ExternalUpdate{}, errors.Wrap(err, errUpdateRuleset)
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.RepositoryRuleset)
+	if !ok {
+		return errors.New(errNotRepositoryRuleset)
+	}
+	cr.SetConditions(xpv1.Deleting())
+
+	id, ok, err := rulesetID(cr)
+	if err != nil || !ok {
+		return err
+	}
+	p := cr.Spec.ForProvider
+	_, err = c.github.Repositories.DeleteRuleset(ctx, p.Org, p.Repo, id)
+	if ghclient.Is404(err) {
+		return nil
+	}
+	return errors.Wrap(err, errDeleteRuleset)
+}
@@ -0,0 +1,328 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package repositoryruleset
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org                  = "test-org"
+	repo                 = "test-repo"
+	rulesetName          = "protect-main"
+	ruleID               = int64(42)
+	otherRuleID          = int64(43)
+	errNotFound          = &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}}
+	sourceTypeRepository = "Repository"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type rulesetModifier func(*v1alpha1.RepositoryRuleset)
+
+func rulesetResource(m ...rulesetModifier) *v1alpha1.RepositoryRuleset {
+	cr := &v1alpha1.RepositoryRuleset{}
+	cr.Spec.ForProvider = v1alpha1.RepositoryRulesetParameters{
+		Org:  org,
+		Repo: repo,
+		Ruleset: v1alpha1.Ruleset{
+			Name: rulesetName,
+			Conditions: &v1alpha1.RulesetConditions{
+				RefName: &v1alpha1.RulesetRefName{Include: []string{"refs/heads/main"}, Exclude: []string{}},
+			},
+			Rules: &v1alpha1.Rules{Deletion: pointer.Bool(true)},
+		},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withExternalName(name string) rulesetModifier {
+	return func(r *v1alpha1.RepositoryRuleset) {
+		meta.SetExternalName(r, name)
+	}
+}
+
+func githubRuleset(id int64, name string, rules ...*github.RepositoryRule) *github.Ruleset {
+	return &github.Ruleset{
+		ID:          pointer.Int64(id),
+		Name:        name,
+		Target:      pointer.String("branch"),
+		SourceType:  &sourceTypeRepository,
+		Enforcement: "active",
+		Conditions: &github.RulesetConditions{
+			RefName: &github.RulesetRefConditionParameters{Include: []string{"refs/heads/main"}, Exclude: []string{}},
+		},
+		Rules: rules,
+	}
+}
+
+func mockRepositories(rulesets ...*github.Ruleset) *fake.MockRepositoriesClient {
+	return &fake.MockRepositoriesClient{
+		MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+			return rulesets, fake.GenerateEmptyResponse(), nil
+		},
+		MockGetRuleset: func(ctx context.Context, owner, repo string, id int64, includesParents bool) (*github.Ruleset, *github.Response, error) {
+			for _, rs := range rulesets {
+				if rs.GetID() == id {
+					return rs, fake.GenerateEmptyResponse(), nil
+				}
+			}
+			return nil, nil, errNotFound
+		},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o            managed.ExternalObservation
+		externalName string
+		err          error
+	}
+
+	errBoom := errors.New("boom")
+	deletion := &github.RepositoryRule{Type: "deletion"}
+
+	cases := map[string]struct {
+		reason string
+		repos  *fake.MockRepositoriesClient
+		cr     *v1alpha1.RepositoryRuleset
+		want   want
+	}{
+		"NotFound": {
+			reason: "A RepositoryRuleset whose ruleset doesn't exist should be created.",
+			repos:  mockRepositories(githubRuleset(otherRuleID, "other", deletion)),
+			cr:     rulesetResource(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"OrganizationRuleset": {
+			reason: "A RepositoryRuleset should not import a ruleset of the organization with its name.",
+			repos: mockRepositories(func() *github.Ruleset {
+				rs := githubRuleset(otherRuleID, rulesetName, deletion)
+				rs.SourceType = pointer.String(sourceTypeOrganization)
+				return rs
+			}()),
+			cr: rulesetResource(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"FoundByName": {
+			reason: "A RepositoryRuleset without an external name should take the ID of the ruleset with its name.",
+			repos:  mockRepositories(githubRuleset(ruleID, rulesetName, deletion)),
+			cr:     rulesetResource(),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true, ResourceLateInitialized: true},
+				externalName: "42",
+			},
+		},
+		"FoundByID": {
+			reason: "A renamed RepositoryRuleset should update the ruleset with its ID rather than the one with its name.",
+			repos:  mockRepositories(githubRuleset(otherRuleID, rulesetName, deletion), githubRuleset(ruleID, "old-name", deletion)),
+			cr:     rulesetResource(withExternalName("42")),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: "42",
+			},
+		},
+		"Differs": {
+			reason: "A RepositoryRuleset whose rules differ from the ruleset should be updated.",
+			repos:  mockRepositories(githubRuleset(ruleID, rulesetName, &github.RepositoryRule{Type: "creation"})),
+			cr:     rulesetResource(withExternalName("42")),
+			want: want{
+				o:            managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false},
+				externalName: "42",
+			},
+		},
+		"InvalidExternalName": {
+			reason: "An external name that isn't a ruleset ID should be reported.",
+			repos:  mockRepositories(),
+			cr:     rulesetResource(withExternalName(rulesetName)),
+			want: want{
+				externalName: rulesetName,
+				err:          errors.Errorf(errInvalidID, rulesetName),
+			},
+		},
+		"ListError": {
+			reason: "Errors listing the rulesets should be returned.",
+			repos: &fake.MockRepositoriesClient{
+				MockGetAllRulesets: func(ctx context.Context, owner, repo string) ([]*github.Ruleset, *github.Response, error) {
+					return nil, nil, errBoom
+				},
+			},
+			cr: rulesetResource(),
+			want: want{
+				err: errors.Wrap(errBoom, errListRulesets),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: &ghclient.Client{Repositories: tc.repos}}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		created      bool
+		externalName string
+		err          error
+	}
+
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.RepositoryRuleset
+		want   want
+	}{
+		"Created": {
+			reason: "A RepositoryRuleset should take the ID of the ruleset it creates.",
+			cr:     rulesetResource(),
+			want: want{
+				created:      true,
+				externalName: "42",
+			},
+		},
+		"Invalid": {
+			reason: "A RepositoryRuleset that fails validation should not be created.",
+			cr: rulesetResource(func(r *v1alpha1.RepositoryRuleset) {
+				r.Spec.ForProvider.Target = pointer.String("commit")
+			}),
+			want: want{
+				err: errors.New(`target must be one of [branch tag push], got "commit"`),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			created := false
+			e := external{github: &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+				MockCreateRuleset: func(ctx context.Context, owner, repo string, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+					created = true
+					ruleset.ID = pointer.Int64(ruleID)
+					return ruleset, fake.GenerateEmptyResponse(), nil
+				},
+			}}}
+			_, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.created, created); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want created, +got created:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.externalName, meta.GetExternalName(tc.cr)); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want external name, +got external name:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	var got int64
+	e := external{github: &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+		MockUpdateRuleset: func(ctx context.Context, owner, repo string, id int64, ruleset *github.Ruleset) (*github.Ruleset, *github.Response, error) {
+			got = id
+			return ruleset, fake.GenerateEmptyResponse(), nil
+		},
+	}}}
+	if _, err := e.Update(context.Background(), rulesetResource(withExternalName("42"))); err != nil {
+		t.Fatalf("e.Update(...): %v", err)
+	}
+	if diff := cmp.Diff(ruleID, got); diff != "" {
+		t.Errorf("\nA RepositoryRuleset should update its ruleset by its ID.\ne.Update(...): -want, +got:\n%s\n", diff)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		cr     *v1alpha1.RepositoryRuleset
+		err    error
+		want   []int64
+	}{
+		"Deleted": {
+			reason: "A RepositoryRuleset should delete its ruleset by its ID.",
+			cr:     rulesetResource(withExternalName("42")),
+			want:   []int64{ruleID},
+		},
+		"AlreadyDeleted": {
+			reason: "A RepositoryRuleset whose ruleset is gone is deleted.",
+			cr:     rulesetResource(withExternalName("42")),
+			err:    errNotFound,
+			want:   []int64{ruleID},
+		},
+		"NeverCreated": {
+			reason: "A RepositoryRuleset without an external name has no ruleset to delete.",
+			cr:     rulesetResource(),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got []int64
+			e := external{github: &ghclient.Client{Repositories: &fake.MockRepositoriesClient{
+				MockDeleteRuleset: func(ctx context.Context, owner, repo string, id int64) (*github.Response, error) {
+					got = append(got, id)
+					return nil, tc.err
+				},
+			}}}
+			if err := e.Delete(context.Background(), tc.cr); err != nil {
+				t.Fatalf("e.Delete(...): %v", err)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\ne.Delete(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package team
+
+import (
+	"context"
+	"sort"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errListIdPGroups   = "cannot list IdP groups of team"
+	errUpdateIdPGroups = "cannot update IdP groups of team"
+)
+
+// getIdPGroups returns the identity provider groups connected to a team,
+// sorted by ID.
+func getIdPGroups(ctx context.Context, gh *ghclient.Client, org, teamSlug string) ([]v1alpha1.TeamIdPGroup, error) {
+	l, _, err := gh.Teams.ListIDPGroupsForTeamBySlug(ctx, org, teamSlug)
+	if err != nil {
+		return nil, errors.Wrap(err, errListIdPGroups)
+	}
+
+	groups := make([]v1alpha1.TeamIdPGroup, 0, len(l.Groups))
+	for _, g := range l.Groups {
+		groups = append(groups, v1alpha1.TeamIdPGroup{
+			GroupID:          g.GetGroupID(),
+			GroupName:        g.GetGroupName(),
+			GroupDescription: g.GroupDescription,
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool { return groups[i].GroupID < groups[j].GroupID })
+	return groups, nil
+}
+
+// idpGroupsUpToDate returns whether the groups connected to a team are the
+// groups of the spec. Groups are identified by their ID, their names and
+// descriptions are only sent along to GitHub when connecting them.
+func idpGroupsUpToDate(desired, observed []v1alpha1.TeamIdPGroup) bool {
+	ids := make(map[string]bool, len(desired))
+	for _, g := range desired {
+		ids[g.GroupID] = true
+	}
+	if len(ids) != len(observed) {
+		return false
+	}
+	for _, g := range observed {
+		if !ids[g.GroupID] {
+			return false
+		}
+	}
+	return true
+}
+
+// updateIdPGroups connects the groups of the spec to a team, replacing the
+// groups that are connected to it.
+func updateIdPGroups(ctx context.Context, gh *ghclient.Client, org, teamSlug string, groups []v1alpha1.TeamIdPGroup) error {
+	req := github.IDPGroupList{Groups: make([]*github.IDPGroup, 0, len(groups))}
+	for _, g := range groups {
+		req.Groups = append(req.Groups, &github.IDPGroup{
+			GroupID:          pointer.String(g.GroupID),
+			GroupName:        pointer.String(g.GroupName),
+			GroupDescription: pointer.String(pointer.StringDeref(g.GroupDescription, "")),
+		})
+	}
+
+	_, _, err := gh.Teams.CreateOrUpdateIDPGroupConnectionsBySlug(ctx, org, teamSlug, req)
+	return errors.Wrap(err, errUpdateIdPGroups)
+}
@@ -21,10 +21,13 @@ import (
 	"reflect"
 
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/pointer"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	"github.com/google/go-github/v62/github"
 	"github.com/gosimple/slug"
@@ -40,9 +43,18 @@ import (
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
 	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/pause"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
 	"github.com/crossplane/provider-github/internal/util"
+	"github.com/crossplane/provider-github/internal/webhook"
 )
 
 const (
@@ -52,6 +64,9 @@ const (
 	errGetCreds     = "cannot get credentials"
 
 	errNewClient = "cannot create new Service"
+
+	errGetParentTeam      = "cannot get parent team %s"
+	errParentTeamNotFound = "parent team %s does not exist yet"
 )
 
 // Setup adds a controller that reconciles Team managed resources.
@@ -63,29 +78,34 @@ func Setup(mgr ctrl.Manager, o controller.Options) error {
 		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
 	}
 
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
 	r := managed.NewReconciler(mgr,
 		resource.ManagedKind(v1alpha1.TeamGroupVersionKind),
 		managed.WithExternalConnecter(&connector{
 			kube:        mgr.GetClient(),
 			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
 			newClientFn: ghclient.NewClient}),
 		managed.WithLogger(o.Logger.WithValues("controller", name)),
 		managed.WithPollInterval(o.PollInterval),
-		managed.WithRecorder(event.NewAPIRecorder(mgr.GetEventRecorderFor(name))),
+		managed.WithRecorder(recorder),
 		managed.WithConnectionPublishers(cps...))
 
 	return ctrl.NewControllerManagedBy(mgr).
 		Named(name).
-		WithOptions(o.ForControllerRuntime()).
-		WithEventFilter(resource.DesiredStateChanged()).
-		For(&v1alpha1.Team{}).
-		Complete(ratelimiter.NewReconciler(name, r, o.GlobalRateLimiter))
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.Team{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.TeamList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		WatchesRawSource(webhook.Source(v1alpha1.TeamGroupVersionKind.GroupKind()), &handler.EnqueueRequestForObject{}).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.TeamGroupVersionKind), r), o.GlobalRateLimiter))
 }
 
 type connector struct {
 	kube        client.Client
 	usage       resource.Tracker
-	newClientFn func(string) (*ghclient.Client, error)
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
 }
 
 func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
@@ -109,12 +129,17 @@ func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.E
 		return nil, errors.Wrap(err, errGetCreds)
 	}
 
-	gh, err := c.newClientFn(string(data))
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
 	if err != nil {
 		return nil, errors.Wrap(err, errNewClient)
 	}
 
-	return &external{github: gh}, nil
+	return dryrun.Guard(c.recorder, pause.Guard(throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.TeamKind, &external{github: gh}))))), nil
 }
 
 type external struct {
@@ -138,22 +163,39 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 		return managed.ExternalObservation{}, err
 	}
 
-	crMToPermission := getUserPermissionMapFromCr(cr.Spec.ForProvider.Members)
-	ghMToPermission, err := getMembersWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, teamSlug)
-	if err != nil {
-		return managed.ExternalObservation{}, err
+	// The members of a team connected to IdP groups are synchronized from
+	// the groups by GitHub.
+	membersUpToDate := true
+	if len(cr.Spec.ForProvider.IdPGroups) > 0 {
+		groups, err := getIdPGroups(ctx, c.github, cr.Spec.ForProvider.Org, teamSlug)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		cr.Status.AtProvider.IdPGroups = groups
+		membersUpToDate = idpGroupsUpToDate(cr.Spec.ForProvider.IdPGroups, groups)
+	} else {
+		cr.Status.AtProvider.IdPGroups = nil
+		crMToPermission := getUserPermissionMapFromCr(cr.Spec.ForProvider.Members)
+		ghMToPermission, err := getMembersWithPermissions(ctx, c.github, cr.Spec.ForProvider.Org, teamSlug)
+		if err != nil {
+			return managed.ExternalObservation{}, err
+		}
+		membersUpToDate = reflect.DeepEqual(util.SortByKey(ghMToPermission), util.SortByKey(crMToPermission))
 	}
 
 	crParentTeamSlug := slug.Make(pointer.StringDeref(cr.Spec.ForProvider.Parent, ""))
 	ghParentTeamSlug := ""
 	if t.Parent != nil {
-		ghParentTeamSlug = *t.Parent.Slug
+		ghParentTeamSlug = util.NormalizeName(*t.Parent.Slug)
 	}
+	cr.Status.AtProvider.ID = t.ID
+	cr.Status.AtProvider.Slug = t.GetSlug()
+	cr.Status.AtProvider.ParentSlug = ghParentTeamSlug
 
 	if crParentTeamSlug != ghParentTeamSlug ||
 		pointer.StringDeref(cr.Spec.ForProvider.Privacy, "secret") != *t.Privacy ||
 		cr.Spec.ForProvider.Description != *t.Description ||
-		!reflect.DeepEqual(util.SortByKey(ghMToPermission), util.SortByKey(crMToPermission)) {
+		!membersUpToDate {
 
 		return managed.ExternalObservation{
 			ResourceExists:   true,
@@ -169,11 +211,29 @@ func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.Ex
 	}, nil
 }
 
+// getParentTeamID returns the ID of the parent team named parent, or nil if
+// the team has no parent.
+func getParentTeamID(ctx context.Context, gh *ghclient.Client, org string, parent *string) (*int64, error) {
+	if parent == nil {
+		return nil, nil
+	}
+
+	parentSlug := slug.Make(*parent)
+	t, _, err := gh.Teams.GetTeamBySlug(ctx, org, parentSlug)
+	if ghclient.Is404(err) {
+		return nil, errors.Errorf(errParentTeamNotFound, parentSlug)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, errGetParentTeam, parentSlug)
+	}
+	return t.ID, nil
+}
+
 func getUserPermissionMapFromCr(users []v1alpha1.TeamMemberUser) map[string]string {
 	crMToPermission := make(map[string]string, len(users))
 
 	for _, user := range users {
-		crMToPermission[user.User] = user.Role
+		crMToPermission[util.NormalizeName(user.User)] = user.Role
 	}
 
 	return crMToPermission
@@ -184,25 +244,18 @@ func getMembersWithPermissions(ctx context.Context, gh *ghclient.Client, org, sl
 	roles := []string{"member", "maintainer"}
 
 	for _, role := range roles {
-		opt := &github.TeamListTeamMembersOptions{
-			Role:        role,
-			ListOptions: github.ListOptions{PerPage: 100},
+		members, err := ghclient.ListAll(func(page int) ([]*github.User, *github.Response, error) {
+			return gh.Teams.ListTeamMembersBySlug(ctx, org, slug, &github.TeamListTeamMembersOptions{
+				Role:        role,
+				ListOptions: github.ListOptions{PerPage: ghclient.PerPage, Page: page},
+			})
+		})
+		if err != nil {
+			return nil, err
 		}
 
-		for {
-			members, resp, err := gh.Teams.ListTeamMembersBySlug(ctx, org, slug, opt)
-			if err != nil {
-				return nil, err
-			}
-
-			for _, m := range members {
-				mToPermission[*m.Login] = role
-			}
-
-			if resp.NextPage == 0 {
-				break
-			}
-			opt.Page = resp.NextPage
+		for _, m := range members {
+			mToPermission[util.NormalizeName(*m.Login)] = role
 		}
 	}
 
@@ -219,17 +272,28 @@ func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.Ext
 	teamSlug := slug.Make(name)
 	privacy := pointer.StringDeref(cr.Spec.ForProvider.Privacy, "secret")
 
+	// A tree of teams applied at once is created from the root, children
+	// wait for their parent team to exist.
+	parentID, err := getParentTeamID(ctx, c.github, cr.Spec.ForProvider.Org, cr.Spec.ForProvider.Parent)
+	if err != nil {
+		return managed.ExternalCreation{}, err
+	}
 	t := github.NewTeam{
-		Name:        name,
-		Description: &cr.Spec.ForProvider.Description,
-		Privacy:     &privacy,
+		Name:         name,
+		Description:  &cr.Spec.ForProvider.Description,
+		Privacy:      &privacy,
+		ParentTeamID: parentID,
 	}
-	_, _, err := c.github.Teams.CreateTeam(ctx, cr.Spec.ForProvider.Org, t)
+	_, _, err = c.github.Teams.CreateTeam(ctx, cr.Spec.ForProvider.Org, t)
 	if err != nil {
 		return managed.ExternalCreation{}, err
 	}
 
-	if cr.Spec.ForProvider.Members != nil {
+	if len(cr.Spec.ForProvider.IdPGroups) > 0 {
+		if err := updateIdPGroups(ctx, c.github, cr.Spec.ForProvider.Org, teamSlug, cr.Spec.ForProvider.IdPGroups); err != nil {
+			return managed.ExternalCreation{}, err
+		}
+	} else if cr.Spec.ForProvider.Members != nil {
 		for _, user := range cr.Spec.ForProvider.Members {
 			opt := &github.TeamAddTeamMembershipOptions{
 				Role: user.Role,
@@ -283,32 +347,28 @@ func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.Ext
 	name := meta.GetExternalName(cr)
 	teamSlug := slug.Make(name)
 
-	err := updateTeamUsers(ctx, cr, c.github, teamSlug)
+	var err error
+	if len(cr.Spec.ForProvider.IdPGroups) > 0 {
+		err = updateIdPGroups(ctx, c.github, cr.Spec.ForProvider.Org, teamSlug, cr.Spec.ForProvider.IdPGroups)
+	} else {
+		err = updateTeamUsers(ctx, cr, c.github, teamSlug)
+	}
 	if err != nil {
 		return managed.ExternalUpdate{}, err
 	}
 
-	crParentTeamSlug := ""
-	parentT := &github.Team{}
-	removeParent := true
-
-	if cr.Spec.ForProvider.Parent != nil {
-		crParentTeamSlug = slug.Make(*cr.Spec.ForProvider.Parent)
-		parentT, _, err = c.github.Teams.GetTeamBySlug(ctx, cr.Spec.ForProvider.Org, crParentTeamSlug)
-		if err != nil {
-			return managed.ExternalUpdate{}, err
-		}
-		removeParent = false
+	parentID, err := getParentTeamID(ctx, c.github, cr.Spec.ForProvider.Org, cr.Spec.ForProvider.Parent)
+	if err != nil {
+		return managed.ExternalUpdate{}, err
 	}
+	removeParent := parentID == nil
 
 	privacy := pointer.StringDeref(cr.Spec.ForProvider.Privacy, "secret")
 	newTeam := github.NewTeam{
-		Name:        name,
-		Privacy:     &privacy,
-		Description: &cr.Spec.ForProvider.Description,
-	}
-	if !removeParent {
-		newTeam.ParentTeamID = parentT.ID
+		Name:         name,
+		Privacy:      &privacy,
+		Description:  &cr.Spec.ForProvider.Description,
+		ParentTeamID: parentID,
 	}
 
 	_, _, err = c.github.Teams.EditTeamBySlug(ctx, cr.Spec.ForProvider.Org, teamSlug, newTeam, removeParent)
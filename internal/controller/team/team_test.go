@@ -22,6 +22,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	ghclient "github.com/crossplane/provider-github/internal/clients"
@@ -59,6 +60,14 @@ type teamModifier func(*v1alpha1.Team)
 // 	}
 // }
 
+func withIdPGroups(ids ...string) teamModifier {
+	return func(r *v1alpha1.Team) {
+		for _, id := range ids {
+			r.Spec.ForProvider.IdPGroups = append(r.Spec.ForProvider.IdPGroups, v1alpha1.TeamIdPGroup{GroupID: id, GroupName: "group-" + id})
+		}
+	}
+}
+
 func team(m ...teamModifier) *v1alpha1.Team {
 	cr := &v1alpha1.Team{}
 
@@ -121,6 +130,60 @@ func TestObserve(t *testing.T) {
 		args   args
 		want   want
 	}{
+		"IdPGroupsUpToDate": {
+			reason: "A team connected to the IdP groups of the spec should be up to date, whatever its members are.",
+			fields: fields{
+				github: &ghclient.Client{
+					Teams: &fake.MockTeamsClient{
+						MockGetTeamBySlug: func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+							return &github.Team{
+								Privacy:     &teamPrivacy,
+								Description: &teamDescription,
+							}, nil, nil
+						},
+						MockListIDPGroupsForTeamBySlug: func(ctx context.Context, org, slug string) (*github.IDPGroupList, *github.Response, error) {
+							return &github.IDPGroupList{Groups: []*github.IDPGroup{{GroupID: github.String("b")}, {GroupID: github.String("a")}}}, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: team(withIdPGroups("a", "b")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: true,
+				},
+			},
+		},
+		"IdPGroupsNotUpToDate": {
+			reason: "A team connected to other IdP groups than the spec should not be up to date.",
+			fields: fields{
+				github: &ghclient.Client{
+					Teams: &fake.MockTeamsClient{
+						MockGetTeamBySlug: func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+							return &github.Team{
+								Privacy:     &teamPrivacy,
+								Description: &teamDescription,
+							}, nil, nil
+						},
+						MockListIDPGroupsForTeamBySlug: func(ctx context.Context, org, slug string) (*github.IDPGroupList, *github.Response, error) {
+							return &github.IDPGroupList{Groups: []*github.IDPGroup{{GroupID: github.String("a")}, {GroupID: github.String("c")}}}, nil, nil
+						},
+					},
+				},
+			},
+			args: args{
+				mg: team(withIdPGroups("a", "b")),
+			},
+			want: want{
+				o: managed.ExternalObservation{
+					ResourceExists:   true,
+					ResourceUpToDate: false,
+				},
+			},
+		},
 		"UpToDate": {
 			fields: fields{
 				github: &ghclient.Client{
@@ -163,3 +226,55 @@ func TestObserve(t *testing.T) {
 		})
 	}
 }
+
+func TestCreate(t *testing.T) {
+	parent := "parent-team"
+
+	cases := map[string]struct {
+		reason     string
+		parent     *github.Team
+		wantParent *int64
+		wantErr    error
+	}{
+		"WithParent": {
+			reason:     "A team should be created below its parent team.",
+			parent:     &github.Team{ID: github.Int64(42), Slug: &parent},
+			wantParent: github.Int64(42),
+		},
+		"ParentNotCreated": {
+			reason:  "A team whose parent team doesn't exist yet should not be created, so that it is retried once the parent exists.",
+			wantErr: errors.Errorf(errParentTeamNotFound, parent),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var got *int64
+			gh := &ghclient.Client{
+				Teams: &fake.MockTeamsClient{
+					MockGetTeamBySlug: func(ctx context.Context, org, slug string) (*github.Team, *github.Response, error) {
+						if tc.parent == nil {
+							return nil, nil, fake.Generate404Response()
+						}
+						return tc.parent, nil, nil
+					},
+					MockCreateTeam: func(ctx context.Context, org string, team github.NewTeam) (*github.Team, *github.Response, error) {
+						got = team.ParentTeamID
+						return &github.Team{}, nil, nil
+					},
+					MockAddTeamMembershipBySlug: func(ctx context.Context, org, slug, user string, opts *github.TeamAddTeamMembershipOptions) (*github.Membership, *github.Response, error) {
+						return &github.Membership{}, nil, nil
+					},
+				},
+			}
+			e := external{github: gh}
+			_, err := e.Create(context.Background(), team(func(r *v1alpha1.Team) { r.Spec.ForProvider.Parent = &parent }))
+			if diff := cmp.Diff(tc.wantErr, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.wantParent, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want parent team ID, +got parent team ID:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflowdispatch
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/connection"
+	"github.com/crossplane/crossplane-runtime/pkg/controller"
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/ratelimiter"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+	"github.com/crossplane/provider-github/internal/backoff"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/credentials"
+	"github.com/crossplane/provider-github/internal/dryrun"
+	"github.com/crossplane/provider-github/internal/features"
+	"github.com/crossplane/provider-github/internal/metrics"
+	"github.com/crossplane/provider-github/internal/permissions"
+	"github.com/crossplane/provider-github/internal/poll"
+	"github.com/crossplane/provider-github/internal/throttle"
+)
+
+const (
+	errNotWorkflowDispatch = "managed resource is not a WorkflowDispatch custom resource"
+	errTrackPCUsage        = "cannot track ProviderConfig usage"
+	errGetPC               = "cannot get ProviderConfig"
+	errGetCreds            = "cannot get credentials"
+	errNewClient           = "cannot create new Service"
+	errParseDispatchedAt   = "cannot parse dispatch time"
+	errListWorkflowRuns    = "cannot list workflow runs"
+	errGetWorkflowRun      = "cannot get workflow run"
+	errDispatchWorkflow    = "cannot dispatch workflow"
+
+	// AnnotationKeyDispatchedAt records when the workflow_dispatch event was
+	// sent. It is kept as an annotation rather than in the status, because only
+	// annotations are persisted after a successful Create.
+	AnnotationKeyDispatchedAt = "github.crossplane.io/dispatched-at"
+
+	eventWorkflowDispatch = "workflow_dispatch"
+	statusCompleted       = "completed"
+	conclusionSuccess     = "success"
+)
+
+// Setup adds a controller that reconciles WorkflowDispatch managed resources.
+func Setup(mgr ctrl.Manager, o controller.Options) error {
+	name := managed.ControllerName(v1alpha1.WorkflowDispatchGroupKind)
+
+	cps := []managed.ConnectionPublisher{managed.NewAPISecretPublisher(mgr.GetClient(), mgr.GetScheme())}
+	if o.Features.Enabled(features.EnableAlphaExternalSecretStores) {
+		cps = append(cps, connection.NewDetailsManager(mgr.GetClient(), apisv1alpha1.StoreConfigGroupVersionKind))
+	}
+
+	recorder := event.NewAPIRecorder(mgr.GetEventRecorderFor(name))
+
+	r := managed.NewReconciler(mgr,
+		resource.ManagedKind(v1alpha1.WorkflowDispatchGroupVersionKind),
+		managed.WithExternalConnecter(&connector{
+			kube:        mgr.GetClient(),
+			usage:       resource.NewProviderConfigUsageTracker(mgr.GetClient(), &apisv1alpha1.ProviderConfigUsage{}),
+			recorder:    recorder,
+			newClientFn: ghclient.NewClient}),
+		managed.WithLogger(o.Logger.WithValues("controller", name)),
+		managed.WithPollInterval(o.PollInterval),
+		managed.WithRecorder(recorder),
+		managed.WithConnectionPublishers(cps...))
+
+	return ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		WithOptions(backoff.ForControllerRuntime(o)).
+		For(&v1alpha1.WorkflowDispatch{}, builder.WithPredicates(resource.DesiredStateChanged())).
+		Watches(&corev1.Secret{}, credentials.EnqueueRequestsForSecret(mgr.GetClient(), &v1alpha1.WorkflowDispatchList{}, o.Logger.WithValues("controller", name)), builder.WithPredicates(credentials.Rotated())).
+		Complete(ratelimiter.NewReconciler(name, poll.NewReconciler(mgr, resource.ManagedKind(v1alpha1.WorkflowDispatchGroupVersionKind), r), o.GlobalRateLimiter))
+}
+
+type connector struct {
+	kube        client.Client
+	usage       resource.Tracker
+	recorder    event.Recorder
+	newClientFn func(string, ...ghclient.Option) (*ghclient.Client, error)
+}
+
+func (c *connector) Connect(ctx context.Context, mg resource.Managed) (managed.ExternalClient, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowDispatch)
+	if !ok {
+		return nil, errors.New(errNotWorkflowDispatch)
+	}
+
+	if err := c.usage.Track(ctx, mg); err != nil {
+		return nil, errors.Wrap(err, errTrackPCUsage)
+	}
+
+	pc := &apisv1alpha1.ProviderConfig{}
+	if err := c.kube.Get(ctx, types.NamespacedName{Name: cr.GetProviderConfigReference().Name}, pc); err != nil {
+		return nil, errors.Wrap(err, errGetPC)
+	}
+
+	cd := pc.Spec.Credentials
+	data, err := resource.CommonCredentialExtractor(ctx, cd.Source, c.kube, cd.CommonCredentialSelectors)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	pool, err := ghclient.ExtractCredentialsPool(ctx, c.kube, pc)
+	if err != nil {
+		return nil, errors.Wrap(err, errGetCreds)
+	}
+
+	gh, err := c.newClientFn(string(data), ghclient.WithProviderConfig(pc), ghclient.WithCredentialsPool(pool))
+	if err != nil {
+		return nil, errors.Wrap(err, errNewClient)
+	}
+
+	return dryrun.Guard(c.recorder, throttle.Guard(gh, permissions.Guard(metrics.Instrument(v1alpha1.WorkflowDispatchKind, &external{github: gh})))), nil
+}
+
+type external struct {
+	github *ghclient.Client
+}
+
+func (c *external) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowDispatch)
+	if !ok {
+		return managed.ExternalObservation{}, errors.New(errNotWorkflowDispatch)
+	}
+
+	// A dispatched run can't be undone, so report it as gone once it is deleted.
+	if meta.WasDeleted(cr) {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	dispatchedAt := cr.GetAnnotations()[AnnotationKeyDispatchedAt]
+	if dispatchedAt == "" {
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+
+	p := cr.Spec.ForProvider
+	run, err := c.getWorkflowRun(ctx, cr, dispatchedAt)
+	if err != nil {
+		return managed.ExternalObservation{}, err
+	}
+
+	if run != nil {
+		cr.Status.AtProvider = v1alpha1.WorkflowDispatchObservation{
+			RunID:      run.ID,
+			Status:     run.Status,
+			Conclusion: run.Conclusion,
+			HTMLURL:    run.HTMLURL,
+		}
+	}
+
+	switch {
+	case run == nil || run.GetStatus() != statusCompleted:
+		cr.SetConditions(xpv1.Creating())
+	case run.GetConclusion() == conclusionSuccess:
+		cr.SetConditions(xpv1.Available())
+	default:
+		cr.SetConditions(xpv1.Unavailable().WithMessage(fmt.Sprintf("workflow %s concluded with %s", p.Workflow, run.GetConclusion())))
+	}
+
+	// A dispatch is fire-once, it is never updated after it was sent.
+	return managed.ExternalObservation{
+		ResourceExists:   true,
+		ResourceUpToDate: true,
+	}, nil
+}
+
+// getWorkflowRun returns the workflow run started by the dispatch, or nil if
+// GitHub has not started it yet. The run is looked up by the time it was
+// dispatched until its ID is known.
+func (c *external) getWorkflowRun(ctx context.Context, cr *v1alpha1.WorkflowDispatch, dispatchedAt string) (*github.WorkflowRun, error) {
+	p := cr.Spec.ForProvider
+
+	if id := cr.Status.AtProvider.RunID; id != nil {
+		run, _, err := c.github.Actions.GetWorkflowRunByID(ctx, p.Org, p.Repo, *id)
+		return run, errors.Wrap(err, errGetWorkflowRun)
+	}
+
+	t, err := time.Parse(time.RFC3339, dispatchedAt)
+	if err != nil {
+		return nil, errors.Wrap(err, errParseDispatchedAt)
+	}
+
+	runs, _, err := c.github.Actions.ListWorkflowRunsByFileName(ctx, p.Org, p.Repo, p.Workflow, &github.ListWorkflowRunsOptions{
+		Event:   eventWorkflowDispatch,
+		Created: ">=" + t.UTC().Format(time.RFC3339),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errListWorkflowRuns)
+	}
+
+	// The oldest run created since the dispatch is the one it started.
+	var run *github.WorkflowRun
+	for _, r := range runs.WorkflowRuns {
+		if run == nil || r.GetCreatedAt().Before(run.GetCreatedAt().Time) {
+			run = r
+		}
+	}
+	return run, nil
+}
+
+func (c *external) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	cr, ok := mg.(*v1alpha1.WorkflowDispatch)
+	if !ok {
+		return managed.ExternalCreation{}, errors.New(errNotWorkflowDispatch)
+	}
+
+	p := cr.Spec.ForProvider
+	event := github.CreateWorkflowDispatchEventRequest{Ref: p.Ref}
+	if p.Inputs != nil {
+		event.Inputs = make(map[string]interface{}, len(p.Inputs))
+		for k, v := range p.Inputs {
+			event.Inputs[k] = v
+		}
+	}
+
+	// GitHub timestamps have second precision, so truncate to not miss the run.
+	dispatchedAt := time.Now().UTC().Truncate(time.Second)
+	if _, err := c.github.Actions.CreateWorkflowDispatchEventByFileName(ctx, p.Org, p.Repo, p.Workflow, event); err != nil {
+		return managed.ExternalCreation{}, errors.Wrap(err, errDispatchWorkflow)
+	}
+
+	meta.AddAnnotations(cr, map[string]string{AnnotationKeyDispatchedAt: dispatchedAt.Format(time.RFC3339)})
+	cr.SetConditions(xpv1.Creating())
+
+	return managed.ExternalCreation{}, nil
+}
+
+func (c *external) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if _, ok := mg.(*v1alpha1.WorkflowDispatch); !ok {
+		return managed.ExternalUpdate{}, errors.New(errNotWorkflowDispatch)
+	}
+
+	return managed.ExternalUpdate{}, nil
+}
+
+func (c *external) Delete(ctx context.Context, mg resource.Managed) error {
+	cr, ok := mg.(*v1alpha1.WorkflowDispatch)
+	if !ok {
+		return errors.New(errNotWorkflowDispatch)
+	}
+	cr.Status.SetConditions(xpv1.Deleting())
+
+	return nil
+}
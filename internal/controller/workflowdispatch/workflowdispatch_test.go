@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workflowdispatch
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+	"github.com/crossplane/provider-github/internal/clients/fake"
+)
+
+var (
+	org                = "test-org"
+	repo               = "test-repo"
+	workflow           = "bootstrap.yaml"
+	ref                = "main"
+	dispatchedAt       = "2024-01-01T00:00:00Z"
+	runID        int64 = 42
+	otherRunID   int64 = 43
+	inProgress         = "in_progress"
+	completed          = "completed"
+	success            = "success"
+	failure            = "failure"
+)
+
+// Unlike many Kubernetes projects Crossplane does not use third party testing
+// libraries, per the common Go test review comments. Crossplane encourages the
+// use of table driven unit tests. The tests of the crossplane-runtime project
+// are representative of the testing style Crossplane encourages.
+//
+// https://github.com/golang/go/wiki/TestComments
+// https://github.com/crossplane/crossplane/blob/master/CONTRIBUTING.md#contributing-code
+
+type workflowDispatchModifier func(*v1alpha1.WorkflowDispatch)
+
+func workflowDispatch(m ...workflowDispatchModifier) *v1alpha1.WorkflowDispatch {
+	cr := &v1alpha1.WorkflowDispatch{}
+	cr.Spec.ForProvider = v1alpha1.WorkflowDispatchParameters{
+		Org:      org,
+		Repo:     repo,
+		Workflow: workflow,
+		Ref:      ref,
+		Inputs:   map[string]string{"environment": "production"},
+	}
+	for _, f := range m {
+		f(cr)
+	}
+	return cr
+}
+
+func withDispatched() workflowDispatchModifier {
+	return func(r *v1alpha1.WorkflowDispatch) {
+		meta.AddAnnotations(r, map[string]string{AnnotationKeyDispatchedAt: dispatchedAt})
+	}
+}
+
+func withRunID(id int64) workflowDispatchModifier {
+	return func(r *v1alpha1.WorkflowDispatch) {
+		r.Status.AtProvider.RunID = &id
+	}
+}
+
+func githubWorkflowRun(id int64, status, conclusion *string, created time.Time) *github.WorkflowRun {
+	return &github.WorkflowRun{
+		ID:         &id,
+		Status:     status,
+		Conclusion: conclusion,
+		CreatedAt:  &github.Timestamp{Time: created},
+	}
+}
+
+func TestObserve(t *testing.T) {
+	type want struct {
+		o     managed.ExternalObservation
+		runID *int64
+		ready bool
+		err   error
+	}
+
+	created, _ := time.Parse(time.RFC3339, dispatchedAt)
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.WorkflowDispatch
+		want   want
+	}{
+		"NotDispatched": {
+			reason: "A WorkflowDispatch without a dispatch time should be dispatched.",
+			github: &ghclient.Client{},
+			cr:     workflowDispatch(),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: false},
+			},
+		},
+		"RunNotStarted": {
+			reason: "A WorkflowDispatch should not be ready while GitHub has not started the run.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockListWorkflowRunsByFileName: func(ctx context.Context, owner, repo, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+						return &github.WorkflowRuns{}, nil, nil
+					},
+				},
+			},
+			cr: workflowDispatch(withDispatched()),
+			want: want{
+				o: managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+			},
+		},
+		"RunFound": {
+			reason: "The oldest run created since the dispatch should be tracked.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockListWorkflowRunsByFileName: func(ctx context.Context, owner, repo, workflowFileName string, opts *github.ListWorkflowRunsOptions) (*github.WorkflowRuns, *github.Response, error) {
+						if opts.Created != ">="+dispatchedAt || opts.Event != eventWorkflowDispatch {
+							return nil, nil, errBoom
+						}
+						return &github.WorkflowRuns{WorkflowRuns: []*github.WorkflowRun{
+							githubWorkflowRun(otherRunID, &inProgress, nil, created.Add(time.Minute)),
+							githubWorkflowRun(runID, &inProgress, nil, created),
+						}}, nil, nil
+					},
+				},
+			},
+			cr: workflowDispatch(withDispatched()),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				runID: &runID,
+			},
+		},
+		"RunSucceeded": {
+			reason: "A WorkflowDispatch should be ready once its run completed successfully.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockGetWorkflowRunByID: func(ctx context.Context, owner, repo string, id int64) (*github.WorkflowRun, *github.Response, error) {
+						return githubWorkflowRun(id, &completed, &success, created), nil, nil
+					},
+				},
+			},
+			cr: workflowDispatch(withDispatched(), withRunID(runID)),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				runID: &runID,
+				ready: true,
+			},
+		},
+		"RunFailed": {
+			reason: "A WorkflowDispatch should not be ready if its run failed.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockGetWorkflowRunByID: func(ctx context.Context, owner, repo string, id int64) (*github.WorkflowRun, *github.Response, error) {
+						return githubWorkflowRun(id, &completed, &failure, created), nil, nil
+					},
+				},
+			},
+			cr: workflowDispatch(withDispatched(), withRunID(runID)),
+			want: want{
+				o:     managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true},
+				runID: &runID,
+			},
+		},
+		"GetRunError": {
+			reason: "Errors getting the workflow run should be returned.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockGetWorkflowRunByID: func(ctx context.Context, owner, repo string, id int64) (*github.WorkflowRun, *github.Response, error) {
+						return nil, nil, errBoom
+					},
+				},
+			},
+			cr: workflowDispatch(withDispatched(), withRunID(runID)),
+			want: want{
+				runID: &runID,
+				err:   errors.Wrap(errBoom, errGetWorkflowRun),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Observe(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.runID, tc.cr.Status.AtProvider.RunID); diff != "" {
+				t.Errorf("\n%s\ne.Observe(...): -want runID, +got runID:\n%s\n", tc.reason, diff)
+			}
+			if ready := tc.cr.GetCondition(xpv1.TypeReady).Equal(xpv1.Available()); ready != tc.want.ready {
+				t.Errorf("\n%s\ne.Observe(...): want ready %t, got %t\n", tc.reason, tc.want.ready, ready)
+			}
+		})
+	}
+}
+
+func TestCreate(t *testing.T) {
+	type want struct {
+		o          managed.ExternalCreation
+		dispatched bool
+		err        error
+	}
+
+	errBoom := errors.New("boom")
+
+	cases := map[string]struct {
+		reason string
+		github *ghclient.Client
+		cr     *v1alpha1.WorkflowDispatch
+		want   want
+	}{
+		"Dispatched": {
+			reason: "Create should dispatch the workflow and record the dispatch time.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockCreateWorkflowDispatchEventByFileName: func(ctx context.Context, owner, repo, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error) {
+						if workflowFileName != workflow || event.Ref != ref || event.Inputs["environment"] != "production" {
+							return nil, errBoom
+						}
+						return nil, nil
+					},
+				},
+			},
+			cr: workflowDispatch(),
+			want: want{
+				dispatched: true,
+			},
+		},
+		"DispatchError": {
+			reason: "Errors dispatching the workflow should be returned.",
+			github: &ghclient.Client{
+				Actions: &fake.MockActionsClient{
+					MockCreateWorkflowDispatchEventByFileName: func(ctx context.Context, owner, repo, workflowFileName string, event github.CreateWorkflowDispatchEventRequest) (*github.Response, error) {
+						return nil, errBoom
+					},
+				},
+			},
+			cr: workflowDispatch(),
+			want: want{
+				err: errors.Wrap(errBoom, errDispatchWorkflow),
+			},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			e := external{github: tc.github}
+			got, err := e.Create(context.Background(), tc.cr)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, got); diff != "" {
+				t.Errorf("\n%s\ne.Create(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if dispatched := tc.cr.GetAnnotations()[AnnotationKeyDispatchedAt] != ""; dispatched != tc.want.dispatched {
+				t.Errorf("\n%s\ne.Create(...): want dispatched %t, got %t\n", tc.reason, tc.want.dispatched, dispatched)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package credentials triggers a reconcile of the managed resources whose
+// credentials Secret changed. The credentials are read whenever a managed
+// resource is reconciled, so this makes rotated credentials take effect right
+// away instead of at the next poll or retry.
+package credentials
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+)
+
+// Rotated only accepts updates of Secrets, since the Secrets that exist when
+// the provider starts are reconciled anyway.
+func Rotated() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// EnqueueRequestsForSecret returns an event handler that enqueues the managed
+// resources of list, e.g. a RepositoryList, whose ProviderConfig reads its
+// credentials or ones of its credentials pool from the Secret of the event.
+func EnqueueRequestsForSecret(kube client.Reader, list resource.ManagedList, log logging.Logger) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, s client.Object) []reconcile.Request {
+		pcs := &apisv1alpha1.ProviderConfigList{}
+		if err := kube.List(ctx, pcs); err != nil {
+			log.Debug("Cannot list ProviderConfigs of rotated credentials", "error", err)
+			return nil
+		}
+		names := make(map[string]bool)
+		for _, pc := range pcs.Items {
+			ref := pc.Spec.Credentials.SecretRef
+			if pc.Spec.Credentials.Source == xpv1.CredentialsSourceSecret && ref != nil && isSecret(ref.SecretReference, s) {
+				names[pc.GetName()] = true
+			}
+			for _, ref := range pc.Spec.CredentialsPool {
+				if isSecret(ref.SecretReference, s) {
+					names[pc.GetName()] = true
+				}
+			}
+		}
+		if len(names) == 0 {
+			return nil
+		}
+
+		l, _ := list.DeepCopyObject().(resource.ManagedList)
+		if err := kube.List(ctx, l); err != nil {
+			log.Debug("Cannot list managed resources of rotated credentials", "error", err)
+			return nil
+		}
+		var reqs []reconcile.Request
+		for _, mg := range l.GetItems() {
+			if ref := mg.GetProviderConfigReference(); ref != nil && names[ref.Name] {
+				reqs = append(reqs, reconcile.Request{NamespacedName: types.NamespacedName{Name: mg.GetName()}})
+			}
+		}
+		log.Debug("Triggered reconciles for rotated credentials", "secret", s.GetNamespace()+"/"+s.GetName(), "resources", len(reqs))
+		return reqs
+	})
+}
+
+func isSecret(ref xpv1.SecretReference, s client.Object) bool {
+	return ref.Name == s.GetName() && ref.Namespace == s.GetNamespace()
+}
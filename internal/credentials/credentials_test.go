@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	apisv1alpha1 "github.com/crossplane/provider-github/apis/v1alpha1"
+)
+
+func secretRef(namespace, name string) xpv1.SecretKeySelector {
+	return xpv1.SecretKeySelector{SecretReference: xpv1.SecretReference{Namespace: namespace, Name: name}, Key: "credentials"}
+}
+
+func providerConfig(name string, source xpv1.CredentialsSource, ref *xpv1.SecretKeySelector, pool ...xpv1.SecretKeySelector) apisv1alpha1.ProviderConfig {
+	pc := apisv1alpha1.ProviderConfig{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	pc.Spec.Credentials.Source = source
+	pc.Spec.Credentials.SecretRef = ref
+	pc.Spec.CredentialsPool = pool
+	return pc
+}
+
+func repository(name, providerConfig string) v1alpha1.Repository {
+	cr := v1alpha1.Repository{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	cr.SetProviderConfigReference(&xpv1.Reference{Name: providerConfig})
+	return cr
+}
+
+func TestEnqueueRequestsForSecret(t *testing.T) {
+	errBoom := errors.New("boom")
+	rotated := secretRef("crossplane-system", "rotated")
+	other := secretRef("crossplane-system", "other")
+	otherNamespace := secretRef("default", "rotated")
+
+	pcs := []apisv1alpha1.ProviderConfig{
+		providerConfig("credentials", xpv1.CredentialsSourceSecret, &rotated),
+		providerConfig("pool", xpv1.CredentialsSourceSecret, &other, other, rotated),
+		providerConfig("other", xpv1.CredentialsSourceSecret, &other),
+		providerConfig("other-namespace", xpv1.CredentialsSourceSecret, &otherNamespace),
+		providerConfig("not-secret", xpv1.CredentialsSourceInjectedIdentity, &rotated),
+	}
+	repos := []v1alpha1.Repository{
+		repository("credentials-repo", "credentials"),
+		repository("pool-repo", "pool"),
+		repository("other-repo", "other"),
+		repository("other-namespace-repo", "other-namespace"),
+		repository("not-secret-repo", "not-secret"),
+	}
+
+	list := func(pcErr, mgErr error) client.Reader {
+		return &test.MockClient{MockList: func(_ context.Context, l client.ObjectList, _ ...client.ListOption) error {
+			switch l := l.(type) {
+			case *apisv1alpha1.ProviderConfigList:
+				l.Items = pcs
+				return pcErr
+			case *v1alpha1.RepositoryList:
+				l.Items = repos
+				return mgErr
+			}
+			return nil
+		}}
+	}
+
+	cases := map[string]struct {
+		reason string
+		kube   client.Reader
+		secret xpv1.SecretKeySelector
+		want   []string
+	}{
+		"Rotated": {
+			reason: "The managed resources whose ProviderConfig reads its credentials or ones of its pool from the Secret should be enqueued.",
+			kube:   list(nil, nil),
+			secret: rotated,
+			want:   []string{"credentials-repo", "pool-repo"},
+		},
+		"Unreferenced": {
+			reason: "No managed resources should be enqueued for a Secret no ProviderConfig references.",
+			kube:   list(nil, nil),
+			secret: secretRef("crossplane-system", "unreferenced"),
+		},
+		"ListProviderConfigsError": {
+			reason: "No managed resources should be enqueued if the ProviderConfigs can't be listed.",
+			kube:   list(errBoom, nil),
+			secret: rotated,
+		},
+		"ListManagedError": {
+			reason: "No managed resources should be enqueued if the managed resources can't be listed.",
+			kube:   list(nil, errBoom),
+			secret: rotated,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			q := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer q.ShutDown()
+
+			s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: tc.secret.Namespace, Name: tc.secret.Name}}
+			h := EnqueueRequestsForSecret(tc.kube, &v1alpha1.RepositoryList{}, logging.NewNopLogger())
+			h.Update(context.Background(), event.UpdateEvent{ObjectOld: s, ObjectNew: s}, q)
+
+			var got []string
+			for q.Len() > 0 {
+				item, _ := q.Get()
+				got = append(got, item.(reconcile.Request).Name)
+				q.Done(item)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want enqueued, +got enqueued:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestRotated(t *testing.T) {
+	s := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "crossplane-system", Name: "rotated"}}
+	p := Rotated()
+	got := map[string]bool{
+		"Create":  p.Create(event.CreateEvent{Object: s}),
+		"Update":  p.Update(event.UpdateEvent{ObjectOld: s, ObjectNew: s}),
+		"Delete":  p.Delete(event.DeleteEvent{Object: s}),
+		"Generic": p.Generic(event.GenericEvent{Object: s}),
+	}
+	want := map[string]bool{"Create": false, "Update": true, "Delete": false, "Generic": false}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Rotated(): only updates of Secrets should be accepted: -want, +got:\n%s\n", diff)
+	}
+}
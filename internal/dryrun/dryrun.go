@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun allows the GitHub mutations of managed resources to be
+// recorded as events rather than executed, so that an organization can be
+// imported on trial before the provider enforces its managed resources.
+package dryrun
+
+import (
+	"context"
+	"strings"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationKeyDryRun records instead of executes the GitHub mutations
+	// of a managed resource if set to true, or executes them although the
+	// provider runs with --dry-run if set to false.
+	AnnotationKeyDryRun = "github.crossplane.io/dry-run"
+
+	reasonDryRun event.Reason = "DryRun"
+)
+
+// enabled is whether managed resources without the annotation are dry run.
+var enabled bool
+
+// Configure sets whether the managed resources of the controllers that are
+// connected afterwards are dry run unless annotated otherwise.
+func Configure(enable bool) {
+	enabled = enable
+}
+
+// IsDryRun returns whether the GitHub mutations of mg are only recorded.
+func IsDryRun(mg resource.Managed) bool {
+	switch strings.ToLower(mg.GetAnnotations()[AnnotationKeyDryRun]) {
+	case "true":
+		return true
+	case "false":
+		return false
+	}
+	return enabled
+}
+
+// Guard wraps an external client so that the creations, updates and
+// deletions of managed resources that are dry run are recorded as events by
+// rec instead. The resources are still observed. A deleted managed resource
+// is reported as gone, so that it can be removed without deleting anything on
+// GitHub.
+func Guard(rec event.Recorder, e managed.ExternalClient) managed.ExternalClient {
+	return &guarded{ExternalClient: e, recorder: rec}
+}
+
+type guarded struct {
+	managed.ExternalClient
+	recorder event.Recorder
+
+	// diff is the difference the last observation reported, which is what
+	// an update would change.
+	diff string
+}
+
+func (g *guarded) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := g.ExternalClient.Observe(ctx, mg)
+	if err != nil || !IsDryRun(mg) {
+		return o, err
+	}
+	g.diff = o.Diff
+	if meta.WasDeleted(mg) && o.ResourceExists {
+		g.record(mg, "would delete the external resource")
+		return managed.ExternalObservation{ResourceExists: false}, nil
+	}
+	return o, nil
+}
+
+func (g *guarded) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	if !IsDryRun(mg) {
+		return g.ExternalClient.Create(ctx, mg)
+	}
+	g.record(mg, "would create the external resource")
+	return managed.ExternalCreation{}, nil
+}
+
+func (g *guarded) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	if !IsDryRun(mg) {
+		return g.ExternalClient.Update(ctx, mg)
+	}
+	msg := "would update the external resource"
+	if g.diff != "" {
+		msg += ": " + g.diff
+	}
+	g.record(mg, msg)
+	return managed.ExternalUpdate{}, nil
+}
+
+func (g *guarded) Delete(ctx context.Context, mg resource.Managed) error {
+	if !IsDryRun(mg) {
+		return g.ExternalClient.Delete(ctx, mg)
+	}
+	g.record(mg, "would delete the external resource")
+	return nil
+}
+
+func (g *guarded) record(mg resource.Managed, msg string) {
+	g.recorder.Event(mg, event.Normal(reasonDryRun, "Dry run "+msg))
+}
@@ -0,0 +1,222 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/crossplane/crossplane-runtime/pkg/event"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+// recorder records the events it is sent.
+type recorder struct {
+	events []event.Event
+}
+
+func (r *recorder) Event(_ runtime.Object, e event.Event) {
+	r.events = append(r.events, e)
+}
+
+func (r *recorder) WithAnnotations(...string) event.Recorder {
+	return r
+}
+
+// external returns an external client that records the operations it is
+// called for into called.
+func external(called *[]string, o managed.ExternalObservation) managed.ExternalClient {
+	return &managed.ExternalClientFns{
+		ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+			*called = append(*called, "Observe")
+			return o, nil
+		},
+		CreateFn: func(context.Context, resource.Managed) (managed.ExternalCreation, error) {
+			*called = append(*called, "Create")
+			return managed.ExternalCreation{}, nil
+		},
+		UpdateFn: func(context.Context, resource.Managed) (managed.ExternalUpdate, error) {
+			*called = append(*called, "Update")
+			return managed.ExternalUpdate{}, nil
+		},
+		DeleteFn: func(context.Context, resource.Managed) error {
+			*called = append(*called, "Delete")
+			return nil
+		},
+	}
+}
+
+func repository(dryRun string, deleted bool) *v1alpha1.Repository {
+	cr := &v1alpha1.Repository{}
+	if dryRun != "" {
+		cr.SetAnnotations(map[string]string{AnnotationKeyDryRun: dryRun})
+	}
+	if deleted {
+		now := metav1.NewTime(time.Now())
+		cr.SetDeletionTimestamp(&now)
+	}
+	return cr
+}
+
+func TestIsDryRun(t *testing.T) {
+	cases := map[string]struct {
+		reason     string
+		enabled    bool
+		annotation string
+		want       bool
+	}{
+		"Disabled": {
+			reason: "Managed resources without annotation should not be dry run by default.",
+			want:   false,
+		},
+		"Enabled": {
+			reason:  "Managed resources without annotation should be dry run with --dry-run.",
+			enabled: true,
+			want:    true,
+		},
+		"Annotated": {
+			reason:     "Managed resources annotated with true should be dry run.",
+			annotation: "True",
+			want:       true,
+		},
+		"Excluded": {
+			reason:     "Managed resources annotated with false should not be dry run with --dry-run.",
+			enabled:    true,
+			annotation: "false",
+			want:       false,
+		},
+		"Invalid": {
+			reason:     "Managed resources with an invalid annotation should be dry run as configured.",
+			enabled:    true,
+			annotation: "maybe",
+			want:       true,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			defer Configure(false)
+			Configure(tc.enabled)
+			if diff := cmp.Diff(tc.want, IsDryRun(repository(tc.annotation, false))); diff != "" {
+				t.Errorf("\n%s\nIsDryRun(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: false, Diff: "description: old -> new"}
+
+	type want struct {
+		o      managed.ExternalObservation
+		called []string
+		events []string
+	}
+
+	cases := map[string]struct {
+		reason string
+		mg     *v1alpha1.Repository
+		do     func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation
+		want   want
+	}{
+		"Observe": {
+			reason: "Managed resources that are dry run should still be observed.",
+			mg:     repository("true", false),
+			do:     observe,
+			want:   want{o: exists, called: []string{"Observe"}},
+		},
+		"Create": {
+			reason: "The creation of managed resources that are dry run should be recorded instead.",
+			mg:     repository("true", false),
+			do: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation {
+				_, _ = e.Create(ctx, mg)
+				return managed.ExternalObservation{}
+			},
+			want: want{events: []string{"Dry run would create the external resource"}},
+		},
+		"Update": {
+			reason: "The update of managed resources that are dry run should be recorded with the difference observed instead.",
+			mg:     repository("true", false),
+			do: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation {
+				o := observe(ctx, e, mg)
+				_, _ = e.Update(ctx, mg)
+				return o
+			},
+			want: want{o: exists, called: []string{"Observe"}, events: []string{"Dry run would update the external resource: description: old -> new"}},
+		},
+		"Delete": {
+			reason: "The deletion of managed resources that are dry run should be recorded instead, and they should be reported as gone.",
+			mg:     repository("true", true),
+			do: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation {
+				o := observe(ctx, e, mg)
+				_ = e.Delete(ctx, mg)
+				return o
+			},
+			want: want{o: managed.ExternalObservation{}, called: []string{"Observe"}, events: []string{"Dry run would delete the external resource", "Dry run would delete the external resource"}},
+		},
+		"NotDryRun": {
+			reason: "The mutations of managed resources that aren't dry run should be executed.",
+			mg:     repository("false", true),
+			do: func(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation {
+				o := observe(ctx, e, mg)
+				_, _ = e.Create(ctx, mg)
+				_, _ = e.Update(ctx, mg)
+				_ = e.Delete(ctx, mg)
+				return o
+			},
+			want: want{o: exists, called: []string{"Observe", "Create", "Update", "Delete"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var called []string
+			rec := &recorder{}
+			o := tc.do(context.Background(), Guard(rec, external(&called, exists)), tc.mg)
+
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+			var events []string
+			for _, e := range rec.events {
+				if e.Type != event.TypeNormal || e.Reason != reasonDryRun {
+					t.Errorf("\n%s\nGuard(...): unexpected event %+v", tc.reason, e)
+				}
+				events = append(events, e.Message)
+			}
+			if diff := cmp.Diff(tc.want.events, events); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want events, +got events:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func observe(ctx context.Context, e managed.ExternalClient, mg resource.Managed) managed.ExternalObservation {
+	o, _ := e.Observe(ctx, mg)
+	return o
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics exposes Prometheus metrics about the outcome of the calls
+// the controllers make to GitHub.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+// Operations of an external client.
+const (
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationDelete = "delete"
+)
+
+// Results of an operation. Failures are split by the class of error returned
+// by GitHub, so that throttling can be told apart from invalid specs.
+const (
+	ResultSuccess    = "success"
+	ResultRateLimit  = "rate_limit"
+	ResultPermission = "permission"
+	ResultValidation = "validation"
+	ResultError      = "error"
+)
+
+var outcomes = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "provider_github",
+	Name:      "external_operations_total",
+	Help:      "Number of create, update and delete operations against GitHub by kind, operation and result.",
+}, []string{"kind", "operation", "result"})
+
+var conditionalRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "provider_github",
+	Name:      "conditional_requests_total",
+	Help:      "Number of GET requests to GitHub by whether a cached response was still current.",
+}, []string{"result"})
+
+// Results of a conditional request.
+const (
+	ResultCacheHit  = "hit"
+	ResultCacheMiss = "miss"
+)
+
+func init() {
+	metrics.Registry.MustRegister(outcomes, conditionalRequests)
+}
+
+// RecordConditionalRequest counts a GET request, as a hit if GitHub answered
+// that the cached response was not modified.
+func RecordConditionalRequest(hit bool) {
+	result := ResultCacheMiss
+	if hit {
+		result = ResultCacheHit
+	}
+	conditionalRequests.WithLabelValues(result).Inc()
+}
+
+// Record counts the outcome of operation on a managed resource of kind.
+func Record(kind, operation string, err error) {
+	outcomes.WithLabelValues(kind, operation, Result(err)).Inc()
+}
+
+// Result classifies the error returned by an operation.
+func Result(err error) string {
+	if err == nil {
+		return ResultSuccess
+	}
+
+	var rateLimitErr *github.RateLimitError
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &rateLimitErr) || errors.As(err, &abuseErr) {
+		return ResultRateLimit
+	}
+
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil {
+		switch errResp.Response.StatusCode {
+		case http.StatusUnauthorized, http.StatusForbidden:
+			return ResultPermission
+		case http.StatusUnprocessableEntity:
+			return ResultValidation
+		}
+	}
+
+	return ResultError
+}
+
+// Instrument wraps an external client so that the outcome of its create,
+// update and delete operations is recorded for kind.
+func Instrument(kind string, e managed.ExternalClient) managed.ExternalClient {
+	return &instrumented{kind: kind, ExternalClient: e}
+}
+
+type instrumented struct {
+	managed.ExternalClient
+	kind string
+}
+
+func (i *instrumented) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := i.ExternalClient.Create(ctx, mg)
+	Record(i.kind, OperationCreate, err)
+	return c, err
+}
+
+func (i *instrumented) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := i.ExternalClient.Update(ctx, mg)
+	Record(i.kind, OperationUpdate, err)
+	return u, err
+}
+
+func (i *instrumented) Delete(ctx context.Context, mg resource.Managed) error {
+	err := i.ExternalClient.Delete(ctx, mg)
+	Record(i.kind, OperationDelete, err)
+	return err
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+)
+
+func errStatus(status int) error {
+	return &github.ErrorResponse{Response: &http.Response{StatusCode: status}}
+}
+
+func response(status int) *http.Response {
+	return &http.Response{StatusCode: status, Request: &http.Request{Method: http.MethodPost, URL: &url.URL{}}}
+}
+
+func TestResult(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   string
+	}{
+		"Success": {
+			reason: "An operation without an error should succeed.",
+			want:   ResultSuccess,
+		},
+		"NotFound": {
+			reason: "A resource GitHub doesn't find is neither throttled nor refused, and should be another error.",
+			err:    errStatus(http.StatusNotFound),
+			want:   ResultError,
+		},
+		"RateLimited": {
+			reason: "A primary rate limit should be classified as a rate limit.",
+			err:    &github.RateLimitError{Response: response(http.StatusForbidden)},
+			want:   ResultRateLimit,
+		},
+		"SecondaryRateLimited": {
+			reason: "A secondary rate limit should be classified as a rate limit, even though GitHub answers it with 403 Forbidden.",
+			err:    errors.Wrap(&github.AbuseRateLimitError{Response: response(http.StatusForbidden)}, "cannot update"),
+			want:   ResultRateLimit,
+		},
+		"Forbidden": {
+			reason: "A request the credentials aren't allowed to make should be classified as a permission error.",
+			err:    errors.Wrap(errStatus(http.StatusForbidden), "cannot update"),
+			want:   ResultPermission,
+		},
+		"Unauthorized": {
+			reason: "Credentials GitHub doesn't accept should be classified as a permission error.",
+			err:    errStatus(http.StatusUnauthorized),
+			want:   ResultPermission,
+		},
+		"Validation": {
+			reason: "A request GitHub can't process should be classified as a validation error.",
+			err:    errStatus(http.StatusUnprocessableEntity),
+			want:   ResultValidation,
+		},
+		"Other": {
+			reason: "An error that isn't a GitHub response should be classified as another error.",
+			err:    errors.New("boom"),
+			want:   ResultError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Result(tc.err)); diff != "" {
+				t.Errorf("\n%s\nResult(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestInstrument(t *testing.T) {
+	errForbidden := errStatus(http.StatusForbidden)
+	errRateLimit := &github.RateLimitError{Response: response(http.StatusForbidden)}
+	errNotFound := errStatus(http.StatusNotFound)
+
+	cases := map[string]struct {
+		reason    string
+		operation string
+		err       error
+		want      string
+	}{
+		"CreateSuccess": {
+			reason:    "A successful create should be recorded as a success.",
+			operation: OperationCreate,
+			want:      ResultSuccess,
+		},
+		"CreateForbidden": {
+			reason:    "A create the credentials aren't allowed to make should be recorded as a permission error.",
+			operation: OperationCreate,
+			err:       errForbidden,
+			want:      ResultPermission,
+		},
+		"CreateRateLimited": {
+			reason:    "A throttled create should be recorded as a rate limit.",
+			operation: OperationCreate,
+			err:       errRateLimit,
+			want:      ResultRateLimit,
+		},
+		"UpdateSuccess": {
+			reason:    "A successful update should be recorded as a success.",
+			operation: OperationUpdate,
+			want:      ResultSuccess,
+		},
+		"UpdateNotFound": {
+			reason:    "An update of a resource GitHub doesn't find should be recorded as another error.",
+			operation: OperationUpdate,
+			err:       errNotFound,
+			want:      ResultError,
+		},
+		"UpdateRateLimited": {
+			reason:    "A throttled update should be recorded as a rate limit.",
+			operation: OperationUpdate,
+			err:       errRateLimit,
+			want:      ResultRateLimit,
+		},
+		"DeleteSuccess": {
+			reason:    "A successful delete should be recorded as a success.",
+			operation: OperationDelete,
+			want:      ResultSuccess,
+		},
+		"DeleteForbidden": {
+			reason:    "A delete the credentials aren't allowed to make should be recorded as a permission error.",
+			operation: OperationDelete,
+			err:       errForbidden,
+			want:      ResultPermission,
+		},
+		"DeleteOther": {
+			reason:    "A delete failing otherwise should be recorded as another error.",
+			operation: OperationDelete,
+			err:       errors.New("boom"),
+			want:      ResultError,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			// Every case records for a kind of its own, as the counters are
+			// shared by the whole process.
+			kind := "Test" + name
+			e := Instrument(kind, &managed.ExternalClientFns{
+				CreateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+					return managed.ExternalCreation{}, tc.err
+				},
+				UpdateFn: func(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+					return managed.ExternalUpdate{}, tc.err
+				},
+				DeleteFn: func(ctx context.Context, mg resource.Managed) error {
+					return tc.err
+				},
+			})
+
+			var err error
+			switch tc.operation {
+			case OperationCreate:
+				_, err = e.Create(context.Background(), nil)
+			case OperationUpdate:
+				_, err = e.Update(context.Background(), nil)
+			case OperationDelete:
+				err = e.Delete(context.Background(), nil)
+			}
+			if diff := cmp.Diff(tc.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\ne.%s(...): -want error, +got error:\n%s\n", tc.reason, tc.operation, diff)
+			}
+
+			for _, result := range []string{ResultSuccess, ResultRateLimit, ResultPermission, ResultValidation, ResultError} {
+				want := 0.0
+				if result == tc.want {
+					want = 1
+				}
+				if got := testutil.ToFloat64(outcomes.WithLabelValues(kind, tc.operation, result)); got != want {
+					t.Errorf("\n%s\ne.%s(...): want %v operations with result %s, got %v\n", tc.reason, tc.operation, want, result, got)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pause allows enforcement of a managed resource to be suspended
+// until a given time, so that manual changes made during an incident are not
+// reverted by the controller.
+package pause
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationKeyPauseUntil suspends updates of a managed resource until the
+	// RFC3339 time it is set to. The resource is still observed while paused.
+	AnnotationKeyPauseUntil = "github.crossplane.io/pause-until"
+
+	errParsePauseUntil = "cannot parse " + AnnotationKeyPauseUntil + " annotation"
+)
+
+// IsPaused returns whether updates of mg are paused at the given time.
+func IsPaused(mg resource.Managed, now time.Time) (bool, error) {
+	v := mg.GetAnnotations()[AnnotationKeyPauseUntil]
+	if v == "" {
+		return false, nil
+	}
+	until, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return false, errors.Wrap(err, errParsePauseUntil)
+	}
+	return now.Before(until), nil
+}
+
+// Guard wraps an external client so that its updates are skipped while the
+// managed resource is paused.
+func Guard(e managed.ExternalClient) managed.ExternalClient {
+	return &guarded{ExternalClient: e}
+}
+
+type guarded struct {
+	managed.ExternalClient
+}
+
+func (g *guarded) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	paused, err := IsPaused(mg, time.Now())
+	if err != nil {
+		return managed.ExternalUpdate{}, err
+	}
+	if paused {
+		return managed.ExternalUpdate{}, nil
+	}
+	return g.ExternalClient.Update(ctx, mg)
+}
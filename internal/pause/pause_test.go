@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pause
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+func pausedUntil(v string) *v1alpha1.Repository {
+	cr := &v1alpha1.Repository{}
+	if v != "" {
+		cr.SetAnnotations(map[string]string{AnnotationKeyPauseUntil: v})
+	}
+	return cr
+}
+
+func TestIsPaused(t *testing.T) {
+	now := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	type want struct {
+		paused bool
+		err    error
+	}
+
+	cases := map[string]struct {
+		reason     string
+		annotation string
+		want       want
+	}{
+		"NoAnnotation": {
+			reason: "Managed resources without annotation should not be paused.",
+			want:   want{paused: false},
+		},
+		"Future": {
+			reason:     "Managed resources should be paused until the time of the annotation.",
+			annotation: "2024-03-01T13:00:00Z",
+			want:       want{paused: true},
+		},
+		"Past": {
+			reason:     "Managed resources should not be paused after the time of the annotation.",
+			annotation: "2024-03-01T11:00:00Z",
+			want:       want{paused: false},
+		},
+		"Invalid": {
+			reason:     "An annotation that isn't an RFC3339 time should be returned as an error.",
+			annotation: "tomorrow",
+			want: want{err: errors.Wrap(&time.ParseError{
+				Layout: time.RFC3339, Value: "tomorrow", LayoutElem: "2006", ValueElem: "tomorrow",
+			}, errParsePauseUntil)},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			paused, err := IsPaused(pausedUntil(tc.annotation), now)
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nIsPaused(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.paused, paused); diff != "" {
+				t.Errorf("\n%s\nIsPaused(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestGuard(t *testing.T) {
+	type want struct {
+		err    bool
+		called []string
+	}
+
+	cases := map[string]struct {
+		reason     string
+		annotation string
+		want       want
+	}{
+		"Paused": {
+			reason:     "Updates of paused managed resources should be skipped, while they are still observed, created and deleted.",
+			annotation: time.Now().Add(time.Hour).Format(time.RFC3339),
+			want:       want{called: []string{"Observe", "Create", "Delete"}},
+		},
+		"NotPaused": {
+			reason:     "Updates of managed resources whose pause ended should be executed.",
+			annotation: time.Now().Add(-time.Hour).Format(time.RFC3339),
+			want:       want{called: []string{"Observe", "Create", "Update", "Delete"}},
+		},
+		"Invalid": {
+			reason:     "Updates of managed resources with an invalid annotation should fail rather than be executed.",
+			annotation: "tomorrow",
+			want:       want{err: true, called: []string{"Observe", "Create", "Delete"}},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			var called []string
+			e := Guard(&managed.ExternalClientFns{
+				ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+					called = append(called, "Observe")
+					return managed.ExternalObservation{ResourceExists: true}, nil
+				},
+				CreateFn: func(context.Context, resource.Managed) (managed.ExternalCreation, error) {
+					called = append(called, "Create")
+					return managed.ExternalCreation{}, nil
+				},
+				UpdateFn: func(context.Context, resource.Managed) (managed.ExternalUpdate, error) {
+					called = append(called, "Update")
+					return managed.ExternalUpdate{}, nil
+				},
+				DeleteFn: func(context.Context, resource.Managed) error {
+					called = append(called, "Delete")
+					return nil
+				},
+			})
+
+			ctx, mg := context.Background(), pausedUntil(tc.annotation)
+			_, _ = e.Observe(ctx, mg)
+			_, _ = e.Create(ctx, mg)
+			_, err := e.Update(ctx, mg)
+			_ = e.Delete(ctx, mg)
+
+			if diff := cmp.Diff(tc.want.err, err != nil); diff != "" {
+				t.Errorf("\n%s\nUpdate(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.called, called); diff != "" {
+				t.Errorf("\n%s\nGuard(...): -want calls, +got calls:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
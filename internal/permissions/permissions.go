@@ -0,0 +1,217 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package permissions allows a controller to skip the sub-resources its
+// credentials aren't allowed to manage, rather than failing the whole
+// reconcile because of one missing token scope or App permission.
+package permissions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+
+	xpv1 "github.com/crossplane/crossplane-runtime/apis/common/v1"
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+const (
+	// headerAcceptedScopes lists the OAuth scopes of which a classic token
+	// needs one for a request.
+	headerAcceptedScopes = "X-Accepted-OAuth-Scopes"
+	// headerScopes lists the OAuth scopes of a classic token.
+	headerScopes = "X-OAuth-Scopes"
+	// headerAcceptedPermissions lists the permissions a fine-grained token
+	// or App needs for a request, alternatives separated by semicolons.
+	headerAcceptedPermissions = "X-Accepted-GitHub-Permissions"
+
+	// messageNotAccessible starts the message of the 403 Forbidden GitHub
+	// answers when an App or fine-grained token lacks a permission, e.g.
+	// "Resource not accessible by integration".
+	messageNotAccessible = "Resource not accessible by"
+)
+
+// IsMissing returns whether err is GitHub refusing a request because the
+// credentials lack the token scope or App permission it requires. Other
+// refusals, e.g. of changes to an archived repository, of credentials not
+// authorized for SSO or of blocked requests, are not missing permissions.
+func IsMissing(err error) bool {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil ||
+		errResp.Response.StatusCode != http.StatusForbidden {
+		return false
+	}
+	return strings.HasPrefix(errResp.Message, messageNotAccessible) ||
+		len(missingScopes(errResp.Response.Header)) > 0
+}
+
+// isHidden returns whether err is GitHub answering a change with 404 Not
+// Found because the credentials lack the permission it requires. GitHub hides
+// the resources that fine-grained tokens aren't allowed to change, but a 404
+// for reading a resource means it doesn't exist.
+func isHidden(err error) bool {
+	var errResp *github.ErrorResponse
+	return errors.As(err, &errResp) && errResp.Response != nil &&
+		errResp.Response.StatusCode == http.StatusNotFound &&
+		errResp.Response.Request != nil && errResp.Response.Request.Method != http.MethodGet &&
+		errResp.Response.Header.Get(headerAcceptedPermissions) != ""
+}
+
+// Needs returns what the credentials need for the request that failed with
+// err, e.g. "admin:org" or "members=write", or an empty string if GitHub
+// didn't say.
+func Needs(err error) string {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil {
+		return ""
+	}
+	h := errResp.Response.Header
+
+	if missing := missingScopes(h); len(missing) > 0 {
+		return strings.Join(missing, " or ")
+	}
+	return strings.Join(splitList(h.Get(headerAcceptedPermissions), ";"), " or ")
+}
+
+// missingScopes returns the OAuth scopes a classic token needs one of for a
+// request if it has none of them.
+func missingScopes(h http.Header) []string {
+	accepted := splitList(h.Get(headerAcceptedScopes), ",")
+	granted := splitList(h.Get(headerScopes), ",")
+	var missing []string
+	for _, s := range accepted {
+		if !contains(granted, s) {
+			missing = append(missing, s)
+		}
+	}
+	if len(missing) < len(accepted) {
+		return nil
+	}
+	return missing
+}
+
+func splitList(v, sep string) []string {
+	var l []string
+	for _, e := range strings.Split(v, sep) {
+		if e = strings.TrimSpace(e); e != "" {
+			l = append(l, e)
+		}
+	}
+	return l
+}
+
+func contains(l []string, v string) bool {
+	for _, e := range l {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Guard wraps an external client so that its calls failing because of missing
+// token scopes or permissions set the PermissionsSufficient condition of the
+// managed resource to what the credentials need, instead of leaving only a
+// generic reconcile error.
+func Guard(e managed.ExternalClient) managed.ExternalClient {
+	return &guarded{ExternalClient: e}
+}
+
+type guarded struct {
+	managed.ExternalClient
+}
+
+func (g *guarded) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	o, err := g.ExternalClient.Observe(ctx, mg)
+	// A resource that isn't up to date keeps its condition until it could be
+	// updated.
+	return o, classify(mg, err, err == nil && o.ResourceExists && !o.ResourceUpToDate)
+}
+
+func (g *guarded) Create(ctx context.Context, mg resource.Managed) (managed.ExternalCreation, error) {
+	c, err := g.ExternalClient.Create(ctx, mg)
+	return c, classify(mg, err, false)
+}
+
+func (g *guarded) Update(ctx context.Context, mg resource.Managed) (managed.ExternalUpdate, error) {
+	u, err := g.ExternalClient.Update(ctx, mg)
+	return u, classify(mg, err, false)
+}
+
+func (g *guarded) Delete(ctx context.Context, mg resource.Managed) error {
+	return classify(mg, g.ExternalClient.Delete(ctx, mg), false)
+}
+
+// classify sets the PermissionsSufficient condition of mg if err is caused by
+// missing token scopes or permissions, and clears it once a call succeeds
+// unless keep is set.
+func classify(mg resource.Managed, err error, keep bool) error {
+	if err == nil {
+		if !keep && mg.GetCondition(v1alpha1.TypePermissionsSufficient).Reason == v1alpha1.ReasonScopesInsufficient {
+			mg.SetConditions(v1alpha1.PermissionsSufficient())
+		}
+		return nil
+	}
+	if !IsMissing(err) && !isHidden(err) {
+		return err
+	}
+
+	msg := "missing token scopes or App permissions"
+	if needs := Needs(err); needs != "" {
+		msg = "needs " + needs
+	}
+	mg.SetConditions(v1alpha1.ScopesInsufficient(msg))
+	return fmt.Errorf("%s: %s: %w", v1alpha1.ReasonScopesInsufficient, msg, err)
+}
+
+// Skipped records the sub-resources of a managed resource that were skipped
+// because of missing permissions.
+type Skipped struct {
+	subResources []string
+}
+
+// Skip returns whether subResource has to be skipped because err is caused by
+// missing permissions, and err otherwise.
+func (s *Skipped) Skip(subResource string, err error) (bool, error) {
+	if !IsMissing(err) {
+		return false, err
+	}
+	if needs := Needs(err); needs != "" {
+		subResource += " (needs " + needs + ")"
+	}
+	for _, r := range s.subResources {
+		if r == subResource {
+			return true, nil
+		}
+	}
+	s.subResources = append(s.subResources, subResource)
+	return true, nil
+}
+
+// Condition returns the condition reporting the skipped sub-resources.
+func (s *Skipped) Condition() xpv1.Condition {
+	if len(s.subResources) == 0 {
+		return v1alpha1.PermissionsSufficient()
+	}
+	return v1alpha1.PermissionsMissing(fmt.Sprintf("skipped %s: missing token scopes or App permissions", strings.Join(s.subResources, ", ")))
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissions
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+)
+
+func forbidden(message string, header ...string) error {
+	h := http.Header{}
+	for i := 0; i+1 < len(header); i += 2 {
+		h.Set(header[i], header[i+1])
+	}
+	return &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusForbidden, Header: h}, Message: message}
+}
+
+func TestIsMissing(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   bool
+	}{
+		"NotAccessibleByIntegration": {
+			reason: "An App lacking a permission should be missing permissions.",
+			err:    forbidden("Resource not accessible by integration", headerAcceptedPermissions, "administration=write"),
+			want:   true,
+		},
+		"NotAccessibleByToken": {
+			reason: "A fine-grained token lacking a permission should be missing permissions.",
+			err:    forbidden("Resource not accessible by personal access token"),
+			want:   true,
+		},
+		"MissingScope": {
+			reason: "A classic token with none of the accepted scopes should be missing permissions.",
+			err:    forbidden("Must have admin rights to Repository.", headerAcceptedScopes, "admin:org", headerScopes, "repo"),
+			want:   true,
+		},
+		"GrantedScope": {
+			reason: "A classic token with one of the accepted scopes should not be missing permissions.",
+			err:    forbidden("Must have admin rights to Repository.", headerAcceptedScopes, "admin:org, write:org", headerScopes, "repo, write:org"),
+		},
+		"ArchivedRepository": {
+			reason: "A change refused because the repository is archived should not be missing permissions.",
+			err:    forbidden("Repository was archived so is read-only.", headerAcceptedPermissions, "contents=write"),
+		},
+		"SSOEnforced": {
+			reason: "A token that isn't authorized for SSO should not be missing permissions.",
+			err:    forbidden("Resource protected by organization SAML enforcement. You must grant your Personal Access token access to this organization."),
+		},
+		"BlockedRequest": {
+			reason: "A blocked request should not be missing permissions.",
+			err:    forbidden("Repository access blocked"),
+		},
+		"NotFound": {
+			reason: "A 404 should not be missing permissions.",
+			err:    &github.ErrorResponse{Response: &http.Response{StatusCode: http.StatusNotFound}, Message: "Resource not accessible by integration"},
+		},
+		"OtherError": {
+			reason: "An error that isn't a GitHub response should not be missing permissions.",
+			err:    errors.New("boom"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, IsMissing(tc.err)); diff != "" {
+				t.Errorf("\n%s\nIsMissing(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestNeeds(t *testing.T) {
+	cases := map[string]struct {
+		reason string
+		err    error
+		want   string
+	}{
+		"MissingScopes": {
+			reason: "The accepted scopes a classic token has none of should be needed.",
+			err:    forbidden("", headerAcceptedScopes, "admin:org, write:org", headerScopes, "repo"),
+			want:   "admin:org or write:org",
+		},
+		"AcceptedPermissions": {
+			reason: "The permissions accepted for an App or fine-grained token should be needed.",
+			err:    forbidden("Resource not accessible by integration", headerAcceptedPermissions, "members=write; administration=write"),
+			want:   "members=write or administration=write",
+		},
+		"Unknown": {
+			reason: "Nothing should be needed if GitHub didn't say.",
+			err:    forbidden("Resource not accessible by integration"),
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, Needs(tc.err)); diff != "" {
+				t.Errorf("\n%s\nNeeds(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package poll allows the poll interval of a managed resource to be set per
+// resource, and spreads the polls of resources that would otherwise be
+// observed in lockstep.
+package poll
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const (
+	// AnnotationKeyPollInterval overrides the poll interval of a managed
+	// resource with the duration it is set to, e.g. "10m".
+	AnnotationKeyPollInterval = "github.crossplane.io/poll-interval"
+
+	// jitter is the share of the poll interval by which a poll is moved
+	// forward or back at random.
+	jitter = 0.1
+)
+
+// Interval returns the poll interval of mg, or def if it doesn't override it.
+func Interval(mg client.Object, def time.Duration) time.Duration {
+	d, err := time.ParseDuration(mg.GetAnnotations()[AnnotationKeyPollInterval])
+	if err != nil || d <= 0 {
+		return def
+	}
+	return d
+}
+
+// Jitter returns d moved forward or back by up to a tenth at random.
+func Jitter(d time.Duration) time.Duration {
+	return d + time.Duration((rand.Float64()*2-1)*jitter*float64(d)) //nolint:gosec // Spreading polls needs no secure randomness.
+}
+
+// NewReconciler wraps the managed reconciler r of the managed resources of
+// kind, so that they are polled at their own interval with jitter.
+func NewReconciler(mgr ctrl.Manager, of resource.ManagedKind, r reconcile.Reconciler) reconcile.Reconciler {
+	return &reconciler{inner: r, kube: mgr.GetClient(), newManaged: func() (client.Object, error) {
+		o, err := mgr.GetScheme().New(schema.GroupVersionKind(of))
+		if err != nil {
+			return nil, err
+		}
+		return o.(client.Object), nil
+	}}
+}
+
+type reconciler struct {
+	inner      reconcile.Reconciler
+	kube       client.Reader
+	newManaged func() (client.Object, error)
+}
+
+// Reconcile reconciles the managed resource of req and reschedules its next
+// poll. The managed reconciler only requeues with a delay to poll.
+func (r *reconciler) Reconcile(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+	res, err := r.inner.Reconcile(ctx, req)
+	if err != nil || res.RequeueAfter <= 0 {
+		return res, err
+	}
+
+	interval := res.RequeueAfter
+	if mg, err := r.newManaged(); err == nil && r.kube.Get(ctx, req.NamespacedName, mg) == nil {
+		interval = Interval(mg, interval)
+	}
+	res.RequeueAfter = Jitter(interval)
+	return res, nil
+}
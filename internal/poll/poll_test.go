@@ -0,0 +1,193 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package poll
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+func withPollInterval(v string) *v1alpha1.Repository {
+	cr := &v1alpha1.Repository{}
+	if v != "" {
+		cr.SetAnnotations(map[string]string{AnnotationKeyPollInterval: v})
+	}
+	return cr
+}
+
+func TestInterval(t *testing.T) {
+	def := time.Minute
+
+	cases := map[string]struct {
+		reason     string
+		annotation string
+		want       time.Duration
+	}{
+		"NoAnnotation": {
+			reason: "The default poll interval should be used without annotation.",
+			want:   def,
+		},
+		"Minutes": {
+			reason:     "The poll interval of the annotation should be used.",
+			annotation: "10m",
+			want:       10 * time.Minute,
+		},
+		"Combined": {
+			reason:     "The poll interval of the annotation may combine units.",
+			annotation: "1h30m",
+			want:       90 * time.Minute,
+		},
+		"Invalid": {
+			reason:     "The default poll interval should be used if the annotation isn't a duration.",
+			annotation: "often",
+			want:       def,
+		},
+		"WithoutUnit": {
+			reason:     "The default poll interval should be used if the annotation has no unit.",
+			annotation: "600",
+			want:       def,
+		},
+		"Zero": {
+			reason:     "The default poll interval should be used if the annotation is zero.",
+			annotation: "0s",
+			want:       def,
+		},
+		"Negative": {
+			reason:     "The default poll interval should be used if the annotation is negative.",
+			annotation: "-5m",
+			want:       def,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			got := Interval(withPollInterval(tc.annotation), def)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("\n%s\nInterval(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	d := 10 * time.Minute
+	lower, upper := 9*time.Minute, 11*time.Minute
+	seen := map[time.Duration]bool{}
+	for i := 0; i < 1000; i++ {
+		got := Jitter(d)
+		if got < lower || got > upper {
+			t.Fatalf("Jitter(%s): %s should be within [%s, %s]", d, got, lower, upper)
+		}
+		seen[got] = true
+	}
+	if len(seen) < 2 {
+		t.Errorf("Jitter(%s): polls should be spread, got %v", d, seen)
+	}
+	if got := Jitter(0); got != 0 {
+		t.Errorf("Jitter(0): want 0, got %s", got)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	errBoom := errors.New("boom")
+
+	type args struct {
+		result reconcile.Result
+		err    error
+		kube   client.Reader
+	}
+	type want struct {
+		result       reconcile.Result
+		err          error
+		lower, upper time.Duration
+	}
+
+	getAnnotated := func(v string) client.Reader {
+		return &test.MockClient{MockGet: func(_ context.Context, _ client.ObjectKey, obj client.Object) error {
+			obj.SetAnnotations(withPollInterval(v).GetAnnotations())
+			return nil
+		}}
+	}
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"Error": {
+			reason: "Errors of the managed reconciler should be returned as is.",
+			args:   args{result: reconcile.Result{RequeueAfter: time.Minute}, err: errBoom, kube: getAnnotated("10m")},
+			want:   want{result: reconcile.Result{RequeueAfter: time.Minute}, err: errBoom},
+		},
+		"NoRequeueAfter": {
+			reason: "Results that don't poll should be returned as is.",
+			args:   args{result: reconcile.Result{Requeue: true}, kube: getAnnotated("10m")},
+			want:   want{result: reconcile.Result{Requeue: true}},
+		},
+		"Default": {
+			reason: "The poll interval of the managed reconciler should be jittered.",
+			args:   args{result: reconcile.Result{RequeueAfter: time.Minute}, kube: getAnnotated("")},
+			want:   want{lower: 54 * time.Second, upper: 66 * time.Second},
+		},
+		"Annotated": {
+			reason: "The poll interval of the annotation should be jittered.",
+			args:   args{result: reconcile.Result{RequeueAfter: time.Minute}, kube: getAnnotated("10m")},
+			want:   want{lower: 9 * time.Minute, upper: 11 * time.Minute},
+		},
+		"GetError": {
+			reason: "The poll interval of the managed reconciler should be jittered if the managed resource can't be read.",
+			args:   args{result: reconcile.Result{RequeueAfter: time.Minute}, kube: &test.MockClient{MockGet: test.NewMockGetFn(errBoom)}},
+			want:   want{lower: 54 * time.Second, upper: 66 * time.Second},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			r := &reconciler{
+				inner: reconcile.Func(func(context.Context, reconcile.Request) (reconcile.Result, error) {
+					return tc.args.result, tc.args.err
+				}),
+				kube:       tc.args.kube,
+				newManaged: func() (client.Object, error) { return &v1alpha1.Repository{}, nil },
+			}
+			got, err := r.Reconcile(context.Background(), reconcile.Request{NamespacedName: types.NamespacedName{Name: "repo"}})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nReconcile(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if tc.want.upper == 0 {
+				if diff := cmp.Diff(tc.want.result, got); diff != "" {
+					t.Errorf("\n%s\nReconcile(...): -want, +got:\n%s\n", tc.reason, diff)
+				}
+				return
+			}
+			if got.RequeueAfter < tc.want.lower || got.RequeueAfter > tc.want.upper {
+				t.Errorf("\n%s\nReconcile(...): RequeueAfter %s should be within [%s, %s]", tc.reason, got.RequeueAfter, tc.want.lower, tc.want.upper)
+			}
+		})
+	}
+}
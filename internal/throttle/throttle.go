@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package throttle backs off the reconciliation of managed resources while the
+// GitHub rate limit of their credentials is nearly used up, instead of running
+// into secondary rate limits and abuse blocks.
+package throttle
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+)
+
+const errThrottled = "GitHub rate limit is nearly used up, backing off until it resets at %s"
+
+// A Throttler reports whether requests should back off until a given time.
+type Throttler interface {
+	Throttled(now time.Time) (time.Time, bool)
+}
+
+// Guard wraps an external client so that it doesn't observe managed resources
+// while t is throttled. The error makes the managed reconciler requeue the
+// resource with backoff and is surfaced in its Synced condition.
+func Guard(t Throttler, e managed.ExternalClient) managed.ExternalClient {
+	return &guarded{ExternalClient: e, throttler: t}
+}
+
+type guarded struct {
+	managed.ExternalClient
+	throttler Throttler
+}
+
+func (g *guarded) Observe(ctx context.Context, mg resource.Managed) (managed.ExternalObservation, error) {
+	if reset, throttled := g.throttler.Throttled(time.Now()); throttled {
+		return managed.ExternalObservation{}, errors.Errorf(errThrottled, reset.Format(time.RFC3339))
+	}
+	return g.ExternalClient.Observe(ctx, mg)
+}
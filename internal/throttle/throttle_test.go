@@ -0,0 +1,91 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package throttle
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/crossplane/crossplane-runtime/pkg/reconciler/managed"
+	"github.com/crossplane/crossplane-runtime/pkg/resource"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+type throttler struct {
+	reset     time.Time
+	throttled bool
+}
+
+func (t throttler) Throttled(time.Time) (time.Time, bool) {
+	return t.reset, t.throttled
+}
+
+func TestGuard(t *testing.T) {
+	reset := time.Date(2024, 3, 1, 13, 0, 0, 0, time.UTC)
+	exists := managed.ExternalObservation{ResourceExists: true, ResourceUpToDate: true}
+
+	type want struct {
+		o        managed.ExternalObservation
+		err      error
+		observed bool
+	}
+
+	cases := map[string]struct {
+		reason    string
+		throttler Throttler
+		want      want
+	}{
+		"Throttled": {
+			reason:    "Managed resources should not be observed while their credentials are throttled.",
+			throttler: throttler{reset: reset, throttled: true},
+			want:      want{err: errors.Errorf(errThrottled, "2024-03-01T13:00:00Z")},
+		},
+		"NotThrottled": {
+			reason:    "Managed resources should be observed while their credentials aren't throttled.",
+			throttler: throttler{},
+			want:      want{o: exists, observed: true},
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			observed := false
+			e := Guard(tc.throttler, &managed.ExternalClientFns{
+				ObserveFn: func(context.Context, resource.Managed) (managed.ExternalObservation, error) {
+					observed = true
+					return exists, nil
+				},
+			})
+			o, err := e.Observe(context.Background(), &v1alpha1.Repository{})
+			if diff := cmp.Diff(tc.want.err, err, test.EquateErrors()); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want error, +got error:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.o, o); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want, +got:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.observed, observed); diff != "" {
+				t.Errorf("\n%s\nObserve(...): -want observed, +got observed:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"fmt"
+
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+// anyApp allows any app to set a required status check of a rule created
+// through the GraphQL API.
+const anyApp = "any"
+
+// NormalizeBranchProtectionRule returns a copy of a branch protection rule
+// with the defaults of its optional fields set and its lists sorted, so that it
+// can be compared with the rule GitHub has. The copy keeps the controller from
+// changing the spec of the live resource, which could cause infinite reconcile
+// loops when the resources are managed with e.g. ArgoCD.
+//
+//nolint:gocyclo
+func NormalizeBranchProtectionRule(rule v1alpha1.RepositoryBranchProtectionRule) v1alpha1.RepositoryBranchProtectionRule {
+	rCopy := rule.DeepCopy()
+
+	// handle optional *bool fields
+	rCopy.RequireLinearHistory = BoolDerefToPointer(rCopy.RequireLinearHistory, false)
+	rCopy.AllowForcePushes = BoolDerefToPointer(rCopy.AllowForcePushes, false)
+	rCopy.AllowDeletions = BoolDerefToPointer(rCopy.AllowDeletions, false)
+	rCopy.RequiredConversationResolution = BoolDerefToPointer(rCopy.RequiredConversationResolution, false)
+	rCopy.LockBranch = BoolDerefToPointer(rCopy.LockBranch, false)
+	rCopy.AllowForkSyncing = BoolDerefToPointer(rCopy.AllowForkSyncing, false)
+	rCopy.RequireSignedCommits = BoolDerefToPointer(rCopy.RequireSignedCommits, false)
+
+	if rCopy.RequiredStatusChecks != nil && rCopy.RequiredStatusChecks.Checks != nil {
+		copyOfStatusChecks := make([]*v1alpha1.RequiredStatusCheck, len(rCopy.RequiredStatusChecks.Checks))
+		copy(copyOfStatusChecks, rCopy.RequiredStatusChecks.Checks)
+		SortRequiredStatusChecks(copyOfStatusChecks)
+		rCopy.RequiredStatusChecks.Checks = copyOfStatusChecks
+	}
+
+	restr := rCopy.BranchProtectionRestrictions
+	if restr != nil {
+		restr.BlockCreations = BoolDerefToPointer(restr.BlockCreations, false)
+		if restr.Users != nil {
+			restr.Users = NormalizeNames(restr.Users)
+		}
+		if restr.Teams != nil {
+			restr.Teams = NormalizeNames(restr.Teams)
+		}
+		if restr.Apps != nil {
+			restr.Apps = NormalizeNames(restr.Apps)
+		}
+	}
+
+	rPRs := rCopy.RequiredPullRequestReviews
+	if rPRs != nil {
+		// handle optional *bool fields
+		rPRs.RequireLastPushApproval = BoolDerefToPointer(rPRs.RequireLastPushApproval, false)
+
+		allowances := rPRs.BypassPullRequestAllowances
+		if allowances != nil {
+			if allowances.Users != nil {
+				allowances.Users = NormalizeNames(allowances.Users)
+			}
+			if allowances.Teams != nil {
+				allowances.Teams = NormalizeNames(allowances.Teams)
+			}
+			if allowances.Apps != nil {
+				allowances.Apps = NormalizeNames(allowances.Apps)
+			}
+		}
+		dismissal := rPRs.DismissalRestrictions
+		if dismissal != nil {
+			if dismissal.Users != nil {
+				dismissal.Users = NormalizeNamesPointer(*dismissal.Users)
+			}
+			if dismissal.Teams != nil {
+				dismissal.Teams = NormalizeNamesPointer(*dismissal.Teams)
+			}
+			if dismissal.Apps != nil {
+				dismissal.Apps = NormalizeNamesPointer(*dismissal.Apps)
+			}
+		}
+	}
+
+	return *rCopy
+}
+
+// BranchProtectionRuleFromGraphQL converts a GraphQL branch protection rule
+// into the form NormalizeBranchProtectionRule normalizes rules to.
+func BranchProtectionRuleFromGraphQL(rule *ghclient.BranchProtectionRule) v1alpha1.RepositoryBranchProtectionRule {
+	bpr := v1alpha1.RepositoryBranchProtectionRule{
+		Branch:                         rule.Pattern,
+		EnforceAdmins:                  rule.IsAdminEnforced,
+		RequireLinearHistory:           ToBoolPtr(rule.RequiresLinearHistory),
+		AllowForcePushes:               ToBoolPtr(rule.AllowsForcePushes),
+		AllowDeletions:                 ToBoolPtr(rule.AllowsDeletions),
+		RequiredConversationResolution: ToBoolPtr(rule.RequiresConversationResolution),
+		LockBranch:                     ToBoolPtr(rule.LockBranch),
+		AllowForkSyncing:               ToBoolPtr(rule.LockAllowsFetchAndMerge),
+		RequireSignedCommits:           ToBoolPtr(rule.RequiresCommitSignatures),
+	}
+
+	if rule.RequiresStatusChecks {
+		bpr.RequiredStatusChecks = &v1alpha1.RequiredStatusChecks{
+			Strict: rule.RequiresStrictStatusChecks,
+		}
+		if len(rule.RequiredStatusChecks) > 0 {
+			checks := make([]*v1alpha1.RequiredStatusCheck, len(rule.RequiredStatusChecks))
+			for i, check := range rule.RequiredStatusChecks {
+				checks[i] = &v1alpha1.RequiredStatusCheck{Context: check.Context}
+				if check.App != nil {
+					checks[i].AppID = &check.App.DatabaseID
+				}
+			}
+			SortRequiredStatusChecks(checks)
+			bpr.RequiredStatusChecks.Checks = checks
+		}
+	}
+
+	if rule.RequiresApprovingReviews {
+		bpr.RequiredPullRequestReviews = &v1alpha1.RequiredPullRequestReviews{
+			DismissStaleReviews:          rule.DismissesStaleReviews,
+			RequireCodeOwnerReviews:      rule.RequiresCodeOwnerReviews,
+			RequiredApprovingReviewCount: rule.RequiredApprovingReviewCount,
+			RequireLastPushApproval:      ToBoolPtr(rule.RequireLastPushApproval),
+		}
+	}
+
+	if rule.RestrictsPushes {
+		bpr.BranchProtectionRestrictions = &v1alpha1.BranchProtectionRestrictions{
+			BlockCreations: ToBoolPtr(rule.BlocksCreations),
+		}
+	}
+
+	return bpr
+}
+
+// BranchProtectionRuleInput converts a branch protection rule into the input
+// of the GraphQL branch protection rule mutations.
+func BranchProtectionRuleInput(rule v1alpha1.RepositoryBranchProtectionRule) *ghclient.BranchProtectionRuleInput {
+	input := &ghclient.BranchProtectionRuleInput{
+		BranchProtectionRuleSettings: ghclient.BranchProtectionRuleSettings{
+			Pattern:                        rule.Branch,
+			IsAdminEnforced:                rule.EnforceAdmins,
+			RequiresLinearHistory:          pointer.BoolDeref(rule.RequireLinearHistory, false),
+			AllowsForcePushes:              pointer.BoolDeref(rule.AllowForcePushes, false),
+			AllowsDeletions:                pointer.BoolDeref(rule.AllowDeletions, false),
+			RequiresConversationResolution: pointer.BoolDeref(rule.RequiredConversationResolution, false),
+			LockBranch:                     pointer.BoolDeref(rule.LockBranch, false),
+			LockAllowsFetchAndMerge:        pointer.BoolDeref(rule.AllowForkSyncing, false),
+			RequiresCommitSignatures:       pointer.BoolDeref(rule.RequireSignedCommits, false),
+		},
+		RequiredStatusChecks: []ghclient.RequiredStatusCheckInput{},
+	}
+
+	if checks := rule.RequiredStatusChecks; checks != nil {
+		input.RequiresStatusChecks = true
+		input.RequiresStrictStatusChecks = checks.Strict
+		for _, check := range checks.Checks {
+			input.RequiredStatusChecks = append(input.RequiredStatusChecks, ghclient.RequiredStatusCheckInput{
+				Context: check.Context,
+				AppID:   anyApp,
+			})
+		}
+	}
+
+	if reviews := rule.RequiredPullRequestReviews; reviews != nil {
+		input.RequiresApprovingReviews = true
+		input.RequiredApprovingReviewCount = reviews.RequiredApprovingReviewCount
+		input.DismissesStaleReviews = reviews.DismissStaleReviews
+		input.RequiresCodeOwnerReviews = reviews.RequireCodeOwnerReviews
+		input.RequireLastPushApproval = pointer.BoolDeref(reviews.RequireLastPushApproval, false)
+	}
+
+	if restr := rule.BranchProtectionRestrictions; restr != nil {
+		input.RestrictsPushes = true
+		input.BlocksCreations = pointer.BoolDeref(restr.BlockCreations, false)
+	}
+
+	return input
+}
+
+// UnsupportedGraphQLSettings returns the problems of the settings of a branch
+// protection rule that the GraphQL API can't manage by name, for rules managed
+// through it. what names those rules in the problems.
+func UnsupportedGraphQLSettings(rule v1alpha1.RepositoryBranchProtectionRule, what string) []string {
+	var problems []string
+
+	if rule.RequiredStatusChecks != nil {
+		for _, check := range rule.RequiredStatusChecks.Checks {
+			if check != nil && check.AppID != nil {
+				problems = append(problems, fmt.Sprintf("required status check appId is not supported for %s", what))
+				break
+			}
+		}
+	}
+
+	if reviews := rule.RequiredPullRequestReviews; reviews != nil {
+		if reviews.DismissalRestrictions != nil {
+			problems = append(problems, fmt.Sprintf("dismissalRestrictions are not supported for %s", what))
+		}
+		if reviews.BypassPullRequestAllowances != nil {
+			problems = append(problems, fmt.Sprintf("bypassPullRequestAllowances are not supported for %s", what))
+		}
+	}
+
+	if restr := rule.BranchProtectionRestrictions; restr != nil {
+		if len(restr.Users) > 0 || len(restr.Teams) > 0 || len(restr.Apps) > 0 {
+			problems = append(problems, fmt.Sprintf("push restrictions for users, teams and apps are not supported for %s", what))
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,887 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"k8s.io/utils/pointer"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+	ghclient "github.com/crossplane/provider-github/internal/clients"
+)
+
+const (
+	errGetWorkflowRepository = "cannot get repository %s of required workflow"
+	errGetBypassTeam         = "cannot get bypass actor team %s"
+	errGetBypassApp          = "cannot get bypass actor app %s"
+)
+
+var (
+	rulesetEnforcements = []string{"disabled", "active", "evaluate"}
+	rulesetTargets      = []string{"branch", "tag", "push"}
+	rulesetActorTypes   = []string{"Integration", "OrganizationAdmin", "RepositoryRole", "Team", "DeployKey"}
+	rulesetBypassModes  = []string{"always", "pull_request"}
+	rulesetOperators    = []string{"starts_with", "ends_with", "contains", "regex"}
+)
+
+// ListRulesets retrieves all the rules for a given GitHub repository.
+// It uses pagination to handle large numbers of rules, fetching 100 rules per API call.
+func ListRulesets(ctx context.Context, gh *ghclient.Client, org, repo string) ([]*github.Ruleset, error) {
+	return ghclient.ListAll(func(page int) ([]*github.Ruleset, *github.Response, error) {
+		return gh.Repositories.GetAllRulesets(ctx, org, repo, true, &github.ListOptions{PerPage: ghclient.PerPage, Page: page})
+	})
+}
+
+// NormalizeRulesets generates a map of the supplied rulesets keyed by name,
+// with their optional fields defaulted so that they compare equal to the
+// rulesets fetched from GitHub.
+//
+//nolint:gocyclo
+func NormalizeRulesets(rules []v1alpha1.Ruleset) map[string]v1alpha1.Ruleset {
+	crRulesToConfig := make(map[string]v1alpha1.Ruleset, len(rules))
+
+	for i := range rules {
+		// Use a copy to avoid changing passed []v1alpha1.RepositoryRules
+		// This prevents the controller from changing the spec of the live CR
+		// It can also prevent infinite reconciliation loops when managing the resources with ArgoCD
+		orig := &rules[i]
+		rCopy := orig.DeepCopy()
+
+		// handle optional fields
+		rCopy.Target = StringDerefToPointer(rCopy.Target, "branch")
+		rCopy.Enforcement = StringDerefToPointer(rCopy.Enforcement, "active")
+
+		rConditions := rCopy.Conditions
+
+		if rConditions != nil && rConditions.RefName != nil {
+			if rConditions.RefName.Include != nil {
+				rConditions.RefName.Include = SortAndReturn(rConditions.RefName.Include)
+			}
+			if rConditions.RefName.Exclude != nil {
+				rConditions.RefName.Exclude = SortAndReturn(rConditions.RefName.Exclude)
+			}
+		}
+
+		if rConditions == nil {
+			rConditions = &v1alpha1.RulesetConditions{
+				RefName: &v1alpha1.RulesetRefName{
+					Include: []string{},
+					Exclude: []string{},
+				},
+			}
+			// Update the rConditions reference in rCopy
+			rCopy.Conditions = rConditions
+		}
+
+		rBActors := rCopy.BypassActors
+		if rBActors != nil {
+			for a := range rBActors {
+				actor := rBActors[a] // Make a copy of the actor
+
+				// Set ActorId, ActorType, and BypassMode fields
+				actor.ActorId = rBActors[a].ActorId
+				actor.ActorType = rBActors[a].ActorType
+				actor.BypassMode = rBActors[a].BypassMode
+
+				// Update the actor in the slice
+				rBActors[a] = actor
+			}
+			SortRulesBypassActors(rBActors)
+		}
+		rRules := rCopy.Rules
+		if rRules != nil {
+			rRules.RequiredSignatures = BoolDerefToPointer(rRules.RequiredSignatures, false)
+			rRules.NonFastForward = BoolDerefToPointer(rRules.NonFastForward, false)
+			rRules.Creation = BoolDerefToPointer(rRules.Creation, false)
+			rRules.Deletion = BoolDerefToPointer(rRules.Deletion, false)
+			rRules.RequiredLinearHistory = BoolDerefToPointer(rRules.RequiredLinearHistory, false)
+			rRules.Update = BoolDerefToPointer(rRules.Update, false)
+
+			if rRules.RequiredDeployments != nil {
+				if rRules.RequiredDeployments.Environments != nil {
+					rRules.RequiredDeployments.Environments = SortAndReturn(rRules.RequiredDeployments.Environments)
+				}
+			}
+			if rRules.PullRequest != nil {
+				rRules.PullRequest.DismissStaleReviewsOnPush = BoolDerefToPointer(rRules.PullRequest.DismissStaleReviewsOnPush, false)
+				rRules.PullRequest.RequireCodeOwnerReview = BoolDerefToPointer(rRules.PullRequest.RequireCodeOwnerReview, false)
+				rRules.PullRequest.RequireLastPushApproval = BoolDerefToPointer(rRules.PullRequest.RequireLastPushApproval, false)
+				rRules.PullRequest.RequiredReviewThreadResolution = BoolDerefToPointer(rRules.PullRequest.RequiredReviewThreadResolution, false)
+				rRules.PullRequest.RequiredApprovingReviewCount = IntDerefToPointer(rRules.PullRequest.RequiredApprovingReviewCount, 0)
+			}
+			if rRules.RequiredStatusChecks != nil {
+				if rRules.RequiredStatusChecks.RequiredStatusChecks != nil {
+					copyOfStatusChecks := make([]*v1alpha1.RulesRequiredStatusChecksParameters, len(rRules.RequiredStatusChecks.RequiredStatusChecks))
+					copy(copyOfStatusChecks, rRules.RequiredStatusChecks.RequiredStatusChecks)
+					SortRulesRequiredStatusChecks(copyOfStatusChecks)
+					rRules.RequiredStatusChecks.RequiredStatusChecks = copyOfStatusChecks
+				}
+				rRules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy = BoolDerefToPointer(rRules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy, false)
+			}
+			if rRules.FilePathRestriction != nil {
+				rRules.FilePathRestriction.RestrictedFilePaths = SortAndReturn(rRules.FilePathRestriction.RestrictedFilePaths)
+			}
+			if rRules.FileExtensionRestriction != nil {
+				rRules.FileExtensionRestriction.RestrictedFileExtensions = SortAndReturn(rRules.FileExtensionRestriction.RestrictedFileExtensions)
+			}
+			for _, pattern := range []*v1alpha1.RulesPattern{
+				rRules.CommitMessagePattern,
+				rRules.CommitAuthorEmailPattern,
+				rRules.CommitterEmailPattern,
+				rRules.BranchNamePattern,
+				rRules.TagNamePattern,
+			} {
+				if pattern != nil {
+					pattern.Negate = BoolDerefToPointer(pattern.Negate, false)
+				}
+			}
+		}
+		crRulesToConfig[rCopy.Name] = *rCopy
+	}
+
+	return crRulesToConfig
+}
+
+// RulesetsFromGitHub creates a map of rulesets keyed by name based on the
+// rulesets fetched from the GitHub API.
+func RulesetsFromGitHub(ctx context.Context, gh *ghclient.Client, owner, repo string, ghRulesets []*github.Ruleset) (map[string]v1alpha1.Ruleset, error) {
+	rulesToConfig := make(map[string]v1alpha1.Ruleset, len(ghRulesets))
+
+	for _, rule := range ghRulesets {
+		rRuleset, _, err := gh.Repositories.GetRuleset(ctx, owner, repo, *rule.ID, true)
+		if err != nil {
+			return nil, err
+		}
+		ruleset, err := RulesetFromGitHub(rRuleset)
+		if err != nil {
+			return nil, err
+		}
+		rulesToConfig[rule.Name] = ruleset
+	}
+
+	return rulesToConfig, nil
+}
+
+// RulesetFromGitHub transforms a ruleset fetched from the GitHub API with its
+// rules into a Ruleset.
+//
+//nolint:gocyclo
+func RulesetFromGitHub(rs *github.Ruleset) (v1alpha1.Ruleset, error) {
+	var err error
+	ruleset := v1alpha1.Ruleset{
+		Target:      ToStringPtr(rs.GetTarget()),
+		Enforcement: &rs.Enforcement,
+		Name:        rs.Name,
+
+		Conditions: &v1alpha1.RulesetConditions{
+			RefName: &v1alpha1.RulesetRefName{
+				Include: []string{},
+				Exclude: []string{},
+			},
+		},
+		BypassActors: nil,
+		Rules: &v1alpha1.Rules{
+			Creation:              ToBoolPtr(false),
+			Update:                ToBoolPtr(false),
+			Deletion:              ToBoolPtr(false),
+			RequiredLinearHistory: ToBoolPtr(false),
+			RequiredDeployments:   nil,
+			RequiredSignatures:    ToBoolPtr(false),
+			NonFastForward:        ToBoolPtr(false),
+			PullRequest:           nil,
+			RequiredStatusChecks:  nil,
+		},
+	}
+
+	if rs.Conditions != nil {
+		if rs.Conditions.RefName != nil {
+			ruleset.Conditions.RefName = &v1alpha1.RulesetRefName{
+				Include: SortAndReturn(rs.Conditions.RefName.Include),
+				Exclude: SortAndReturn(rs.Conditions.RefName.Exclude),
+			}
+		}
+	}
+
+	if rs.BypassActors != nil {
+		if len(rs.BypassActors) > 0 {
+			ruleset.BypassActors = make([]*v1alpha1.RulesetByPassActors, len(rs.BypassActors))
+			for i, actor := range rs.BypassActors {
+				ruleset.BypassActors[i] = &v1alpha1.RulesetByPassActors{
+					ActorType:  actor.ActorType,
+					ActorId:    actor.ActorID,
+					BypassMode: actor.BypassMode,
+				}
+			}
+			SortRulesBypassActors(ruleset.BypassActors)
+		}
+
+	}
+	if rs != nil {
+		for _, rule := range rs.Rules {
+			switch rule.Type {
+			case "creation":
+				ruleset.Rules.Creation = ToBoolPtr(true)
+			case "deletion":
+				ruleset.Rules.Deletion = ToBoolPtr(true)
+			case "required_linear_history":
+				ruleset.Rules.RequiredLinearHistory = ToBoolPtr(true)
+			case "required_signatures":
+				ruleset.Rules.RequiredSignatures = ToBoolPtr(true)
+			case "non_fast_forward":
+				ruleset.Rules.NonFastForward = ToBoolPtr(true)
+			case "update":
+				ruleset.Rules.Update = ToBoolPtr(true)
+			case "pull_request":
+				if rule.Parameters != nil {
+					params := github.PullRequestRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.PullRequest = &v1alpha1.RulesPullRequest{
+						RequireCodeOwnerReview:         ToBoolPtr(params.RequireCodeOwnerReview),
+						RequireLastPushApproval:        ToBoolPtr(params.RequireLastPushApproval),
+						RequiredReviewThreadResolution: ToBoolPtr(params.RequiredReviewThreadResolution),
+						RequiredApprovingReviewCount:   ToIntPtr(params.RequiredApprovingReviewCount),
+						DismissStaleReviewsOnPush:      ToBoolPtr(params.DismissStaleReviewsOnPush),
+					}
+				}
+			case "required_deployments":
+				if rule.Parameters != nil {
+					params := github.RequiredDeploymentEnvironmentsRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.RequiredDeployments = &v1alpha1.RulesRequiredDeployments{
+						Environments: SortAndReturn(params.RequiredDeploymentEnvironments),
+					}
+				}
+			case "required_status_checks":
+				if rule.Parameters != nil {
+					params := github.RequiredStatusChecksRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					requiredStatusChecksParameters := make([]*v1alpha1.RulesRequiredStatusChecksParameters, len(params.RequiredStatusChecks))
+					for i, statusCheck := range params.RequiredStatusChecks {
+						requiredStatusChecksParameters[i] = &v1alpha1.RulesRequiredStatusChecksParameters{
+							Context:       statusCheck.Context,
+							IntegrationId: statusCheck.IntegrationID,
+						}
+					}
+					SortRulesRequiredStatusChecks(requiredStatusChecksParameters)
+
+					ruleset.Rules.RequiredStatusChecks = &v1alpha1.RulesRequiredStatusChecks{
+						StrictRequiredStatusChecksPolicy: ToBoolPtr(params.StrictRequiredStatusChecksPolicy),
+						RequiredStatusChecks:             requiredStatusChecksParameters,
+					}
+				}
+			case "workflows":
+				if rule.Parameters != nil {
+					params := github.RequiredWorkflowsRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					workflows := make([]*v1alpha1.RulesWorkflow, len(params.RequiredWorkflows))
+					for i, workflow := range params.RequiredWorkflows {
+						workflows[i] = &v1alpha1.RulesWorkflow{
+							Path:         workflow.Path,
+							RepositoryId: workflow.RepositoryID,
+							Ref:          workflow.Ref,
+							Sha:          workflow.Sha,
+						}
+					}
+					SortRulesWorkflows(workflows)
+
+					ruleset.Rules.Workflows = &v1alpha1.RulesWorkflows{
+						Workflows: workflows,
+					}
+				}
+			case "file_path_restriction":
+				if rule.Parameters != nil {
+					params := FilePathRestrictionRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.FilePathRestriction = &v1alpha1.RulesFilePathRestriction{
+						RestrictedFilePaths: SortAndReturn(params.RestrictedFilePaths),
+					}
+				}
+			case "max_file_path_length":
+				if rule.Parameters != nil {
+					params := MaxFilePathLengthRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.MaxFilePathLength = ToIntPtr(params.MaxFilePathLength)
+				}
+			case "file_extension_restriction":
+				if rule.Parameters != nil {
+					params := FileExtensionRestrictionRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.FileExtensionRestriction = &v1alpha1.RulesFileExtensionRestriction{
+						RestrictedFileExtensions: SortAndReturn(params.RestrictedFileExtensions),
+					}
+				}
+			case "max_file_size":
+				if rule.Parameters != nil {
+					params := MaxFileSizeRuleParameters{}
+					if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+						return v1alpha1.Ruleset{}, err
+					}
+					ruleset.Rules.MaxFileSize = ToIntPtr(params.MaxFileSize)
+				}
+			case "commit_message_pattern":
+				if ruleset.Rules.CommitMessagePattern, err = ghPatternRuleToCr(rule); err != nil {
+					return v1alpha1.Ruleset{}, err
+				}
+			case "commit_author_email_pattern":
+				if ruleset.Rules.CommitAuthorEmailPattern, err = ghPatternRuleToCr(rule); err != nil {
+					return v1alpha1.Ruleset{}, err
+				}
+			case "committer_email_pattern":
+				if ruleset.Rules.CommitterEmailPattern, err = ghPatternRuleToCr(rule); err != nil {
+					return v1alpha1.Ruleset{}, err
+				}
+			case "branch_name_pattern":
+				if ruleset.Rules.BranchNamePattern, err = ghPatternRuleToCr(rule); err != nil {
+					return v1alpha1.Ruleset{}, err
+				}
+			case "tag_name_pattern":
+				if ruleset.Rules.TagNamePattern, err = ghPatternRuleToCr(rule); err != nil {
+					return v1alpha1.Ruleset{}, err
+				}
+			}
+
+		}
+
+	}
+
+	return ruleset, nil
+}
+
+// The parameters of the file rules of push rulesets, which go-github doesn't
+// provide types for.
+type FilePathRestrictionRuleParameters struct {
+	RestrictedFilePaths []string `json:"restricted_file_paths"`
+}
+
+type MaxFilePathLengthRuleParameters struct {
+	MaxFilePathLength int `json:"max_file_path_length"`
+}
+
+type FileExtensionRestrictionRuleParameters struct {
+	RestrictedFileExtensions []string `json:"restricted_file_extensions"`
+}
+
+type MaxFileSizeRuleParameters struct {
+	MaxFileSize int `json:"max_file_size"`
+}
+
+// NewRawRule creates a rule of ruleType with the supplied parameters.
+func NewRawRule(ruleType string, params interface{}) *github.RepositoryRule {
+	bytes, _ := json.Marshal(params)
+	rawParams := json.RawMessage(bytes)
+
+	return &github.RepositoryRule{
+		Type:       ruleType,
+		Parameters: &rawParams,
+	}
+}
+
+// IsPushRuleset reports whether the ruleset targets pushes rather than refs.
+func IsPushRuleset(rule v1alpha1.Ruleset) bool {
+	return pointer.StringDeref(rule.Target, "") == "push"
+}
+
+// ghPatternRuleToCr transforms the parameters of a GitHub pattern rule into a
+// RulesPattern. It returns nil if the rule has no parameters.
+func ghPatternRuleToCr(rule *github.RepositoryRule) (*v1alpha1.RulesPattern, error) {
+	if rule.Parameters == nil {
+		return nil, nil
+	}
+	params := github.RulePatternParameters{}
+	if err := json.Unmarshal(*rule.Parameters, &params); err != nil {
+		return nil, err
+	}
+	return &v1alpha1.RulesPattern{
+		Name:     params.Name,
+		Operator: params.Operator,
+		Pattern:  params.Pattern,
+		Negate:   ToBoolPtr(params.GetNegate()),
+	}, nil
+}
+
+// ResolveRulesetReferences resolves the repositories, teams and apps referenced
+// by name in the supplied normalized rulesets to the IDs GitHub uses.
+func ResolveRulesetReferences(ctx context.Context, gh *ghclient.Client, owner, repo string, rules map[string]v1alpha1.Ruleset) error {
+	if err := resolveBypassActors(ctx, gh, owner, rules); err != nil {
+		return err
+	}
+	return resolveRequiredWorkflowRepositories(ctx, gh, owner, repo, rules)
+}
+
+// resolveBypassActors sets the ActorId and default ActorType of the bypass actors
+// in the supplied normalized rulesets that reference a team or app by name. The
+// references are cleared afterwards, so that the rulesets compare equal to the
+// ones fetched from GitHub.
+func resolveBypassActors(ctx context.Context, gh *ghclient.Client, owner string, rules map[string]v1alpha1.Ruleset) error {
+	for _, rule := range rules {
+		for _, actor := range rule.BypassActors {
+			switch {
+			case actor.ActorId != nil:
+			case actor.Team != "":
+				team, _, err := gh.Teams.GetTeamBySlug(ctx, owner, slug.Make(actor.Team))
+				if err != nil {
+					return errors.Wrapf(err, errGetBypassTeam, actor.Team)
+				}
+				actor.ActorId = team.ID
+				actor.ActorType = StringDerefToPointer(actor.ActorType, "Team")
+			case actor.AppSlug != nil:
+				app, _, err := gh.Apps.Get(ctx, *actor.AppSlug)
+				if err != nil {
+					return errors.Wrapf(err, errGetBypassApp, *actor.AppSlug)
+				}
+				actor.ActorId = app.ID
+				actor.ActorType = StringDerefToPointer(actor.ActorType, "Integration")
+			}
+			actor.Team = ""
+			actor.TeamRef = nil
+			actor.TeamSelector = nil
+			actor.AppSlug = nil
+		}
+		SortRulesBypassActors(rule.BypassActors)
+	}
+
+	return nil
+}
+
+// resolveRequiredWorkflowRepositories sets the RepositoryId of the required workflows
+// in the supplied normalized rulesets, looking up repositories of the organization by
+// name where needed. Workflows without a repository default to repo, the repository
+// the rulesets apply to. The repository names and references are cleared afterwards,
+// so that the rulesets compare equal to the ones fetched from GitHub.
+func resolveRequiredWorkflowRepositories(ctx context.Context, gh *ghclient.Client, owner, repo string, rules map[string]v1alpha1.Ruleset) error {
+	ids := make(map[string]int64)
+
+	for _, rule := range rules {
+		if rule.Rules == nil || rule.Rules.Workflows == nil {
+			continue
+		}
+		for _, workflow := range rule.Rules.Workflows.Workflows {
+			if workflow.RepositoryId == nil {
+				name := workflow.Repo
+				if name == "" {
+					name = repo
+				}
+				id, ok := ids[name]
+				if !ok {
+					r, _, err := gh.Repositories.Get(ctx, owner, name)
+					if err != nil {
+						return errors.Wrapf(err, errGetWorkflowRepository, name)
+					}
+					id = r.GetID()
+					ids[name] = id
+				}
+				workflow.RepositoryId = pointer.Int64(id)
+			}
+			workflow.Repo = ""
+			workflow.RepoRef = nil
+			workflow.RepoSelector = nil
+		}
+		SortRulesWorkflows(rule.Rules.Workflows.Workflows)
+	}
+
+	return nil
+}
+
+// RulesetToGitHub transforms a Ruleset object from the Crossplane resource
+// into a Ruleset object that can be used with the GitHub API.
+//
+//nolint:gocyclo
+func RulesetToGitHub(rule v1alpha1.Ruleset) *github.Ruleset {
+	githubRuleset := &github.Ruleset{
+		Name:        rule.Name,
+		Enforcement: *rule.Enforcement,
+		Target:      rule.Target,
+	}
+
+	// If BypassActors is not nil, transform it into the github rule BypassActors
+	if rule.BypassActors != nil {
+		githubBypassActors := make([]*github.BypassActor, len(rule.BypassActors))
+		for i, actor := range rule.BypassActors {
+			githubBypassActors[i] = &github.BypassActor{
+				ActorID:    actor.ActorId,
+				ActorType:  actor.ActorType,
+				BypassMode: actor.BypassMode,
+			}
+		}
+		githubRuleset.BypassActors = githubBypassActors
+	}
+
+	// If Conditions is not nil, transform it into the github rule Conditions.
+	// Push rulesets apply to all refs and don't support ref name conditions.
+	if rule.Conditions != nil && !IsPushRuleset(rule) {
+		githubConditions := &github.RulesetConditions{
+			RefName: &github.RulesetRefConditionParameters{
+				Include: rule.Conditions.RefName.Include,
+				Exclude: rule.Conditions.RefName.Exclude,
+			},
+		}
+		githubRuleset.Conditions = githubConditions
+	}
+	// If Rules is not nil, transform it into the github rule Rules
+	if rule.Rules != nil {
+		githubRules := make([]*github.RepositoryRule, 0)
+		if rule.Rules.RequiredStatusChecks != nil {
+			params := github.RequiredStatusChecksRuleParameters{
+				StrictRequiredStatusChecksPolicy: *rule.Rules.RequiredStatusChecks.StrictRequiredStatusChecksPolicy,
+			}
+			requiredStatusChecks := make([]github.RuleRequiredStatusChecks, len(rule.Rules.RequiredStatusChecks.RequiredStatusChecks))
+			for i, statusCheck := range rule.Rules.RequiredStatusChecks.RequiredStatusChecks {
+				requiredStatusChecks[i] = github.RuleRequiredStatusChecks{
+					Context:       statusCheck.Context,
+					IntegrationID: statusCheck.IntegrationId,
+				}
+			}
+			params.RequiredStatusChecks = requiredStatusChecks
+			paramsBytes, err := json.Marshal(params)
+			if err != nil {
+				return nil
+			}
+			rawParams := json.RawMessage(paramsBytes)
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type:       "required_status_checks",
+				Parameters: &rawParams,
+			})
+		}
+
+		if *rule.Rules.Creation {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "creation",
+			})
+		}
+
+		if *rule.Rules.Deletion {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "deletion",
+			})
+		}
+
+		if *rule.Rules.RequiredLinearHistory {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "required_linear_history",
+			})
+		}
+
+		if *rule.Rules.RequiredSignatures {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "required_signatures",
+			})
+		}
+		if *rule.Rules.NonFastForward {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "non_fast_forward",
+			})
+		}
+		if *rule.Rules.Update {
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type: "update",
+			})
+		}
+		if rule.Rules.PullRequest != nil {
+			params := github.PullRequestRuleParameters{
+				DismissStaleReviewsOnPush:      *rule.Rules.PullRequest.DismissStaleReviewsOnPush,
+				RequireCodeOwnerReview:         *rule.Rules.PullRequest.RequireCodeOwnerReview,
+				RequireLastPushApproval:        *rule.Rules.PullRequest.RequireLastPushApproval,
+				RequiredReviewThreadResolution: *rule.Rules.PullRequest.RequiredReviewThreadResolution,
+				RequiredApprovingReviewCount:   *rule.Rules.PullRequest.RequiredApprovingReviewCount,
+			}
+			paramsBytes, err := json.Marshal(params)
+			if err != nil {
+				return nil
+			}
+			rawParams := json.RawMessage(paramsBytes)
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type:       "pull_request",
+				Parameters: &rawParams,
+			})
+		}
+		if rule.Rules.RequiredDeployments != nil {
+			params := github.RequiredDeploymentEnvironmentsRuleParameters{
+				RequiredDeploymentEnvironments: rule.Rules.RequiredDeployments.Environments,
+			}
+			paramsBytes, err := json.Marshal(params)
+			if err != nil {
+				return nil
+			}
+			rawParams := json.RawMessage(paramsBytes)
+			githubRules = append(githubRules, &github.RepositoryRule{
+				Type:       "required_deployments",
+				Parameters: &rawParams,
+			})
+		}
+		if rule.Rules.Workflows != nil {
+			workflows := make([]*github.RuleRequiredWorkflow, len(rule.Rules.Workflows.Workflows))
+			for i, workflow := range rule.Rules.Workflows.Workflows {
+				workflows[i] = &github.RuleRequiredWorkflow{
+					Path:         workflow.Path,
+					RepositoryID: workflow.RepositoryId,
+					Ref:          workflow.Ref,
+					Sha:          workflow.Sha,
+				}
+			}
+			githubRules = append(githubRules, github.NewRequiredWorkflowsRule(&github.RequiredWorkflowsRuleParameters{
+				RequiredWorkflows: workflows,
+			}))
+		}
+		if rule.Rules.FilePathRestriction != nil {
+			githubRules = append(githubRules, NewRawRule("file_path_restriction", FilePathRestrictionRuleParameters{
+				RestrictedFilePaths: rule.Rules.FilePathRestriction.RestrictedFilePaths,
+			}))
+		}
+		if rule.Rules.MaxFilePathLength != nil {
+			githubRules = append(githubRules, NewRawRule("max_file_path_length", MaxFilePathLengthRuleParameters{
+				MaxFilePathLength: *rule.Rules.MaxFilePathLength,
+			}))
+		}
+		if rule.Rules.FileExtensionRestriction != nil {
+			githubRules = append(githubRules, NewRawRule("file_extension_restriction", FileExtensionRestrictionRuleParameters{
+				RestrictedFileExtensions: rule.Rules.FileExtensionRestriction.RestrictedFileExtensions,
+			}))
+		}
+		if rule.Rules.MaxFileSize != nil {
+			githubRules = append(githubRules, NewRawRule("max_file_size", MaxFileSizeRuleParameters{
+				MaxFileSize: *rule.Rules.MaxFileSize,
+			}))
+		}
+		patternRules := []struct {
+			pattern *v1alpha1.RulesPattern
+			newRule func(*github.RulePatternParameters) *github.RepositoryRule
+		}{
+			{rule.Rules.CommitMessagePattern, github.NewCommitMessagePatternRule},
+			{rule.Rules.CommitAuthorEmailPattern, github.NewCommitAuthorEmailPatternRule},
+			{rule.Rules.CommitterEmailPattern, github.NewCommitterEmailPatternRule},
+			{rule.Rules.BranchNamePattern, github.NewBranchNamePatternRule},
+			{rule.Rules.TagNamePattern, github.NewTagNamePatternRule},
+		}
+		for _, p := range patternRules {
+			if p.pattern != nil {
+				githubRules = append(githubRules, p.newRule(&github.RulePatternParameters{
+					Name:     p.pattern.Name,
+					Negate:   p.pattern.Negate,
+					Operator: p.pattern.Operator,
+					Pattern:  p.pattern.Pattern,
+				}))
+			}
+		}
+		githubRuleset.Rules = githubRules
+
+	}
+	return githubRuleset
+}
+
+// ValidateRuleset returns the list of problems found in a Ruleset,
+// including any problems converting it into a GitHub API request.
+//
+//nolint:gocyclo
+func ValidateRuleset(rule v1alpha1.Ruleset) []string {
+	var problems []string
+
+	if rule.Name == "" {
+		problems = append(problems, "name must not be empty")
+	}
+	if rule.Enforcement != nil && !Contains(rulesetEnforcements, *rule.Enforcement) {
+		problems = append(problems, fmt.Sprintf("enforcement must be one of %v, got %q", rulesetEnforcements, *rule.Enforcement))
+	}
+	if rule.Target != nil && !Contains(rulesetTargets, *rule.Target) {
+		problems = append(problems, fmt.Sprintf("target must be one of %v, got %q", rulesetTargets, *rule.Target))
+	}
+
+	for _, actor := range rule.BypassActors {
+		if actor == nil {
+			continue
+		}
+		refs := 0
+		for _, set := range []bool{actor.ActorId != nil, actor.Team != "", actor.AppSlug != nil} {
+			if set {
+				refs++
+			}
+		}
+		switch {
+		case refs == 0:
+			problems = append(problems, "bypass actor must set one of actorId, team and appSlug")
+		case refs > 1:
+			problems = append(problems, "bypass actor must set only one of actorId, team and appSlug")
+		}
+		if actor.ActorType != nil && !Contains(rulesetActorTypes, *actor.ActorType) {
+			problems = append(problems, fmt.Sprintf("bypass actor actorType must be one of %v, got %q", rulesetActorTypes, *actor.ActorType))
+		}
+		if actor.BypassMode != nil && !Contains(rulesetBypassModes, *actor.BypassMode) {
+			problems = append(problems, fmt.Sprintf("bypass actor bypassMode must be one of %v, got %q", rulesetBypassModes, *actor.BypassMode))
+		}
+	}
+
+	if rule.Conditions != nil && rule.Conditions.RefName == nil {
+		problems = append(problems, "conditions.refName must be set when conditions are specified")
+	}
+	problems = append(problems, validateRefNameTarget(rule)...)
+
+	if rules := rule.Rules; rules != nil {
+		if rules.PullRequest != nil && rules.PullRequest.RequiredApprovingReviewCount != nil {
+			count := *rules.PullRequest.RequiredApprovingReviewCount
+			if count < 0 || count > 10 {
+				problems = append(problems, fmt.Sprintf("pullRequest.requiredApprovingReviewCount must be between 0 and 10, got %d", count))
+			}
+		}
+		if rules.RequiredStatusChecks != nil {
+			for _, check := range rules.RequiredStatusChecks.RequiredStatusChecks {
+				if check == nil || check.Context == "" {
+					problems = append(problems, "required status check context must not be empty")
+				}
+			}
+		}
+		if rules.Workflows != nil {
+			for _, workflow := range rules.Workflows.Workflows {
+				if workflow == nil || workflow.Path == "" {
+					problems = append(problems, "required workflow path must not be empty")
+				}
+			}
+		}
+		if !IsPushRuleset(rule) && hasFileRules(rules) {
+			problems = append(problems, "file rules are only supported by rulesets with target \"push\"")
+		}
+		problems = append(problems, validateRulesTarget(rule)...)
+		problems = append(problems, validateRulesPattern("commitMessagePattern", rules.CommitMessagePattern)...)
+		problems = append(problems, validateRulesPattern("commitAuthorEmailPattern", rules.CommitAuthorEmailPattern)...)
+		problems = append(problems, validateRulesPattern("committerEmailPattern", rules.CommitterEmailPattern)...)
+		problems = append(problems, validateRulesPattern("branchNamePattern", rules.BranchNamePattern)...)
+		problems = append(problems, validateRulesPattern("tagNamePattern", rules.TagNamePattern)...)
+	}
+
+	// Only attempt to construct the request once the fields it dereferences are known to be safe.
+	if len(problems) == 0 {
+		normalized := NormalizeRulesets([]v1alpha1.Ruleset{rule})[rule.Name]
+		if RulesetToGitHub(normalized) == nil {
+			problems = append(problems, "cannot construct ruleset request")
+		}
+	}
+
+	return problems
+}
+
+// hasFileRules reports whether any of the rules that only apply to push rulesets are set.
+func hasFileRules(rules *v1alpha1.Rules) bool {
+	return rules.FilePathRestriction != nil || rules.MaxFilePathLength != nil ||
+		rules.FileExtensionRestriction != nil || rules.MaxFileSize != nil
+}
+
+// validateRulesTarget returns the list of problems found in the rules of a
+// ruleset that don't apply to refs of the type it targets.
+func validateRulesTarget(rule v1alpha1.Ruleset) []string {
+	rules := rule.Rules
+	var problems []string
+	switch pointer.StringDeref(rule.Target, "branch") {
+	case "tag":
+		for _, r := range []struct {
+			name string
+			set  bool
+		}{
+			{"requiredLinearHistory", rules.RequiredLinearHistory != nil},
+			{"requiredDeployments", rules.RequiredDeployments != nil},
+			{"pullRequest", rules.PullRequest != nil},
+			{"requiredStatusChecks", rules.RequiredStatusChecks != nil},
+			{"branchNamePattern", rules.BranchNamePattern != nil},
+			{"workflows", rules.Workflows != nil},
+		} {
+			if r.set {
+				problems = append(problems, fmt.Sprintf("%s is only supported by rulesets with target \"branch\"", r.name))
+			}
+		}
+	case "branch":
+		if rules.TagNamePattern != nil {
+			problems = append(problems, "tagNamePattern is only supported by rulesets with target \"tag\"")
+		}
+	}
+	return problems
+}
+
+// validateRefNameTarget returns the list of problems found in the ref name
+// conditions of a ruleset that can only match refs of another type than the
+// one it targets.
+func validateRefNameTarget(rule v1alpha1.Ruleset) []string {
+	if rule.Conditions == nil || rule.Conditions.RefName == nil {
+		return nil
+	}
+
+	target := pointer.StringDeref(rule.Target, "branch")
+	var problems []string
+	for field, refs := range map[string][]string{
+		"include": rule.Conditions.RefName.Include,
+		"exclude": rule.Conditions.RefName.Exclude,
+	} {
+		for _, ref := range refs {
+			var branchRef bool
+			switch {
+			case ref == "~DEFAULT_BRANCH", strings.HasPrefix(ref, "refs/heads/"):
+				branchRef = true
+			case strings.HasPrefix(ref, "refs/tags/"):
+				branchRef = false
+			default:
+				continue
+			}
+			if target == "tag" && branchRef {
+				problems = append(problems, fmt.Sprintf("conditions.refName.%s %q matches branches, but the ruleset targets tags", field, ref))
+			}
+			if target == "branch" && !branchRef {
+				problems = append(problems, fmt.Sprintf("conditions.refName.%s %q matches tags, but the ruleset targets branches", field, ref))
+			}
+		}
+	}
+	sort.Strings(problems)
+	return problems
+}
+
+// validateRulesPattern returns the list of problems found in the pattern rule called name.
+func validateRulesPattern(name string, pattern *v1alpha1.RulesPattern) []string {
+	if pattern == nil {
+		return nil
+	}
+
+	var problems []string
+	if !Contains(rulesetOperators, pattern.Operator) {
+		problems = append(problems, fmt.Sprintf("%s.operator must be one of %v, got %q", name, rulesetOperators, pattern.Operator))
+	}
+	if pattern.Pattern == "" {
+		problems = append(problems, fmt.Sprintf("%s.pattern must not be empty", name))
+	} else if pattern.Operator == "regex" {
+		if _, err := regexp.Compile(pattern.Pattern); err != nil {
+			problems = append(problems, fmt.Sprintf("%s.pattern is not a valid regular expression: %s", name, err))
+		}
+	}
+	return problems
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"reflect"
 	"sort"
+	"strings"
 
 	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
 	"github.com/google/go-cmp/cmp"
@@ -115,14 +116,14 @@ func DiffRepoWebhooks(a, b map[string]v1alpha1.RepositoryWebhook) (map[string]v1
 // inANotInB: entities (keys) that are present in 'a' but not in 'b' mapped to their values in 'a'
 // inBNotInA: entities (keys) that are present in 'b' but not in 'a' mapped to their values in 'b'
 // diffs: entities (keys) that are present in both 'a' and 'b' but have different values, mapped to their values in 'b'
-func DiffProtectedBranches(a, b map[string]v1alpha1.BranchProtectionRule) (
-	map[string]v1alpha1.BranchProtectionRule,
-	map[string]v1alpha1.BranchProtectionRule,
-	map[string]v1alpha1.BranchProtectionRule,
+func DiffProtectedBranches(a, b map[string]v1alpha1.RepositoryBranchProtectionRule) (
+	map[string]v1alpha1.RepositoryBranchProtectionRule,
+	map[string]v1alpha1.RepositoryBranchProtectionRule,
+	map[string]v1alpha1.RepositoryBranchProtectionRule,
 ) {
-	inANotInB := make(map[string]v1alpha1.BranchProtectionRule)
-	inBNotInA := make(map[string]v1alpha1.BranchProtectionRule)
-	diffs := make(map[string]v1alpha1.BranchProtectionRule)
+	inANotInB := make(map[string]v1alpha1.RepositoryBranchProtectionRule)
+	inBNotInA := make(map[string]v1alpha1.RepositoryBranchProtectionRule)
+	diffs := make(map[string]v1alpha1.RepositoryBranchProtectionRule)
 
 	for entity, va := range a {
 		vb, ok := b[entity]
@@ -144,18 +145,18 @@ func DiffProtectedBranches(a, b map[string]v1alpha1.BranchProtectionRule) (
 
 }
 
-// DiffRepositoryRulesets compares two maps of RepositoryRuleset, 'a' and 'b'.
+// DiffRepositoryRulesets compares two maps of Ruleset, 'a' and 'b'.
 // It returns three maps:
 // inANotInB: entities (keys) that are present in 'a' but not in 'b' mapped to their values in 'a'
 // inBNotInA: entities (keys) that are present in 'b' but not in 'a' mapped to their values in 'b'
 // diffs: entities (keys) that are present in both 'a' and 'b' but have different values, mapped to their values in 'b'
-func DiffRepositoryRulesets(a, b map[string]v1alpha1.RepositoryRuleset) (
-	map[string]v1alpha1.RepositoryRuleset,
-	map[string]v1alpha1.RepositoryRuleset,
-	map[string]v1alpha1.RepositoryRuleset) {
-	inANotInB := make(map[string]v1alpha1.RepositoryRuleset)
-	inBNotInA := make(map[string]v1alpha1.RepositoryRuleset)
-	diffs := make(map[string]v1alpha1.RepositoryRuleset)
+func DiffRepositoryRulesets(a, b map[string]v1alpha1.Ruleset) (
+	map[string]v1alpha1.Ruleset,
+	map[string]v1alpha1.Ruleset,
+	map[string]v1alpha1.Ruleset) {
+	inANotInB := make(map[string]v1alpha1.Ruleset)
+	inBNotInA := make(map[string]v1alpha1.Ruleset)
+	diffs := make(map[string]v1alpha1.Ruleset)
 
 	for entity, va := range a {
 		vb, ok := b[entity]
@@ -201,27 +202,72 @@ func SortAndReturn(s []string) []string {
 	return s
 }
 
+// NormalizeName normalizes a GitHub user login, team slug or app slug.
+// GitHub treats them case-insensitively, so they are compared lowercased on
+// both the desired and the observed side to avoid updates that only change case.
+func NormalizeName(name string) string {
+	return strings.ToLower(name)
+}
+
+// NormalizeNames returns the normalized and sorted copy of a slice of user
+// logins, team slugs or app slugs.
+func NormalizeNames(names []string) []string {
+	res := make([]string, len(names))
+	for i, name := range names {
+		res[i] = NormalizeName(name)
+	}
+	sort.Strings(res)
+	return res
+}
+
+// NormalizeNamesPointer returns a pointer to the normalized and sorted copy of
+// a slice of user logins, team slugs or app slugs.
+func NormalizeNamesPointer(names []string) *[]string {
+	res := NormalizeNames(names)
+	return &res
+}
+
 // SortRequiredStatusChecks sorts a slice of RequiredStatusCheck pointers in-place
-// by the Context field in ascending order.
+// by the Context field in ascending order. Checks with the same Context are sorted
+// by their AppID, checks without an AppID sort first.
 func SortRequiredStatusChecks(checks []*v1alpha1.RequiredStatusCheck) {
 	sort.Slice(checks, func(i, j int) bool {
-		return checks[i].Context < checks[j].Context
+		if checks[i].Context != checks[j].Context {
+			return checks[i].Context < checks[j].Context
+		}
+		return pointer.Int64Deref(checks[i].AppID, -1) < pointer.Int64Deref(checks[j].AppID, -1)
 	})
 }
 
 // SortRulesRequiredStatusChecks sorts a slice of RequiredStatusCheck pointers in-place
-// by the Context field in ascending order.
+// by the Context field in ascending order. Checks with the same Context are sorted
+// by their IntegrationId, checks without an IntegrationId sort first.
 func SortRulesRequiredStatusChecks(checks []*v1alpha1.RulesRequiredStatusChecksParameters) {
 	sort.Slice(checks, func(i, j int) bool {
-		return checks[i].Context < checks[j].Context
+		if checks[i].Context != checks[j].Context {
+			return checks[i].Context < checks[j].Context
+		}
+		return pointer.Int64Deref(checks[i].IntegrationId, 0) < pointer.Int64Deref(checks[j].IntegrationId, 0)
+	})
+}
+
+// SortRulesWorkflows sorts a slice of RulesWorkflow pointers in-place
+// by the RepositoryId and Path fields in ascending order.
+func SortRulesWorkflows(workflows []*v1alpha1.RulesWorkflow) {
+	sort.Slice(workflows, func(i, j int) bool {
+		idI, idJ := pointer.Int64Deref(workflows[i].RepositoryId, 0), pointer.Int64Deref(workflows[j].RepositoryId, 0)
+		if idI != idJ {
+			return idI < idJ
+		}
+		return workflows[i].Path < workflows[j].Path
 	})
 }
 
 // SortRulesBypassActors sorts a slice of RulesetByPassActors pointers in-place
-// by the ActorId field in ascending order.
+// by the ActorId field in ascending order. Actors without an ActorId sort first.
 func SortRulesBypassActors(actors []*v1alpha1.RulesetByPassActors) {
 	sort.Slice(actors, func(i, j int) bool {
-		return *actors[i].ActorId < *actors[j].ActorId
+		return pointer.Int64Deref(actors[i].ActorId, 0) < pointer.Int64Deref(actors[j].ActorId, 0)
 	})
 
 }
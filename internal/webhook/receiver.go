@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhook receives the webhooks of GitHub organizations and triggers
+// a reconcile of the managed resources whose GitHub resources changed.
+package webhook
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v62/github"
+	"github.com/gosimple/slug"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+const (
+	// maxPayloadSize is the largest payload GitHub delivers.
+	maxPayloadSize = 25 << 20
+
+	errListRepositories = "cannot list Repository managed resources"
+	errListTeams        = "cannot list Team managed resources"
+)
+
+// Receiver is an http.Handler for the webhooks of GitHub organizations. It
+// triggers a reconcile of the Repositories and Teams whose GitHub resources
+// changed, so that their drift is corrected without waiting for a poll.
+type Receiver struct {
+	kube   client.Reader
+	secret []byte
+	log    logging.Logger
+}
+
+// NewReceiver returns a Receiver that accepts the deliveries signed with
+// secret, and looks up the managed resources they affect with kube.
+func NewReceiver(kube client.Reader, secret []byte, log logging.Logger) *Receiver {
+	return &Receiver{kube: kube, secret: secret, log: log}
+}
+
+// ServeHTTP handles a delivery of a GitHub webhook.
+func (r *Receiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	req.Body = http.MaxBytesReader(w, req.Body, maxPayloadSize)
+	payload, err := github.ValidatePayload(req, r.secret)
+	if err != nil {
+		r.log.Debug("Rejected webhook delivery", "error", err)
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	eventType := github.WebHookType(req)
+	switch eventType {
+	case "repository", "branch_protection_rule", "member", "team":
+	default:
+		// GitHub sends a ping when the webhook is created, and the
+		// webhook may deliver events that affect no managed resource.
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	e, err := github.ParseWebHook(eventType, payload)
+	if err != nil {
+		http.Error(w, "invalid payload", http.StatusBadRequest)
+		return
+	}
+
+	if err := r.reconcile(req.Context(), e); err != nil {
+		r.log.Info("Cannot handle webhook delivery", "event", eventType, "delivery", github.DeliveryID(req), "error", err)
+		http.Error(w, "cannot handle event", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusAccepted)
+}
+
+// reconcile triggers a reconcile of the managed resources affected by e.
+func (r *Receiver) reconcile(ctx context.Context, e any) error {
+	var repos, teams []string
+	var org string
+	switch e := e.(type) {
+	case *github.RepositoryEvent:
+		org = e.GetOrg().GetLogin()
+		repos = append(repos, e.GetRepo().GetName())
+		// A renamed repository is still known by its old name.
+		if from := e.GetChanges().GetRepo().GetName().GetFrom(); from != "" {
+			repos = append(repos, from)
+		}
+	case *github.BranchProtectionRuleEvent:
+		org = e.GetOrg().GetLogin()
+		repos = append(repos, e.GetRepo().GetName())
+	case *github.MemberEvent:
+		org = e.GetOrg().GetLogin()
+		repos = append(repos, e.GetRepo().GetName())
+	case *github.TeamEvent:
+		org = e.GetOrg().GetLogin()
+		teams = append(teams, e.GetTeam().GetSlug())
+		if from := e.GetChanges().GetName().GetFrom(); from != "" {
+			teams = append(teams, slug.Make(from))
+		}
+		// The repository a team was added to or removed from.
+		if e.Repo != nil {
+			repos = append(repos, e.GetRepo().GetName())
+		}
+	}
+	if org == "" {
+		return nil
+	}
+
+	if len(repos) > 0 {
+		if err := r.reconcileRepositories(ctx, org, repos); err != nil {
+			return err
+		}
+	}
+	if len(teams) > 0 {
+		return r.reconcileTeams(ctx, org, teams)
+	}
+	return nil
+}
+
+func (r *Receiver) reconcileRepositories(ctx context.Context, org string, names []string) error {
+	l := &v1alpha1.RepositoryList{}
+	if err := r.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListRepositories)
+	}
+	for i := range l.Items {
+		cr := &l.Items[i]
+		owner, name := v1alpha1.ParseRepositoryExternalName(meta.GetExternalName(cr))
+		if owner == "" {
+			owner = cr.Spec.ForProvider.Org
+		}
+		// The names of organizations and repositories are case-insensitive.
+		if strings.EqualFold(owner, org) && containsFold(names, name) {
+			r.trigger(v1alpha1.RepositoryGroupVersionKind.GroupKind(), cr)
+		}
+	}
+	return nil
+}
+
+func (r *Receiver) reconcileTeams(ctx context.Context, org string, slugs []string) error {
+	l := &v1alpha1.TeamList{}
+	if err := r.kube.List(ctx, l); err != nil {
+		return errors.Wrap(err, errListTeams)
+	}
+	for i := range l.Items {
+		cr := &l.Items[i]
+		if strings.EqualFold(cr.Spec.ForProvider.Org, org) && containsFold(slugs, slug.Make(meta.GetExternalName(cr))) {
+			r.trigger(v1alpha1.TeamGroupVersionKind.GroupKind(), cr)
+		}
+	}
+	return nil
+}
+
+func (r *Receiver) trigger(gk schema.GroupKind, o client.Object) {
+	if !trigger(gk, o) {
+		r.log.Info("Dropped reconcile triggered by webhook, reconcile queue is full", "kind", gk.String(), "name", o.GetName())
+		return
+	}
+	r.log.Debug("Triggered reconcile from webhook", "kind", gk.String(), "name", o.GetName())
+}
+
+func containsFold(s []string, v string) bool {
+	for _, e := range s {
+		if strings.EqualFold(e, v) {
+			return true
+		}
+	}
+	return false
+}
+
+// Serve serves h at addr until ctx is done.
+func Serve(ctx context.Context, addr string, h http.Handler) error {
+	srv := &http.Server{Addr: addr, Handler: h, ReadHeaderTimeout: 10 * time.Second}
+	errs := make(chan error, 1)
+	go func() { errs <- srv.ListenAndServe() }()
+
+	select {
+	case err := <-errs:
+		return errors.Wrap(err, "cannot serve webhooks")
+	case <-ctx.Done():
+		shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return srv.Shutdown(shutdown)
+	}
+}
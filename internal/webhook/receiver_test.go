@@ -0,0 +1,321 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-github/v62/github"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/crossplane/crossplane-runtime/pkg/logging"
+	"github.com/crossplane/crossplane-runtime/pkg/meta"
+	"github.com/crossplane/crossplane-runtime/pkg/test"
+
+	"github.com/crossplane/provider-github/apis/organizations/v1alpha1"
+)
+
+var secret = []byte("webhook-secret")
+
+// sign signs payload as GitHub does with the secret of a webhook.
+func sign(secret []byte, payload string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(payload))
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func repository(name, org, externalName string) v1alpha1.Repository {
+	cr := v1alpha1.Repository{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	cr.Spec.ForProvider.Org = org
+	meta.SetExternalName(&cr, externalName)
+	return cr
+}
+
+func team(name, org, externalName string) v1alpha1.Team {
+	cr := v1alpha1.Team{ObjectMeta: metav1.ObjectMeta{Name: name}}
+	cr.Spec.ForProvider.Org = org
+	meta.SetExternalName(&cr, externalName)
+	return cr
+}
+
+func kube() *test.MockClient {
+	return &test.MockClient{
+		MockList: func(_ context.Context, l client.ObjectList, _ ...client.ListOption) error {
+			switch l := l.(type) {
+			case *v1alpha1.RepositoryList:
+				l.Items = []v1alpha1.Repository{
+					repository("repo", "acme", "repo"),
+					repository("owned-repo", "", "Acme/owned-repo"),
+					repository("other-org-repo", "other", "repo"),
+					repository("other-repo", "acme", "other-repo"),
+				}
+			case *v1alpha1.TeamList:
+				l.Items = []v1alpha1.Team{
+					team("team", "acme", "Platform Team"),
+					team("other-team", "acme", "Other Team"),
+				}
+			}
+			return nil
+		},
+	}
+}
+
+// drain returns the names of the managed resources of gk whose reconcile was
+// triggered.
+func drain(gk schema.GroupKind) []string {
+	var names []string
+	c := queues.channel(gk)
+	for {
+		select {
+		case e := <-c:
+			names = append(names, e.Object.GetName())
+		default:
+			sort.Strings(names)
+			return names
+		}
+	}
+}
+
+func TestReceiver(t *testing.T) {
+	type args struct {
+		kube      client.Reader
+		event     string
+		payload   string
+		signature func(payload string) string
+	}
+	type want struct {
+		status int
+		repos  []string
+		teams  []string
+	}
+
+	signed := func(payload string) string { return sign(secret, payload) }
+
+	cases := map[string]struct {
+		reason string
+		args   args
+		want   want
+	}{
+		"MissingSignature": {
+			reason: "A delivery without signature should be rejected.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"organization":{"login":"acme"},"repository":{"name":"repo"}}`,
+				signature: func(string) string { return "" },
+			},
+			want: want{status: http.StatusUnauthorized},
+		},
+		"BadSignature": {
+			reason: "A delivery signed with another secret should be rejected.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"organization":{"login":"acme"},"repository":{"name":"repo"}}`,
+				signature: func(payload string) string { return sign([]byte("other-secret"), payload) },
+			},
+			want: want{status: http.StatusUnauthorized},
+		},
+		"TamperedPayload": {
+			reason: "A delivery whose payload doesn't match its signature should be rejected.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"organization":{"login":"acme"},"repository":{"name":"repo"}}`,
+				signature: func(string) string { return sign(secret, `{"organization":{"login":"acme"}}`) },
+			},
+			want: want{status: http.StatusUnauthorized},
+		},
+		"Ping": {
+			reason: "A ping should be accepted without triggering reconciles.",
+			args: args{
+				kube:      kube(),
+				event:     "ping",
+				payload:   `{"zen":"Keep it logically awesome."}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusNoContent},
+		},
+		"UnknownEvent": {
+			reason: "Events that affect no managed resource should be accepted without triggering reconciles.",
+			args: args{
+				kube:      kube(),
+				event:     "push",
+				payload:   `{"organization":{"login":"acme"},"repository":{"name":"repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusNoContent},
+		},
+		"InvalidPayload": {
+			reason: "A payload that isn't an event should be rejected.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `not json`,
+				signature: signed,
+			},
+			want: want{status: http.StatusBadRequest},
+		},
+		"ListError": {
+			reason: "A delivery whose managed resources can't be listed should fail, so that GitHub reports it.",
+			args: args{
+				kube:      &test.MockClient{MockList: test.NewMockListFn(errors.New("boom"))},
+				event:     "repository",
+				payload:   `{"organization":{"login":"acme"},"repository":{"name":"repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusInternalServerError},
+		},
+		"Repository": {
+			reason: "A repository event should trigger a reconcile of the Repositories of the repository.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"action":"edited","organization":{"login":"ACME"},"repository":{"name":"Repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"repo"}},
+		},
+		"RepositoryOfOwner": {
+			reason: "A repository event should trigger a reconcile of the Repositories whose external name has an owner.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"action":"edited","organization":{"login":"acme"},"repository":{"name":"owned-repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"owned-repo"}},
+		},
+		"RenamedRepository": {
+			reason: "A renamed repository should trigger a reconcile of the Repositories of its old name.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"action":"renamed","organization":{"login":"acme"},"repository":{"name":"new-repo"},"changes":{"repository":{"name":{"from":"repo"}}}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"repo"}},
+		},
+		"BranchProtectionRule": {
+			reason: "A branch protection rule event should trigger a reconcile of the Repositories of the repository.",
+			args: args{
+				kube:      kube(),
+				event:     "branch_protection_rule",
+				payload:   `{"action":"edited","organization":{"login":"acme"},"repository":{"name":"other-repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"other-repo"}},
+		},
+		"Member": {
+			reason: "A member event should trigger a reconcile of the Repositories of the repository.",
+			args: args{
+				kube:      kube(),
+				event:     "member",
+				payload:   `{"action":"added","organization":{"login":"acme"},"repository":{"name":"repo"},"member":{"login":"user1"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"repo"}},
+		},
+		"Team": {
+			reason: "A team event should trigger a reconcile of the Teams of the team, and of the Repositories it was added to.",
+			args: args{
+				kube:      kube(),
+				event:     "team",
+				payload:   `{"action":"added_to_repository","organization":{"login":"acme"},"team":{"slug":"platform-team"},"repository":{"name":"repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, repos: []string{"repo"}, teams: []string{"team"}},
+		},
+		"RenamedTeam": {
+			reason: "A renamed team should trigger a reconcile of the Teams of its old name.",
+			args: args{
+				kube:      kube(),
+				event:     "team",
+				payload:   `{"action":"edited","organization":{"login":"acme"},"team":{"slug":"new-team"},"changes":{"name":{"from":"Platform Team"}}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted, teams: []string{"team"}},
+		},
+		"WithoutOrganization": {
+			reason: "An event outside an organization should trigger no reconcile.",
+			args: args{
+				kube:      kube(),
+				event:     "repository",
+				payload:   `{"action":"edited","repository":{"name":"repo"}}`,
+				signature: signed,
+			},
+			want: want{status: http.StatusAccepted},
+		},
+	}
+
+	repositories := v1alpha1.RepositoryGroupVersionKind.GroupKind()
+	teams := v1alpha1.TeamGroupVersionKind.GroupKind()
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			drain(repositories)
+			drain(teams)
+
+			req := httptest.NewRequest(http.MethodPost, "/", strings.NewReader(tc.args.payload))
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set(github.EventTypeHeader, tc.args.event)
+			if s := tc.args.signature(tc.args.payload); s != "" {
+				req.Header.Set(github.SHA256SignatureHeader, s)
+			}
+			w := httptest.NewRecorder()
+			NewReceiver(tc.args.kube, secret, logging.NewNopLogger()).ServeHTTP(w, req)
+
+			if diff := cmp.Diff(tc.want.status, w.Code); diff != "" {
+				t.Errorf("\n%s\nServeHTTP(...): -want status, +got status:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.repos, drain(repositories)); diff != "" {
+				t.Errorf("\n%s\nServeHTTP(...): -want Repositories, +got Repositories:\n%s\n", tc.reason, diff)
+			}
+			if diff := cmp.Diff(tc.want.teams, drain(teams)); diff != "" {
+				t.Errorf("\n%s\nServeHTTP(...): -want Teams, +got Teams:\n%s\n", tc.reason, diff)
+			}
+		})
+	}
+}
+
+func TestTrigger(t *testing.T) {
+	gk := schema.GroupKind{Group: "test.github.crossplane.io", Kind: "Full"}
+	cr := &v1alpha1.Repository{ObjectMeta: metav1.ObjectMeta{Name: "repo"}}
+	for i := 0; i < queueSize; i++ {
+		if !trigger(gk, cr) {
+			t.Fatalf("trigger(...): reconcile %d should be queued", i)
+		}
+	}
+	if trigger(gk, cr) {
+		t.Errorf("trigger(...): reconcile should be dropped while the queue is full")
+	}
+	if diff := cmp.Diff(event.GenericEvent{Object: cr}, <-queues.channel(gk)); diff != "" {
+		t.Errorf("trigger(...): -want event, +got event:\n%s\n", diff)
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 The Crossplane Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhook
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// queueSize is the number of reconciles of a kind that can be pending. A
+// reconcile triggered while the queue is full is dropped, the managed resource
+// is still reconciled at its next poll.
+const queueSize = 1024
+
+// queues is shared by the Receiver and the controllers, since they are set up
+// independently.
+var queues = &registry{channels: make(map[schema.GroupKind]chan event.GenericEvent)}
+
+type registry struct {
+	mu       sync.Mutex
+	channels map[schema.GroupKind]chan event.GenericEvent
+}
+
+func (r *registry) channel(gk schema.GroupKind) chan event.GenericEvent {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	c, ok := r.channels[gk]
+	if !ok {
+		c = make(chan event.GenericEvent, queueSize)
+		r.channels[gk] = c
+	}
+	return c
+}
+
+// Source returns the reconciles that the Receiver triggers for the managed
+// resources of gk, for a controller to watch.
+func Source(gk schema.GroupKind) source.Source {
+	return &source.Channel{Source: queues.channel(gk)}
+}
+
+// trigger queues a reconcile of o, a managed resource of gk, and reports
+// whether it was queued.
+func trigger(gk schema.GroupKind, o client.Object) bool {
+	select {
+	case queues.channel(gk) <- event.GenericEvent{Object: o}:
+		return true
+	default:
+		return false
+	}
+}